@@ -2,30 +2,96 @@ package di
 
 import (
 	"log"
+	"time"
 
 	"meawle/internal/config"
+	"meawle/internal/contentfilter"
 	"meawle/internal/database"
 	"meawle/internal/handlers"
 	"meawle/internal/middleware"
+	"meawle/internal/notifier"
+	"meawle/internal/realtime"
 	"meawle/internal/repositories"
+	"meawle/internal/scheduler"
 	"meawle/internal/services"
 )
 
 // Dependencies содержит все зависимости приложения
 type Dependencies struct {
-	Config          *config.Config
-	Logger          *log.Logger
-	DB              *database.Database
-	UserRepo        repositories.UserRepository
-	CatBreedRepo    repositories.CatBreedRepository
-	CatRepo         repositories.CatRepository
-	UserService     *services.UserService
-	CatBreedService *services.CatBreedService
-	CatService      *services.CatService
-	UserHandler     *handlers.UserHandler
-	CatBreedHandler *handlers.CatBreedHandler
-	CatHandler      *handlers.CatHandler
-	AuthMiddleware  *middleware.AuthMiddleware
+	Config              *config.Config
+	Logger              *log.Logger
+	DB                  *database.Database
+	UserRepo            repositories.UserRepository
+	CatBreedRepo        repositories.CatBreedRepository
+	CatRepo             repositories.CatRepository
+	ChipContactRepo     repositories.ChipContactRepository
+	HealthRepo          repositories.HealthRecordRepository
+	MeasurementRepo     repositories.MeasurementRepository
+	ReminderRepo        repositories.ReminderRepository
+	PedigreeRepo        repositories.PedigreeRepository
+	LitterRepo          repositories.LitterRepository
+	TransferRepo        repositories.TransferRepository
+	CatMemberRepo       repositories.CatMemberRepository
+	ListingRepo         repositories.ListingRepository
+	ApplicationRepo     repositories.AdoptionApplicationRepository
+	OrganizationRepo    repositories.OrganizationRepository
+	LostFoundRepo       repositories.LostFoundRepository
+	LostAlertRepo       repositories.LostAlertRepository
+	LikeRepo            repositories.LikeRepository
+	CommentRepo         repositories.CommentRepository
+	FollowRepo          repositories.FollowRepository
+	ActivityRepo        repositories.ActivityRepository
+	ModerationRepo      repositories.ModerationRepository
+	MessageRepo         repositories.MessageRepository
+	BlockRepo           repositories.BlockRepository
+	UserService         *services.UserService
+	CatBreedService     *services.CatBreedService
+	CatService          *services.CatService
+	ChipService         *services.ChipService
+	HealthService       *services.HealthRecordService
+	MeasurementService  *services.MeasurementService
+	ReminderService     *services.ReminderService
+	CalendarService     *services.CalendarService
+	PedigreeService     *services.PedigreeService
+	LitterService       *services.LitterService
+	TransferService     *services.TransferService
+	CatMemberService    *services.CatMemberService
+	ListingService      *services.ListingService
+	ApplicationService  *services.AdoptionApplicationService
+	OrganizationService *services.OrganizationService
+	LostFoundService    *services.LostFoundService
+	LostAlertService    *services.LostAlertService
+	CommentService      *services.CommentService
+	FeedService         *services.FeedService
+	ModerationService   *services.ModerationService
+	TextFilterService   *services.TextFilterService
+	MessageService      *services.MessageService
+	UserHandler         *handlers.UserHandler
+	CatBreedHandler     *handlers.CatBreedHandler
+	CatHandler          *handlers.CatHandler
+	ChipHandler         *handlers.ChipHandler
+	HealthHandler       *handlers.HealthRecordHandler
+	MeasurementHandler  *handlers.MeasurementHandler
+	ReminderHandler     *handlers.ReminderHandler
+	CalendarHandler     *handlers.CalendarHandler
+	PedigreeHandler     *handlers.PedigreeHandler
+	LitterHandler       *handlers.LitterHandler
+	TransferHandler     *handlers.TransferHandler
+	CatMemberHandler    *handlers.CatMemberHandler
+	ListingHandler      *handlers.ListingHandler
+	ApplicationHandler  *handlers.AdoptionApplicationHandler
+	OrganizationHandler *handlers.OrganizationHandler
+	LostFoundHandler    *handlers.LostFoundHandler
+	LostAlertHandler    *handlers.LostAlertHandler
+	CommentHandler      *handlers.CommentHandler
+	FeedHandler         *handlers.FeedHandler
+	ModerationHandler   *handlers.ModerationHandler
+	MessageHandler      *handlers.MessageHandler
+	AuthMiddleware      *middleware.AuthMiddleware
+	ChipRateLimiter     *middleware.RateLimiter
+	Notifier            notifier.Notifier
+	MessageHub          *realtime.Hub
+	Scheduler           *scheduler.Scheduler
 }
 
 // InitializeDependencies инициализирует все зависимости приложения
@@ -45,33 +111,173 @@ func InitializeDependencies(cfg *config.Config, logger *log.Logger) (*Dependenci
 	userRepo := repositories.NewUserRepository(db)
 	catBreedRepo := repositories.NewCatBreedRepository(db)
 	catRepo := repositories.NewCatRepository(db)
+	chipContactRepo := repositories.NewChipContactRepository(db)
+	healthRepo := repositories.NewHealthRecordRepository(db)
+	measurementRepo := repositories.NewMeasurementRepository(db)
+	reminderRepo := repositories.NewReminderRepository(db)
+	pedigreeRepo := repositories.NewPedigreeRepository(db)
+	litterRepo := repositories.NewLitterRepository(db)
+	transferRepo := repositories.NewTransferRepository(db)
+	catMemberRepo := repositories.NewCatMemberRepository(db)
+	listingRepo := repositories.NewListingRepository(db)
+	applicationRepo := repositories.NewAdoptionApplicationRepository(db)
+	organizationRepo := repositories.NewOrganizationRepository(db)
+	lostFoundRepo := repositories.NewLostFoundRepository(db)
+	lostAlertRepo := repositories.NewLostAlertRepository(db)
+	likeRepo := repositories.NewLikeRepository(db)
+	commentRepo := repositories.NewCommentRepository(db)
+	followRepo := repositories.NewFollowRepository(db)
+	activityRepo := repositories.NewActivityRepository(db)
+	moderationRepo := repositories.NewModerationRepository(db)
+	messageRepo := repositories.NewMessageRepository(db)
+	blockRepo := repositories.NewBlockRepository(db)
+
+	// Инициализация уведомлений: email заглушка пишет в лог, webhook подключается при наличии URL
+	notifiers := notifier.MultiNotifier{notifier.NewEmailNotifier(logger)}
+	if cfg.NotifyWebhookURL != "" {
+		notifiers = append(notifiers, notifier.NewWebhookNotifier(cfg.NotifyWebhookURL))
+	}
+
+	// Инициализация доставки сообщений подключенным пользователям в реальном времени
+	messageHub := realtime.NewHub()
+
+	// Инициализация фильтра текста: списки запрещенных слов, число ссылок и повторы
+	textFilter := contentfilter.MultiFilter{
+		contentfilter.NewWordListFilter(cfg.ContentFilterLanguages, cfg.ContentFilterWords, cfg.ContentFilterAllowed),
+		contentfilter.NewLinkFilter(cfg.ContentFilterMaxLinks),
+		contentfilter.NewRepetitionFilter(cfg.ContentFilterMaxRepeats),
+	}
 
 	// Инициализация сервисов
 	userService := services.NewUserService(userRepo, cfg.JWTSecret)
-	catBreedService := services.NewCatBreedService(catBreedRepo)
-	catService := services.NewCatService(catRepo)
+	textFilterService := services.NewTextFilterService(textFilter, cfg.ContentFilterMode, moderationRepo)
+	catBreedService := services.NewCatBreedService(catBreedRepo, organizationRepo, likeRepo, activityRepo, textFilterService)
+	catService := services.NewCatService(catRepo, pedigreeRepo, organizationRepo, likeRepo, activityRepo, textFilterService)
+	chipService := services.NewChipService(catRepo, chipContactRepo, userRepo, notifiers)
+	healthService := services.NewHealthRecordService(healthRepo, catRepo)
+	measurementService := services.NewMeasurementService(measurementRepo, catRepo, cfg.WeightAlertPercent)
+	reminderService := services.NewReminderService(reminderRepo, catRepo, userRepo, notifiers)
+	calendarService := services.NewCalendarService(userRepo, catRepo, reminderRepo, healthRepo)
+	pedigreeService := services.NewPedigreeService(pedigreeRepo, catRepo)
+	litterService := services.NewLitterService(litterRepo, catRepo, pedigreeRepo, activityRepo, textFilterService)
+	transferService := services.NewTransferService(transferRepo, catRepo, userRepo, notifiers)
+	catMemberService := services.NewCatMemberService(catMemberRepo, catRepo, userRepo, notifiers)
+	listingService := services.NewListingService(listingRepo, catRepo)
+	applicationService := services.NewAdoptionApplicationService(applicationRepo, listingRepo, catRepo, userRepo, notifiers)
+	organizationService := services.NewOrganizationService(organizationRepo, userRepo, notifiers)
+	lostFoundService := services.NewLostFoundService(lostFoundRepo, catRepo, catBreedRepo, activityRepo)
+	lostAlertService := services.NewLostAlertService(lostAlertRepo, notifiers)
+	commentService := services.NewCommentService(commentRepo, catRepo, catBreedRepo, organizationRepo, cfg.CommentRateLimit)
+	feedService := services.NewFeedService(followRepo, activityRepo, userRepo, catRepo, catBreedRepo, lostFoundRepo)
+	moderationService := services.NewModerationService(moderationRepo, userRepo, catRepo, catBreedRepo, commentRepo, notifiers)
+	messageService := services.NewMessageService(messageRepo, blockRepo, userRepo, catRepo, listingRepo, messageHub)
 
 	// Инициализация хэндлеров
 	userHandler := handlers.NewUserHandler(userService)
 	catBreedHandler := handlers.NewCatBreedHandler(catBreedService)
 	catHandler := handlers.NewCatHandler(catService)
+	chipHandler := handlers.NewChipHandler(chipService)
+	healthHandler := handlers.NewHealthRecordHandler(healthService)
+	measurementHandler := handlers.NewMeasurementHandler(measurementService)
+	reminderHandler := handlers.NewReminderHandler(reminderService)
+	calendarHandler := handlers.NewCalendarHandler(calendarService)
+	pedigreeHandler := handlers.NewPedigreeHandler(pedigreeService)
+	litterHandler := handlers.NewLitterHandler(litterService)
+	transferHandler := handlers.NewTransferHandler(transferService)
+	catMemberHandler := handlers.NewCatMemberHandler(catMemberService)
+	listingHandler := handlers.NewListingHandler(listingService)
+	applicationHandler := handlers.NewAdoptionApplicationHandler(applicationService)
+	organizationHandler := handlers.NewOrganizationHandler(organizationService)
+	lostFoundHandler := handlers.NewLostFoundHandler(lostFoundService)
+	lostAlertHandler := handlers.NewLostAlertHandler(lostAlertService)
+	commentHandler := handlers.NewCommentHandler(commentService)
+	feedHandler := handlers.NewFeedHandler(feedService)
+	moderationHandler := handlers.NewModerationHandler(moderationService)
+	messageHandler := handlers.NewMessageHandler(messageService)
 
 	// Инициализация middleware
 	authMiddleware := middleware.NewAuthMiddleware(userService)
+	chipRateLimiter := middleware.NewRateLimiter(cfg.ChipLookupRateLimit, time.Minute)
+
+	// Инициализация фоновых задач
+	sched := scheduler.New(time.Duration(cfg.SchedulerInterval)*time.Second, logger)
+	sched.AddJob("reminders", reminderService.DispatchDue)
+	sched.AddJob("lost-alerts", lostAlertService.DispatchPending)
 
 	return &Dependencies{
-		Config:          cfg,
-		Logger:          logger,
-		DB:              db,
-		UserRepo:        userRepo,
-		CatBreedRepo:    catBreedRepo,
-		CatRepo:         catRepo,
-		UserService:     userService,
-		CatBreedService: catBreedService,
-		CatService:      catService,
-		UserHandler:     userHandler,
-		CatBreedHandler: catBreedHandler,
-		CatHandler:      catHandler,
-		AuthMiddleware:  authMiddleware,
+		Config:              cfg,
+		Logger:              logger,
+		DB:                  db,
+		UserRepo:            userRepo,
+		CatBreedRepo:        catBreedRepo,
+		CatRepo:             catRepo,
+		ChipContactRepo:     chipContactRepo,
+		HealthRepo:          healthRepo,
+		MeasurementRepo:     measurementRepo,
+		ReminderRepo:        reminderRepo,
+		PedigreeRepo:        pedigreeRepo,
+		LitterRepo:          litterRepo,
+		TransferRepo:        transferRepo,
+		CatMemberRepo:       catMemberRepo,
+		ListingRepo:         listingRepo,
+		ApplicationRepo:     applicationRepo,
+		OrganizationRepo:    organizationRepo,
+		LostFoundRepo:       lostFoundRepo,
+		LostAlertRepo:       lostAlertRepo,
+		LikeRepo:            likeRepo,
+		CommentRepo:         commentRepo,
+		FollowRepo:          followRepo,
+		ActivityRepo:        activityRepo,
+		ModerationRepo:      moderationRepo,
+		MessageRepo:         messageRepo,
+		BlockRepo:           blockRepo,
+		UserService:         userService,
+		CatBreedService:     catBreedService,
+		CatService:          catService,
+		ChipService:         chipService,
+		HealthService:       healthService,
+		MeasurementService:  measurementService,
+		ReminderService:     reminderService,
+		CalendarService:     calendarService,
+		PedigreeService:     pedigreeService,
+		LitterService:       litterService,
+		TransferService:     transferService,
+		CatMemberService:    catMemberService,
+		ListingService:      listingService,
+		ApplicationService:  applicationService,
+		OrganizationService: organizationService,
+		LostFoundService:    lostFoundService,
+		LostAlertService:    lostAlertService,
+		CommentService:      commentService,
+		FeedService:         feedService,
+		ModerationService:   moderationService,
+		TextFilterService:   textFilterService,
+		MessageService:      messageService,
+		UserHandler:         userHandler,
+		CatBreedHandler:     catBreedHandler,
+		CatHandler:          catHandler,
+		ChipHandler:         chipHandler,
+		HealthHandler:       healthHandler,
+		MeasurementHandler:  measurementHandler,
+		ReminderHandler:     reminderHandler,
+		CalendarHandler:     calendarHandler,
+		PedigreeHandler:     pedigreeHandler,
+		LitterHandler:       litterHandler,
+		TransferHandler:     transferHandler,
+		CatMemberHandler:    catMemberHandler,
+		ListingHandler:      listingHandler,
+		ApplicationHandler:  applicationHandler,
+		OrganizationHandler: organizationHandler,
+		LostFoundHandler:    lostFoundHandler,
+		LostAlertHandler:    lostAlertHandler,
+		CommentHandler:      commentHandler,
+		FeedHandler:         feedHandler,
+		ModerationHandler:   moderationHandler,
+		MessageHandler:      messageHandler,
+		AuthMiddleware:      authMiddleware,
+		ChipRateLimiter:     chipRateLimiter,
+		Notifier:            notifiers,
+		MessageHub:          messageHub,
+		Scheduler:           sched,
 	}, nil
 }
@@ -24,11 +24,32 @@ func main() {
 		deps.UserHandler,
 		deps.CatBreedHandler,
 		deps.CatHandler,
+		deps.ChipHandler,
+		deps.HealthHandler,
+		deps.MeasurementHandler,
+		deps.ReminderHandler,
+		deps.CalendarHandler,
+		deps.PedigreeHandler,
+		deps.LitterHandler,
+		deps.TransferHandler,
+		deps.CatMemberHandler,
+		deps.ListingHandler,
+		deps.ApplicationHandler,
+		deps.OrganizationHandler,
+		deps.LostFoundHandler,
+		deps.LostAlertHandler,
+		deps.CommentHandler,
+		deps.FeedHandler,
+		deps.ModerationHandler,
+		deps.MessageHandler,
 		deps.AuthMiddleware,
+		deps.ChipRateLimiter,
 	)
 
 	// Создание и запуск сервера
-	srv := server.NewServer(cfg, router, logger)
+	srv := server.NewServer(cfg, router, logger, deps.Scheduler)
+	// Открытые потоки событий сообщений не завершатся сами, поэтому закрываем их при остановке сервера
+	srv.RegisterOnShutdown(deps.MessageHub.Close)
 	srv.Start()
 
 	// Ожидание graceful shutdown
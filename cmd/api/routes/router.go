@@ -15,7 +15,26 @@ func SetupRoutes(
 	userHandler *handlers.UserHandler,
 	catBreedHandler *handlers.CatBreedHandler,
 	catHandler *handlers.CatHandler,
+	chipHandler *handlers.ChipHandler,
+	healthHandler *handlers.HealthRecordHandler,
+	measurementHandler *handlers.MeasurementHandler,
+	reminderHandler *handlers.ReminderHandler,
+	calendarHandler *handlers.CalendarHandler,
+	pedigreeHandler *handlers.PedigreeHandler,
+	litterHandler *handlers.LitterHandler,
+	transferHandler *handlers.TransferHandler,
+	catMemberHandler *handlers.CatMemberHandler,
+	listingHandler *handlers.ListingHandler,
+	applicationHandler *handlers.AdoptionApplicationHandler,
+	organizationHandler *handlers.OrganizationHandler,
+	lostFoundHandler *handlers.LostFoundHandler,
+	lostAlertHandler *handlers.LostAlertHandler,
+	commentHandler *handlers.CommentHandler,
+	feedHandler *handlers.FeedHandler,
+	moderationHandler *handlers.ModerationHandler,
+	messageHandler *handlers.MessageHandler,
 	authMiddleware *middleware.AuthMiddleware,
+	chipRateLimiter *middleware.RateLimiter,
 ) http.Handler {
 	r := mux.NewRouter()
 
@@ -25,18 +44,66 @@ func SetupRoutes(
 	// Публичные маршруты
 	api.HandleFunc("/auth/register", userHandler.Register).Methods(http.MethodPost)
 	api.HandleFunc("/auth/login", userHandler.Login).Methods(http.MethodPost)
-	api.HandleFunc("/users", userHandler.GetAllUsers).Methods(http.MethodGet)
-	api.HandleFunc("/users/{id:[0-9]+}", userHandler.GetUser).Methods(http.MethodGet)
-	api.HandleFunc("/cat-breeds", catBreedHandler.GetAllCatBreeds).Methods(http.MethodGet)
-	api.HandleFunc("/cat-breeds/{id:[0-9]+}", catBreedHandler.GetCatBreed).Methods(http.MethodGet)
-	api.HandleFunc("/cats", catHandler.GetAllCats).Methods(http.MethodGet)
-	api.HandleFunc("/cats/{id:[0-9]+}", catHandler.GetCat).Methods(http.MethodGet)
+	// Календарь доступен по секретному токену в query, так как календарные приложения не передают JWT
+	api.HandleFunc("/users/me/calendar.ics", calendarHandler.GetFeed).Methods(http.MethodGet)
+
+	// Публичные GET маршруты видят пользователя, если передан валидный токен, и персонализируют ответ:
+	// роль в коте, признак владельца породы, скрытые коты владельца
+	public := func(path string, handler http.HandlerFunc) {
+		api.Handle(path, authMiddleware.OptionalAuth(handler)).Methods(http.MethodGet)
+	}
+	public("/users", userHandler.GetAllUsers)
+	public("/users/{id:[0-9]+}", userHandler.GetUser)
+	public("/cat-breeds", catBreedHandler.GetAllCatBreeds)
+	public("/cat-breeds/{id:[0-9]+}", catBreedHandler.GetCatBreed)
+	public("/cats", catHandler.GetAllCats)
+	public("/cats/{id:[0-9]+}", catHandler.GetCat)
+	public("/cats/{id:[0-9]+}/pedigree", pedigreeHandler.GetPedigree)
+	public("/cats/{id:[0-9]+}/descendants", pedigreeHandler.GetDescendants)
+	public("/pedigree/ancestors/{id:[0-9]+}", pedigreeHandler.GetExternalAncestor)
+	public("/litters", litterHandler.GetLitters)
+	public("/litters/{id:[0-9]+}", litterHandler.GetLitter)
+	public("/listings", listingHandler.Search)
+	public("/listings/{id:[0-9]+}", listingHandler.GetListing)
+	public("/organizations", organizationHandler.GetAllOrganizations)
+	public("/organizations/{id:[0-9]+}", organizationHandler.GetOrganization)
+	public("/organizations/{id:[0-9]+}/cats", catHandler.GetOrganizationCats)
+	public("/lost", lostFoundHandler.Search)
+	public("/lost/{id:[0-9]+}", lostFoundHandler.GetReport)
+	public("/lost/{id:[0-9]+}/matches", lostFoundHandler.GetMatches)
+	public("/cats/{id:[0-9]+}/comments", commentHandler.GetCatComments)
+	public("/cat-breeds/{id:[0-9]+}/comments", commentHandler.GetBreedComments)
+	public("/users/{id:[0-9]+}/followers", feedHandler.GetFollowers)
+	public("/users/{id:[0-9]+}/following", feedHandler.GetFollowing)
 
 	// Защищенные маршруты пользователей
 	users := api.PathPrefix("/users").Subrouter()
 	users.Use(authMiddleware.RequireAuth)
 	users.HandleFunc("/{id:[0-9]+}", userHandler.UpdateUser).Methods(http.MethodPut)
 	users.HandleFunc("/{id:[0-9]+}", userHandler.DeleteUser).Methods(http.MethodDelete)
+	users.HandleFunc("/{id:[0-9]+}/follow", feedHandler.Follow).Methods(http.MethodPost)
+	users.HandleFunc("/{id:[0-9]+}/follow", feedHandler.Unfollow).Methods(http.MethodDelete)
+	users.HandleFunc("/me/chip-contacts", chipHandler.GetMyContactRequests).Methods(http.MethodGet)
+	users.HandleFunc("/me/calendar-token", calendarHandler.RotateToken).Methods(http.MethodPost)
+	users.HandleFunc("/me/transfers/incoming", transferHandler.GetIncoming).Methods(http.MethodGet)
+	users.HandleFunc("/me/transfers/outgoing", transferHandler.GetOutgoing).Methods(http.MethodGet)
+	users.HandleFunc("/me/cat-invitations", catMemberHandler.GetInvitations).Methods(http.MethodGet)
+	users.HandleFunc("/me/listings", listingHandler.GetUserListings).Methods(http.MethodGet)
+	users.HandleFunc("/me/applications", applicationHandler.GetUserApplications).Methods(http.MethodGet)
+	users.HandleFunc("/me/organizations", organizationHandler.GetUserOrganizations).Methods(http.MethodGet)
+	users.HandleFunc("/me/lost-reports", lostFoundHandler.GetUserReports).Methods(http.MethodGet)
+	users.HandleFunc("/me/lost-alerts", lostAlertHandler.GetSubscription).Methods(http.MethodGet)
+	users.HandleFunc("/me/lost-alerts", lostAlertHandler.Subscribe).Methods(http.MethodPut)
+	users.HandleFunc("/me/lost-alerts", lostAlertHandler.Unsubscribe).Methods(http.MethodDelete)
+	users.HandleFunc("/me/favorites/cats", catHandler.GetFavorites).Methods(http.MethodGet)
+	users.HandleFunc("/me/favorites/breeds", catBreedHandler.GetFavorites).Methods(http.MethodGet)
+	users.HandleFunc("/me/warnings", moderationHandler.GetMyWarnings).Methods(http.MethodGet)
+	users.HandleFunc("/me/messages/unread", messageHandler.GetUnreadCount).Methods(http.MethodGet)
+	users.HandleFunc("/me/blocks", messageHandler.GetBlockedUsers).Methods(http.MethodGet)
+	users.HandleFunc("/{id:[0-9]+}/block", messageHandler.BlockUser).Methods(http.MethodPost)
+	users.HandleFunc("/{id:[0-9]+}/block", messageHandler.UnblockUser).Methods(http.MethodDelete)
+	users.HandleFunc("/me/cat-invitations/{memberId:[0-9]+}/accept", catMemberHandler.AcceptInvitation).Methods(http.MethodPost)
+	users.HandleFunc("/me/cat-invitations/{memberId:[0-9]+}/decline", catMemberHandler.DeclineInvitation).Methods(http.MethodPost)
 
 	// Защищенные маршруты пород кошек
 	catBreeds := api.PathPrefix("/cat-breeds").Subrouter()
@@ -44,14 +111,149 @@ func SetupRoutes(
 	catBreeds.HandleFunc("", catBreedHandler.Create).Methods(http.MethodPost)
 	catBreeds.HandleFunc("/{id:[0-9]+}", catBreedHandler.UpdateCatBreed).Methods(http.MethodPut)
 	catBreeds.HandleFunc("/{id:[0-9]+}", catBreedHandler.DeleteCatBreed).Methods(http.MethodDelete)
+	catBreeds.HandleFunc("/{id:[0-9]+}/like", catBreedHandler.Like).Methods(http.MethodPost)
+	catBreeds.HandleFunc("/{id:[0-9]+}/like", catBreedHandler.Unlike).Methods(http.MethodDelete)
+	catBreeds.HandleFunc("/{id:[0-9]+}/comments", commentHandler.CreateBreedComment).Methods(http.MethodPost)
+	catBreeds.HandleFunc("/{id:[0-9]+}/comments/lock", commentHandler.LockBreedComments).Methods(http.MethodPost)
+	catBreeds.HandleFunc("/{id:[0-9]+}/comments/lock", commentHandler.UnlockBreedComments).Methods(http.MethodDelete)
 
 	// Защищенные маршруты котов
 	cats := api.PathPrefix("/cats").Subrouter()
 	cats.Use(authMiddleware.RequireAuth)
-	cats.HandleFunc("", catHandler.Create).Methods(http.MethodPost)
+	// Создание и обновление кота сообщают о занятом номере микрочипа, поэтому ограничены
+	// общим с поиском по микрочипу лимитом против перебора номеров
+	cats.Handle("", chipRateLimiter.Limit(http.HandlerFunc(catHandler.Create))).Methods(http.MethodPost)
 	cats.HandleFunc("/user", catHandler.GetUserCats).Methods(http.MethodGet)
-	cats.HandleFunc("/{id:[0-9]+}", catHandler.UpdateCat).Methods(http.MethodPut)
+	cats.HandleFunc("/shared", catHandler.GetSharedCats).Methods(http.MethodGet)
+	cats.Handle("/{id:[0-9]+}", chipRateLimiter.Limit(http.HandlerFunc(catHandler.UpdateCat))).Methods(http.MethodPut)
 	cats.HandleFunc("/{id:[0-9]+}", catHandler.DeleteCat).Methods(http.MethodDelete)
+	cats.HandleFunc("/{id:[0-9]+}/like", catHandler.Like).Methods(http.MethodPost)
+	cats.HandleFunc("/{id:[0-9]+}/like", catHandler.Unlike).Methods(http.MethodDelete)
+
+	// Комментарии к котам: добавление и закрытие ветки владельцем
+	cats.HandleFunc("/{id:[0-9]+}/comments", commentHandler.CreateCatComment).Methods(http.MethodPost)
+	cats.HandleFunc("/{id:[0-9]+}/comments/lock", commentHandler.LockCatComments).Methods(http.MethodPost)
+	cats.HandleFunc("/{id:[0-9]+}/comments/lock", commentHandler.UnlockCatComments).Methods(http.MethodDelete)
+
+	// Медицинская история котов (по умолчанию видна только владельцу)
+	cats.HandleFunc("/{id:[0-9]+}/health", healthHandler.GetCatRecords).Methods(http.MethodGet)
+	cats.HandleFunc("/{id:[0-9]+}/health", healthHandler.Create).Methods(http.MethodPost)
+	cats.HandleFunc("/{id:[0-9]+}/health/{recordId:[0-9]+}", healthHandler.GetRecord).Methods(http.MethodGet)
+	cats.HandleFunc("/{id:[0-9]+}/health/{recordId:[0-9]+}", healthHandler.UpdateRecord).Methods(http.MethodPut)
+	cats.HandleFunc("/{id:[0-9]+}/health/{recordId:[0-9]+}", healthHandler.DeleteRecord).Methods(http.MethodDelete)
+
+	// Измерения веса и роста котов
+	cats.HandleFunc("/{id:[0-9]+}/measurements", measurementHandler.GetCatMeasurements).Methods(http.MethodGet)
+	cats.HandleFunc("/{id:[0-9]+}/measurements", measurementHandler.Create).Methods(http.MethodPost)
+	cats.HandleFunc("/{id:[0-9]+}/measurements/weekly", measurementHandler.GetWeeklySeries).Methods(http.MethodGet)
+	cats.HandleFunc("/{id:[0-9]+}/measurements/{measurementId:[0-9]+}", measurementHandler.DeleteMeasurement).Methods(http.MethodDelete)
+
+	// Напоминания по уходу за котами
+	cats.HandleFunc("/{id:[0-9]+}/reminders", reminderHandler.GetCatReminders).Methods(http.MethodGet)
+	cats.HandleFunc("/{id:[0-9]+}/reminders", reminderHandler.Create).Methods(http.MethodPost)
+	cats.HandleFunc("/{id:[0-9]+}/reminders/{reminderId:[0-9]+}", reminderHandler.UpdateReminder).Methods(http.MethodPut)
+	cats.HandleFunc("/{id:[0-9]+}/reminders/{reminderId:[0-9]+}", reminderHandler.DeleteReminder).Methods(http.MethodDelete)
+
+	// Внешние (незарегистрированные) предки для родословных
+	pedigree := api.PathPrefix("/pedigree").Subrouter()
+	pedigree.Use(authMiddleware.RequireAuth)
+	pedigree.HandleFunc("/ancestors", pedigreeHandler.CreateExternalAncestor).Methods(http.MethodPost)
+
+	// Передача котов другим владельцам и история владельцев
+	cats.HandleFunc("/{id:[0-9]+}/transfers", transferHandler.Initiate).Methods(http.MethodPost)
+	cats.HandleFunc("/{id:[0-9]+}/owner", transferHandler.ForceTransfer).Methods(http.MethodPost)
+	cats.HandleFunc("/{id:[0-9]+}/ownership-history", transferHandler.GetHistory).Methods(http.MethodGet)
+
+	// Совместный доступ к коту: участники с ролями owner, caretaker и viewer
+	cats.HandleFunc("/{id:[0-9]+}/members", catMemberHandler.GetMembers).Methods(http.MethodGet)
+	cats.HandleFunc("/{id:[0-9]+}/members", catMemberHandler.Invite).Methods(http.MethodPost)
+	cats.HandleFunc("/{id:[0-9]+}/members/{memberId:[0-9]+}", catMemberHandler.UpdateRole).Methods(http.MethodPut)
+	cats.HandleFunc("/{id:[0-9]+}/members/{memberId:[0-9]+}", catMemberHandler.RemoveMember).Methods(http.MethodDelete)
+
+	transfers := api.PathPrefix("/transfers").Subrouter()
+	transfers.Use(authMiddleware.RequireAuth)
+	transfers.HandleFunc("/{id:[0-9]+}/accept", transferHandler.Accept).Methods(http.MethodPost)
+	transfers.HandleFunc("/{id:[0-9]+}/decline", transferHandler.Decline).Methods(http.MethodPost)
+	transfers.HandleFunc("/{id:[0-9]+}/cancel", transferHandler.Cancel).Methods(http.MethodPost)
+
+	// Защищенные маршруты пометов
+	litters := api.PathPrefix("/litters").Subrouter()
+	litters.Use(authMiddleware.RequireAuth)
+	litters.HandleFunc("", litterHandler.Create).Methods(http.MethodPost)
+	litters.HandleFunc("/{id:[0-9]+}", litterHandler.UpdateLitter).Methods(http.MethodPut)
+	litters.HandleFunc("/{id:[0-9]+}", litterHandler.DeleteLitter).Methods(http.MethodDelete)
+	litters.HandleFunc("/{id:[0-9]+}/kittens/{catId:[0-9]+}", litterHandler.UpdateKittenAvailability).Methods(http.MethodPut)
+
+	// Защищенные маршруты объявлений о пристройстве
+	listings := api.PathPrefix("/listings").Subrouter()
+	listings.Use(authMiddleware.RequireAuth)
+	listings.HandleFunc("", listingHandler.Create).Methods(http.MethodPost)
+	listings.HandleFunc("/{id:[0-9]+}", listingHandler.UpdateListing).Methods(http.MethodPut)
+	listings.HandleFunc("/{id:[0-9]+}", listingHandler.DeleteListing).Methods(http.MethodDelete)
+	listings.HandleFunc("/{id:[0-9]+}/applications", applicationHandler.Submit).Methods(http.MethodPost)
+	listings.HandleFunc("/{id:[0-9]+}/applications", applicationHandler.GetListingApplications).Methods(http.MethodGet)
+
+	// Заявки на пристройство: submitted → reviewing → approved/rejected → completed
+	applications := api.PathPrefix("/applications").Subrouter()
+	applications.Use(authMiddleware.RequireAuth)
+	applications.HandleFunc("/{id:[0-9]+}", applicationHandler.GetApplication).Methods(http.MethodGet)
+	applications.HandleFunc("/{id:[0-9]+}/status", applicationHandler.ChangeStatus).Methods(http.MethodPut)
+
+	// Организации (приюты, питомники) и управление сотрудниками: admin управляет организацией, staff ухаживает за котами
+	organizations := api.PathPrefix("/organizations").Subrouter()
+	organizations.Use(authMiddleware.RequireAuth)
+	organizations.HandleFunc("", organizationHandler.Create).Methods(http.MethodPost)
+	organizations.HandleFunc("/{id:[0-9]+}", organizationHandler.UpdateOrganization).Methods(http.MethodPut)
+	organizations.HandleFunc("/{id:[0-9]+}", organizationHandler.DeleteOrganization).Methods(http.MethodDelete)
+	organizations.HandleFunc("/{id:[0-9]+}/members", organizationHandler.GetMembers).Methods(http.MethodGet)
+	organizations.HandleFunc("/{id:[0-9]+}/members", organizationHandler.AddMember).Methods(http.MethodPost)
+	organizations.HandleFunc("/{id:[0-9]+}/members/{memberId:[0-9]+}", organizationHandler.UpdateMemberRole).Methods(http.MethodPut)
+	organizations.HandleFunc("/{id:[0-9]+}/members/{memberId:[0-9]+}", organizationHandler.RemoveMember).Methods(http.MethodDelete)
+
+	// Объявления о потерянных и найденных котах с поиском по расстоянию
+	lost := api.PathPrefix("/lost").Subrouter()
+	lost.Use(authMiddleware.RequireAuth)
+	lost.HandleFunc("", lostFoundHandler.Create).Methods(http.MethodPost)
+	lost.HandleFunc("/{id:[0-9]+}", lostFoundHandler.UpdateReport).Methods(http.MethodPut)
+	lost.HandleFunc("/{id:[0-9]+}", lostFoundHandler.DeleteReport).Methods(http.MethodDelete)
+
+	// Лента активности авторов, на которых подписан пользователь
+	api.Handle("/feed", authMiddleware.RequireAuth(http.HandlerFunc(feedHandler.GetFeed))).Methods(http.MethodGet)
+
+	// Жалобы на котов, породы, комментарии и пользователей
+	api.Handle("/reports", authMiddleware.RequireAuth(http.HandlerFunc(moderationHandler.CreateReport))).Methods(http.MethodPost)
+
+	// Очередь модерации для модераторов и админа
+	moderation := api.PathPrefix("/moderation").Subrouter()
+	moderation.Use(authMiddleware.RequireAuth)
+	moderation.HandleFunc("/reports", moderationHandler.GetQueue).Methods(http.MethodGet)
+	moderation.HandleFunc("/reports/{id:[0-9]+}", moderationHandler.GetReport).Methods(http.MethodGet)
+	moderation.HandleFunc("/reports/{id:[0-9]+}/resolve", moderationHandler.ResolveReport).Methods(http.MethodPost)
+	moderation.HandleFunc("/users/{id:[0-9]+}/ban", moderationHandler.UnbanUser).Methods(http.MethodDelete)
+
+	// Личные сообщения о котах и объявлениях о пристройстве, поток событий для доставки в реальном времени
+	conversations := api.PathPrefix("/conversations").Subrouter()
+	conversations.Use(authMiddleware.RequireAuth)
+	conversations.HandleFunc("", messageHandler.StartConversation).Methods(http.MethodPost)
+	conversations.HandleFunc("", messageHandler.GetConversations).Methods(http.MethodGet)
+	conversations.HandleFunc("/stream", messageHandler.Stream).Methods(http.MethodGet)
+	conversations.HandleFunc("/{id:[0-9]+}/messages", messageHandler.GetMessages).Methods(http.MethodGet)
+	conversations.HandleFunc("/{id:[0-9]+}/messages", messageHandler.SendMessage).Methods(http.MethodPost)
+	conversations.HandleFunc("/{id:[0-9]+}/read", messageHandler.MarkRead).Methods(http.MethodPost)
+
+	// Редактирование, удаление и модерация комментариев
+	comments := api.PathPrefix("/comments").Subrouter()
+	comments.Use(authMiddleware.RequireAuth)
+	comments.HandleFunc("/{id:[0-9]+}", commentHandler.UpdateComment).Methods(http.MethodPut)
+	comments.HandleFunc("/{id:[0-9]+}", commentHandler.DeleteComment).Methods(http.MethodDelete)
+	comments.HandleFunc("/{id:[0-9]+}/hide", commentHandler.HideComment).Methods(http.MethodPost)
+	comments.HandleFunc("/{id:[0-9]+}/hide", commentHandler.UnhideComment).Methods(http.MethodDelete)
+
+	// Публичный поиск по микрочипу с ограничением частоты запросов против перебора номеров
+	chips := api.PathPrefix("/chips").Subrouter()
+	chips.Use(chipRateLimiter.Limit)
+	chips.HandleFunc("/{number}", chipHandler.Lookup).Methods(http.MethodGet)
+	chips.HandleFunc("/{number}/contact", chipHandler.SendContactRequest).Methods(http.MethodPost)
 
 	// Health check
 	r.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
@@ -10,16 +10,18 @@ import (
 	"time"
 
 	"meawle/internal/config"
+	"meawle/internal/scheduler"
 )
 
 // Server представляет HTTP сервер приложения
 type Server struct {
 	*http.Server
-	Logger *log.Logger
+	Logger    *log.Logger
+	Scheduler *scheduler.Scheduler
 }
 
-// NewServer создает новый HTTP сервер
-func NewServer(cfg *config.Config, handler http.Handler, logger *log.Logger) *Server {
+// NewServer создает новый HTTP сервер с планировщиком фоновых задач
+func NewServer(cfg *config.Config, handler http.Handler, logger *log.Logger, scheduler *scheduler.Scheduler) *Server {
 	return &Server{
 		Server: &http.Server{
 			Addr:         cfg.Port,
@@ -28,12 +30,17 @@ func NewServer(cfg *config.Config, handler http.Handler, logger *log.Logger) *Se
 			WriteTimeout: 15 * time.Second,
 			IdleTimeout:  60 * time.Second,
 		},
-		Logger: logger,
+		Logger:    logger,
+		Scheduler: scheduler,
 	}
 }
 
-// Start запускает сервер в горутине
+// Start запускает сервер и планировщик фоновых задач в горутинах
 func (s *Server) Start() {
+	if s.Scheduler != nil {
+		s.Scheduler.Start()
+	}
+
 	go func() {
 		s.Logger.Printf("Server starting on port %s", s.Addr)
 		if err := s.ListenAndServe(); err != nil && err != http.ErrServerClosed {
@@ -53,6 +60,11 @@ func (s *Server) Shutdown() {
 		s.Logger.Fatal("Server forced to shutdown:", err)
 	}
 
+	// Останавливаем фоновые задачи после завершения обработки HTTP запросов
+	if s.Scheduler != nil {
+		s.Scheduler.Stop()
+	}
+
 	s.Logger.Println("Server exited")
 }
 
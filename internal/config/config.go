@@ -3,23 +3,48 @@ package config
 import (
 	"os"
 	"strconv"
+	"strings"
 )
 
 // Config представляет конфигурацию приложения
 type Config struct {
-	Port      string
-	DBPath    string
-	JWTSecret string
-	LogLevel  string
+	Port                string
+	DBPath              string
+	JWTSecret           string
+	LogLevel            string
+	ChipLookupRateLimit int     // Максимум запросов поиска по микрочипу, создания и обновления котов с одного IP в минуту
+	WeightAlertPercent  float64 // Изменение веса кота в процентах, при котором измерение помечается тревожным
+	SchedulerInterval   int     // Интервал запуска фоновых задач в секундах
+	NotifyWebhookURL    string  // URL для отправки уведомлений; пустое значение отключает webhook
+	CommentRateLimit    int     // Максимум комментариев от одного пользователя в минуту; 0 отключает ограничение
+	// Фильтр текста в названиях и описаниях котов и пород
+	ContentFilterMode       string   // off, reject, flag или mask
+	ContentFilterLanguages  []string // Языки встроенных списков запрещенных слов
+	ContentFilterWords      []string // Дополнительные запрещенные слова; слово со * в конце запрещает все формы с этим корнем
+	ContentFilterAllowed    []string // Слова-исключения, которые фильтр не трогает, даже если они начинаются с запрещенного корня
+	ContentFilterMaxLinks   int      // Максимум ссылок в тексте; 0 отключает проверку
+	ContentFilterMaxRepeats int      // Максимум повторов одного слова подряд; 0 отключает проверку
 }
 
 // Load загружает конфигурацию из переменных окружения
 func Load() *Config {
 	return &Config{
-		Port:      getEnv("PORT", ":8080"),
-		DBPath:    getEnv("DB_PATH", "app.db"),
-		JWTSecret: getEnv("JWT_SECRET", "your-secret-key-change-in-production"),
-		LogLevel:  getEnv("LOG_LEVEL", "info"),
+		Port:                getEnv("PORT", ":8080"),
+		DBPath:              getEnv("DB_PATH", "app.db"),
+		JWTSecret:           getEnv("JWT_SECRET", "your-secret-key-change-in-production"),
+		LogLevel:            getEnv("LOG_LEVEL", "info"),
+		ChipLookupRateLimit: getEnvInt("CHIP_LOOKUP_RATE_LIMIT", 10),
+		WeightAlertPercent:  getEnvFloat("WEIGHT_ALERT_PERCENT", 10),
+		SchedulerInterval:   getEnvInt("SCHEDULER_INTERVAL", 60),
+		NotifyWebhookURL:    getEnv("NOTIFY_WEBHOOK_URL", ""),
+		CommentRateLimit:    getEnvInt("COMMENT_RATE_LIMIT", 5),
+
+		ContentFilterMode:       getEnv("CONTENT_FILTER_MODE", "mask"),
+		ContentFilterLanguages:  getEnvList("CONTENT_FILTER_LANGUAGES", []string{"ru", "en"}),
+		ContentFilterWords:      getEnvList("CONTENT_FILTER_WORDS", nil),
+		ContentFilterAllowed:    getEnvList("CONTENT_FILTER_ALLOWED_WORDS", nil),
+		ContentFilterMaxLinks:   getEnvInt("CONTENT_FILTER_MAX_LINKS", 2),
+		ContentFilterMaxRepeats: getEnvInt("CONTENT_FILTER_MAX_REPEATS", 4),
 	}
 }
 
@@ -39,4 +64,30 @@ func getEnvInt(key string, defaultValue int) int {
 		}
 	}
 	return defaultValue
-}
\ No newline at end of file
+}
+
+// getEnvFloat получает дробное значение переменной окружения или значение по умолчанию
+func getEnvFloat(key string, defaultValue float64) float64 {
+	if value := os.Getenv(key); value != "" {
+		if floatValue, err := strconv.ParseFloat(value, 64); err == nil {
+			return floatValue
+		}
+	}
+	return defaultValue
+}
+
+// getEnvList получает список значений переменной окружения, разделенных запятыми, или значение по умолчанию
+func getEnvList(key string, defaultValue []string) []string {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
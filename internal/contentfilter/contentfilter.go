@@ -0,0 +1,228 @@
+package contentfilter
+
+import (
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// Режимы реакции на нарушения, найденные фильтром
+const (
+	ModeOff    = "off"    // Проверка отключена
+	ModeReject = "reject" // Текст с нарушениями отклоняется
+	ModeFlag   = "flag"   // Текст сохраняется как есть, ссылки и повторы отправляются в очередь модерации
+	ModeMask   = "mask"   // Запрещенные слова маскируются, остальные нарушения отправляются в очередь модерации
+)
+
+// Правила, по которым фильтр находит нарушения
+const (
+	RuleProfanity  = "profanity"
+	RuleLinks      = "links"
+	RuleRepetition = "repetition"
+)
+
+// Result представляет результат проверки текста
+type Result struct {
+	Text       string   // Текст после маскирования
+	Masked     []string // Правила, нарушения которых замаскированы в Text
+	Violations []string // Правила, нарушения которых маскированием не устранить
+}
+
+// Clean сообщает, что нарушений не найдено
+func (r Result) Clean() bool {
+	return len(r.Masked) == 0 && len(r.Violations) == 0
+}
+
+// Filter определяет интерфейс проверки пользовательского текста
+type Filter interface {
+	Check(text string) Result
+}
+
+// wordPattern выделяет слова из букв и цифр
+var wordPattern = regexp.MustCompile(`[\p{L}\p{N}]+`)
+
+// WordListFilter находит и маскирует запрещенные слова. Слово запрещено, если совпадает со словом из списка
+// или начинается с корня, отмеченного звездочкой, и не входит в список исключений
+type WordListFilter struct {
+	words   map[string]bool
+	stems   []string
+	allowed map[string]bool
+}
+
+// NewWordListFilter создает фильтр по встроенным спискам слов и исключений для языков,
+// дополнительным запрещенным словам и дополнительным исключениям
+func NewWordListFilter(languages []string, extraWords []string, allowedWords []string) *WordListFilter {
+	f := &WordListFilter{words: make(map[string]bool), allowed: make(map[string]bool)}
+	for _, language := range languages {
+		language = strings.ToLower(strings.TrimSpace(language))
+		f.addWords(wordLists[language])
+		f.addAllowed(allowLists[language])
+	}
+	f.addWords(extraWords)
+	f.addAllowed(allowedWords)
+
+	return f
+}
+
+// addWords добавляет запрещенные слова; слово со звездочкой в конце добавляется как корень
+func (f *WordListFilter) addWords(words []string) {
+	for _, word := range words {
+		word = normalizeWord(word)
+		if stem := strings.TrimSuffix(word, "*"); stem != word {
+			if stem != "" {
+				f.stems = append(f.stems, stem)
+			}
+		} else if word != "" {
+			f.words[word] = true
+		}
+	}
+}
+
+// addAllowed добавляет слова-исключения
+func (f *WordListFilter) addAllowed(words []string) {
+	for _, word := range words {
+		if word = normalizeWord(word); word != "" {
+			f.allowed[word] = true
+		}
+	}
+}
+
+// Check маскирует запрещенные слова, оставляя первую букву
+func (f *WordListFilter) Check(text string) Result {
+	found := false
+	masked := wordPattern.ReplaceAllStringFunc(text, func(word string) string {
+		if !f.isBanned(word) {
+			return word
+		}
+
+		found = true
+		first, size := utf8.DecodeRuneInString(word)
+		return string(first) + strings.Repeat("*", utf8.RuneCountInString(word[size:]))
+	})
+
+	result := Result{Text: masked}
+	if found {
+		result.Masked = []string{RuleProfanity}
+	}
+	return result
+}
+
+// isBanned проверяет, совпадает ли слово с запрещенным или начинается ли с запрещенного корня
+func (f *WordListFilter) isBanned(word string) bool {
+	word = normalizeWord(word)
+	if f.allowed[word] {
+		return false
+	}
+	if f.words[word] {
+		return true
+	}
+	for _, stem := range f.stems {
+		if strings.HasPrefix(word, stem) {
+			return true
+		}
+	}
+	return false
+}
+
+// linkPattern находит ссылки и адреса сайтов в тексте
+var linkPattern = regexp.MustCompile(`(?i)(?:https?://|www\.)\S+|\b[a-z0-9-]+\.(?:com|net|org|ru|su|info|biz|io|xyz|top|online|site)\b`)
+
+// LinkFilter считает текст спамом, если ссылок в нем больше допустимого
+type LinkFilter struct {
+	maxLinks int
+}
+
+// NewLinkFilter создает фильтр по числу ссылок. Значение 0 отключает проверку
+func NewLinkFilter(maxLinks int) *LinkFilter {
+	return &LinkFilter{maxLinks: maxLinks}
+}
+
+// Check проверяет число ссылок в тексте
+func (f *LinkFilter) Check(text string) Result {
+	result := Result{Text: text}
+	if f.maxLinks > 0 && len(linkPattern.FindAllStringIndex(text, -1)) > f.maxLinks {
+		result.Violations = []string{RuleLinks}
+	}
+	return result
+}
+
+// maxCharRun - максимальная длина серии одинаковых символов
+const maxCharRun = 10
+
+// RepetitionFilter находит повторы: одно и то же слово подряд и длинные серии одинаковых символов
+type RepetitionFilter struct {
+	maxRepeats int
+}
+
+// NewRepetitionFilter создает фильтр повторов. Значение 0 отключает проверку
+func NewRepetitionFilter(maxRepeats int) *RepetitionFilter {
+	return &RepetitionFilter{maxRepeats: maxRepeats}
+}
+
+// Check проверяет текст на повторы
+func (f *RepetitionFilter) Check(text string) Result {
+	result := Result{Text: text}
+	if f.maxRepeats == 0 {
+		return result
+	}
+
+	if hasCharRun(text, maxCharRun) || hasWordRepeats(text, f.maxRepeats) {
+		result.Violations = []string{RuleRepetition}
+	}
+	return result
+}
+
+// hasCharRun проверяет, есть ли в тексте серия одинаковых символов длиннее limit, кроме пробелов
+func hasCharRun(text string, limit int) bool {
+	var prev rune
+	run := 0
+	for _, r := range text {
+		if r == prev && r != ' ' {
+			run++
+		} else {
+			prev, run = r, 1
+		}
+		if run > limit {
+			return true
+		}
+	}
+	return false
+}
+
+// hasWordRepeats проверяет, повторяется ли одно слово подряд больше limit раз
+func hasWordRepeats(text string, limit int) bool {
+	var prev string
+	run := 0
+	for _, word := range wordPattern.FindAllString(text, -1) {
+		word = normalizeWord(word)
+		if word == prev {
+			run++
+		} else {
+			prev, run = word, 1
+		}
+		if run > limit {
+			return true
+		}
+	}
+	return false
+}
+
+// MultiFilter последовательно применяет несколько фильтров, передавая каждому текст после маскирования
+type MultiFilter []Filter
+
+// Check объединяет результаты всех фильтров
+func (m MultiFilter) Check(text string) Result {
+	result := Result{Text: text}
+	for _, f := range m {
+		r := f.Check(result.Text)
+		result.Text = r.Text
+		result.Masked = append(result.Masked, r.Masked...)
+		result.Violations = append(result.Violations, r.Violations...)
+	}
+	return result
+}
+
+// normalizeWord приводит слово к нижнему регистру и заменяет ё на е
+func normalizeWord(word string) string {
+	return strings.ReplaceAll(strings.ToLower(strings.TrimSpace(word)), "ё", "е")
+}
@@ -0,0 +1,134 @@
+package contentfilter
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestWordListFilter(t *testing.T) {
+	filter := NewWordListFilter([]string{"ru", "en"}, []string{"мяу", "гав*"}, []string{"гавань"})
+
+	tests := []struct {
+		name   string
+		text   string
+		want   string
+		masked bool
+	}{
+		{name: "clean text", text: "Ласковый рыжий кот", want: "Ласковый рыжий кот"},
+		{name: "whole word", text: "Кот сука", want: "Кот с***", masked: true},
+		{name: "upper case and punctuation", text: "СУКА, кот!", want: "С***, кот!", masked: true},
+		{name: "explicit word form", text: "нет суки", want: "нет с***", masked: true},
+		{name: "whole word does not match a longer word", text: "Сукно и суккулент", want: "Сукно и суккулент"},
+		{name: "stem matches word forms", text: "бляди", want: "б****", masked: true},
+		{name: "stem inside a word is not matched", text: "мандарин и оскорблять", want: "мандарин и оскорблять"},
+		{name: "hyphen separates words", text: "кот-сука", want: "кот-с***", masked: true},
+		{name: "digits join the word", text: "сука1", want: "сука1"},
+		{name: "yo is normalized", text: "ёбаный", want: "ё*****", masked: true},
+		{name: "english stem", text: "Fucking shitty cat", want: "F****** s***** cat", masked: true},
+		{name: "english whole word", text: "bastards and bastardize", want: "b******* and bastardize", masked: true},
+		{name: "built-in allowlist", text: "shiitake soup", want: "shiitake soup"},
+		{name: "extra word", text: "Мяу мяуканье", want: "М** мяуканье", masked: true},
+		{name: "extra stem and allowlist", text: "гавкает в гавани у гавань", want: "г****** в г***** у гавань", masked: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := filter.Check(tt.text)
+			if result.Text != tt.want {
+				t.Errorf("Check(%q).Text = %q, want %q", tt.text, result.Text, tt.want)
+			}
+			if got := len(result.Masked) > 0; got != tt.masked {
+				t.Errorf("Check(%q).Masked = %v, want masked %v", tt.text, result.Masked, tt.masked)
+			}
+			if len(result.Violations) > 0 {
+				t.Errorf("Check(%q).Violations = %v, want none", tt.text, result.Violations)
+			}
+		})
+	}
+}
+
+func TestWordListFilterLanguages(t *testing.T) {
+	filter := NewWordListFilter([]string{" EN "}, nil, nil)
+
+	if result := filter.Check("сука"); !result.Clean() {
+		t.Errorf("russian word matched with only english list: %+v", result)
+	}
+	if result := filter.Check("shit"); result.Clean() {
+		t.Error("english word not matched")
+	}
+}
+
+func TestLinkFilter(t *testing.T) {
+	tests := []struct {
+		name     string
+		maxLinks int
+		text     string
+		want     bool
+	}{
+		{name: "disabled", maxLinks: 0, text: "http://a.ru http://b.ru http://c.ru", want: false},
+		{name: "within limit", maxLinks: 2, text: "Питомник: https://cats.example/a и www.cats.example", want: false},
+		{name: "over limit", maxLinks: 2, text: "http://a.example https://b.example www.c.example", want: true},
+		{name: "bare domains", maxLinks: 1, text: "пишите на kotiki.ru или murr.com", want: true},
+		{name: "cyrillic text with dots is not a link", maxLinks: 1, text: "Кот.Ласковый.Рыжий. Т.е. домашний", want: false},
+		{name: "unknown zone is not a link", maxLinks: 1, text: "file.txt и photo.jpg", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := NewLinkFilter(tt.maxLinks).Check(tt.text)
+			if got := reflect.DeepEqual(result.Violations, []string{RuleLinks}); got != tt.want {
+				t.Errorf("Check(%q).Violations = %v, want links violation %v", tt.text, result.Violations, tt.want)
+			}
+			if result.Text != tt.text {
+				t.Errorf("Check(%q).Text = %q, want text unchanged", tt.text, result.Text)
+			}
+		})
+	}
+}
+
+func TestRepetitionFilter(t *testing.T) {
+	tests := []struct {
+		name       string
+		maxRepeats int
+		text       string
+		want       bool
+	}{
+		{name: "disabled", maxRepeats: 0, text: "мяу мяу мяу мяу мяу мяу", want: false},
+		{name: "within limit", maxRepeats: 4, text: "мяу мяу мяу мяу", want: false},
+		{name: "over limit", maxRepeats: 4, text: "мяу мяу мяу мяу мяу", want: true},
+		{name: "case and yo do not hide repeats", maxRepeats: 2, text: "Ёж ЕЖ еж", want: true},
+		{name: "punctuation between words", maxRepeats: 2, text: "купи, купи! купи?", want: true},
+		{name: "interrupted repeats", maxRepeats: 2, text: "мяу мяу кот мяу мяу", want: false},
+		{name: "long run of one letter", maxRepeats: 4, text: "Мяяяяяяяяяяяяу", want: true},
+		{name: "run at the limit", maxRepeats: 4, text: "Мяяяяяяяяяяу", want: false},
+		{name: "spaces are not a run", maxRepeats: 4, text: "кот            спит", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := NewRepetitionFilter(tt.maxRepeats).Check(tt.text)
+			if got := reflect.DeepEqual(result.Violations, []string{RuleRepetition}); got != tt.want {
+				t.Errorf("Check(%q).Violations = %v, want repetition violation %v", tt.text, result.Violations, tt.want)
+			}
+		})
+	}
+}
+
+func TestMultiFilter(t *testing.T) {
+	filter := MultiFilter{
+		NewWordListFilter([]string{"ru"}, nil, nil),
+		NewLinkFilter(1),
+		NewRepetitionFilter(4),
+	}
+
+	result := filter.Check("сука сука сука http://a.example http://b.example")
+	if want := "с*** с*** с*** http://a.example http://b.example"; result.Text != want {
+		t.Errorf("Text = %q, want %q", result.Text, want)
+	}
+	if want := []string{RuleProfanity}; !reflect.DeepEqual(result.Masked, want) {
+		t.Errorf("Masked = %v, want %v", result.Masked, want)
+	}
+	if want := []string{RuleLinks}; !reflect.DeepEqual(result.Violations, want) {
+		t.Errorf("Violations = %v, want %v", result.Violations, want)
+	}
+}
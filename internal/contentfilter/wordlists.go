@@ -0,0 +1,22 @@
+package contentfilter
+
+// wordLists содержит встроенные запрещенные слова по языкам. Слово без звездочки запрещено
+// только целиком, слово со звездочкой в конце задает корень и запрещает все формы, начинающиеся с него.
+// Корни подобраны так, чтобы не задевать обычные слова вроде "мандарин" или "pussycat";
+// дополнительные слова задаются в конфигурации
+var wordLists = map[string][]string{
+	"ru": {
+		"хуй*", "хуе*", "хуя*", "пизд*", "ебат*", "ебан*", "ебал*", "ебну*", "еблан*", "выеб*", "заеб*", "уеб*",
+		"бляд*", "блять", "мудак*", "мудил*", "сука", "суки", "суке", "суку", "сукой", "гандон*", "пидор*", "пидар*",
+		"шлюх*", "залуп*", "дрочи*",
+	},
+	"en": {
+		"fuck*", "motherfuck*", "shit*", "bullshit*", "bitch*", "asshole*", "cunt*", "bastard", "bastards",
+		"slut", "sluts", "slutty", "whore*", "faggot*", "nigger*",
+	},
+}
+
+// allowLists содержит встроенные исключения по языкам: обычные слова, которые начинаются с запрещенного корня
+var allowLists = map[string][]string{
+	"en": {"shiitake", "shitake", "shittim", "shittah"},
+}
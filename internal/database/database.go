@@ -4,21 +4,22 @@ import (
 	"database/sql"
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/golang-migrate/migrate/v4"
 	"github.com/golang-migrate/migrate/v4/database/sqlite3"
 	_ "github.com/golang-migrate/migrate/v4/source/file"
-	_ "github.com/mattn/go-sqlite3"
 )
 
 // Database представляет подключение к SQLite базе данных
 type Database struct {
-	DB *sql.DB
+	DB   *sql.DB
+	path string
 }
 
 // New создает новое подключение к SQLite базе данных
 func New(dbPath string) (*Database, error) {
-	db, err := sql.Open("sqlite3", dbPath)
+	db, err := sql.Open(driverName, withForeignKeys(dbPath))
 	if err != nil {
 		return nil, fmt.Errorf("failed to open database: %w", err)
 	}
@@ -31,7 +32,19 @@ func New(dbPath string) (*Database, error) {
 
 	log.Printf("Successfully connected to database: %s", dbPath)
 
-	return &Database{DB: db}, nil
+	return &Database{DB: db, path: dbPath}, nil
+}
+
+// withForeignKeys включает проверку внешних ключей. SQLite по умолчанию их не проверяет,
+// и без этого удаление кота или пользователя оставляет связанные с ними записи.
+// Параметр передается в строке подключения, чтобы он действовал для каждого соединения пула
+func withForeignKeys(dbPath string) string {
+	separator := "?"
+	if strings.Contains(dbPath, "?") {
+		separator = "&"
+	}
+
+	return dbPath + separator + "_foreign_keys=on"
 }
 
 // Close закрывает подключение к базе данных
@@ -65,9 +78,33 @@ func (d *Database) QueryRow(query string, args ...interface{}) *sql.Row {
 	return d.DB.QueryRow(query, args...)
 }
 
-// RunMigrations выполняет миграции базы данных
+// Begin начинает транзакцию для операций, которые должны выполниться целиком
+func (d *Database) Begin() (*sql.Tx, error) {
+	tx, err := d.DB.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	return tx, nil
+}
+
+// RunMigrations выполняет миграции базы данных.
+// Миграции выполняются на отдельном подключении без проверки внешних ключей: иначе DROP TABLE
+// при пересоздании таблицы срабатывает как DELETE и обнуляет или удаляет ссылки на нее в других таблицах.
+// Целостность ссылок проверяется после выполнения миграций
 func (d *Database) RunMigrations(migrationsPath string) error {
-	driver, err := sqlite3.WithInstance(d.DB, &sqlite3.Config{})
+	migrationDB, err := sql.Open(driverName, d.path)
+	if err != nil {
+		return fmt.Errorf("failed to open migration connection: %w", err)
+	}
+	defer migrationDB.Close()
+
+	// PRAGMA действует только на свое соединение, поэтому миграции выполняются на одном соединении
+	migrationDB.SetMaxOpenConns(1)
+	if _, err := migrationDB.Exec("PRAGMA foreign_keys = OFF"); err != nil {
+		return fmt.Errorf("failed to disable foreign keys for migrations: %w", err)
+	}
+
+	driver, err := sqlite3.WithInstance(migrationDB, &sqlite3.Config{})
 	if err != nil {
 		return fmt.Errorf("failed to create migration driver: %w", err)
 	}
@@ -93,5 +130,34 @@ func (d *Database) RunMigrations(migrationsPath string) error {
 		log.Println("Migrations applied successfully")
 	}
 
+	return checkForeignKeys(migrationDB)
+}
+
+// checkForeignKeys проверяет, что после миграций не осталось ссылок на несуществующие записи
+func checkForeignKeys(db *sql.DB) error {
+	rows, err := db.Query("PRAGMA foreign_key_check")
+	if err != nil {
+		return fmt.Errorf("failed to check foreign keys: %w", err)
+	}
+	defer rows.Close()
+
+	var violations []string
+	for rows.Next() {
+		var table, parent string
+		var rowID sql.NullInt64
+		var fkID int
+		if err := rows.Scan(&table, &rowID, &parent, &fkID); err != nil {
+			return fmt.Errorf("failed to check foreign keys: %w", err)
+		}
+		violations = append(violations, fmt.Sprintf("%s row %d references missing %s", table, rowID.Int64, parent))
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to check foreign keys: %w", err)
+	}
+
+	if len(violations) > 0 {
+		return fmt.Errorf("foreign key check failed after migrations: %s", strings.Join(violations, "; "))
+	}
+
 	return nil
 }
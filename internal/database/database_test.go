@@ -0,0 +1,122 @@
+package database
+
+import (
+	"database/sql"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang-migrate/migrate/v4"
+	"github.com/golang-migrate/migrate/v4/database/sqlite3"
+)
+
+const testMigrationsPath = "../../migrations"
+
+// migrateTo переводит базу на указанную версию миграций, как это сделал бы прежний релиз
+func migrateTo(t *testing.T, dbPath string, version uint) {
+	t.Helper()
+
+	db, err := sql.Open(driverName, dbPath)
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	defer db.Close()
+
+	driver, err := sqlite3.WithInstance(db, &sqlite3.Config{})
+	if err != nil {
+		t.Fatalf("migration driver: %v", err)
+	}
+	m, err := migrate.NewWithDatabaseInstance("file://"+testMigrationsPath, "sqlite3", driver)
+	if err != nil {
+		t.Fatalf("migration instance: %v", err)
+	}
+	if err := m.Migrate(version); err != nil {
+		t.Fatalf("migrate to %d: %v", version, err)
+	}
+}
+
+func TestRunMigrationsKeepsReferencesToRebuiltTables(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "meawle.db")
+
+	// Миграция 025 пересоздает content_reports, на которую ссылаются предупреждения
+	migrateTo(t, dbPath, 24)
+
+	db, err := New(dbPath)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	defer db.Close()
+
+	var reportID int
+	if err := db.QueryRow(`SELECT id FROM content_reports ORDER BY id LIMIT 1`).Scan(&reportID); err != nil {
+		t.Fatalf("seed report: %v", err)
+	}
+	result, err := db.Execute(`INSERT INTO user_warnings (user_id, report_id, reason, issued_by) VALUES (2, ?, 'spam', 3)`, reportID)
+	if err != nil {
+		t.Fatalf("insert warning: %v", err)
+	}
+	warningID, _ := result.LastInsertId()
+
+	if err := db.RunMigrations(testMigrationsPath); err != nil {
+		t.Fatalf("RunMigrations: %v", err)
+	}
+
+	var got sql.NullInt64
+	if err := db.QueryRow(`SELECT report_id FROM user_warnings WHERE id = ?`, warningID).Scan(&got); err != nil {
+		t.Fatalf("read warning: %v", err)
+	}
+	if !got.Valid || int(got.Int64) != reportID {
+		t.Errorf("warning report_id = %v, want %d", got, reportID)
+	}
+
+	// Откат 025 пересоздает таблицу еще раз и тоже должен сохранить ссылку на жалобу пользователя
+	migrateTo(t, dbPath, 24)
+
+	if err := db.QueryRow(`SELECT report_id FROM user_warnings WHERE id = ?`, warningID).Scan(&got); err != nil {
+		t.Fatalf("read warning after rollback: %v", err)
+	}
+	if !got.Valid || int(got.Int64) != reportID {
+		t.Errorf("warning report_id after rollback = %v, want %d", got, reportID)
+	}
+}
+
+func TestNewEnablesForeignKeys(t *testing.T) {
+	db, err := New(filepath.Join(t.TempDir(), "meawle.db"))
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	defer db.Close()
+
+	var enabled int
+	if err := db.QueryRow(`PRAGMA foreign_keys`).Scan(&enabled); err != nil {
+		t.Fatalf("PRAGMA foreign_keys: %v", err)
+	}
+	if enabled != 1 {
+		t.Errorf("foreign_keys = %d, want 1", enabled)
+	}
+}
+
+func TestRunMigrationsRejectsBrokenReferences(t *testing.T) {
+	dir := t.TempDir()
+	migrationsPath := filepath.Join(dir, "migrations")
+	if err := os.Mkdir(migrationsPath, 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+
+	up := `CREATE TABLE owners (id INTEGER PRIMARY KEY);
+CREATE TABLE pets (id INTEGER PRIMARY KEY, owner_id INTEGER REFERENCES owners(id));
+INSERT INTO pets (id, owner_id) VALUES (1, 42);`
+	if err := os.WriteFile(filepath.Join(migrationsPath, "001_broken.up.sql"), []byte(up), 0o644); err != nil {
+		t.Fatalf("write migration: %v", err)
+	}
+
+	db, err := New(filepath.Join(dir, "meawle.db"))
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	defer db.Close()
+
+	if err := db.RunMigrations(migrationsPath); err == nil {
+		t.Error("RunMigrations succeeded with a row referencing a missing owner")
+	}
+}
@@ -0,0 +1,36 @@
+package database
+
+import (
+	"database/sql"
+	"math"
+
+	"github.com/mattn/go-sqlite3"
+)
+
+// driverName - имя драйвера SQLite с функциями приложения
+const driverName = "sqlite3_meawle"
+
+// earthRadiusKm - средний радиус Земли в километрах
+const earthRadiusKm = 6371.0
+
+func init() {
+	// Тригонометрические функции SQLite доступны только со сборочным тегом sqlite_math_functions,
+	// поэтому расстояние считаем функцией, зарегистрированной на каждом соединении
+	sql.Register(driverName, &sqlite3.SQLiteDriver{
+		ConnectHook: func(conn *sqlite3.SQLiteConn) error {
+			return conn.RegisterFunc("haversine_km", haversineKm, true)
+		},
+	})
+}
+
+// haversineKm возвращает расстояние по поверхности Земли между двумя точками в километрах
+func haversineKm(lat1, lon1, lat2, lon2 float64) float64 {
+	toRadians := func(deg float64) float64 { return deg * math.Pi / 180 }
+
+	dLat := toRadians(lat2 - lat1)
+	dLon := toRadians(lon2 - lon1)
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(toRadians(lat1))*math.Cos(toRadians(lat2))*math.Sin(dLon/2)*math.Sin(dLon/2)
+
+	return 2 * earthRadiusKm * math.Asin(math.Min(1, math.Sqrt(a)))
+}
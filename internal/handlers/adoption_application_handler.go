@@ -0,0 +1,224 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"meawle/internal/middleware"
+	"meawle/internal/models"
+	"meawle/internal/services"
+
+	"github.com/gorilla/mux"
+)
+
+// AdoptionApplicationHandler представляет хэндлер для заявок на пристройство котов
+type AdoptionApplicationHandler struct {
+	service *services.AdoptionApplicationService
+}
+
+// NewAdoptionApplicationHandler создает новый экземпляр хэндлера заявок на пристройство
+func NewAdoptionApplicationHandler(service *services.AdoptionApplicationService) *AdoptionApplicationHandler {
+	return &AdoptionApplicationHandler{service: service}
+}
+
+// Submit обрабатывает подачу заявки на объявление
+func (h *AdoptionApplicationHandler) Submit(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodPost) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	// Извлекаем ID объявления из path параметров
+	vars := mux.Vars(r)
+	listingID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid listing ID")
+		return
+	}
+
+	var req models.AdoptionApplicationCreateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	application, err := h.service.Submit(listingID, &req, currentUser.UserID)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Created(application)
+}
+
+// GetListingApplications обрабатывает получение заявок на объявление
+func (h *AdoptionApplicationHandler) GetListingApplications(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodGet) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	// Извлекаем ID объявления из path параметров
+	vars := mux.Vars(r)
+	listingID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid listing ID")
+		return
+	}
+
+	applications, err := h.service.GetListingApplications(listingID, currentUser.UserID, currentUser.IsAdmin)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Success(applications)
+}
+
+// GetUserApplications обрабатывает получение заявок текущего пользователя
+func (h *AdoptionApplicationHandler) GetUserApplications(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodGet) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	applications, err := h.service.GetUserApplications(currentUser.UserID)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Success(applications)
+}
+
+// GetApplication обрабатывает получение заявки по ID
+func (h *AdoptionApplicationHandler) GetApplication(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodGet) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	// Извлекаем ID заявки из path параметров
+	vars := mux.Vars(r)
+	id, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid application ID")
+		return
+	}
+
+	application, err := h.service.GetApplication(id, currentUser.UserID, currentUser.IsAdmin)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Success(application)
+}
+
+// ChangeStatus обрабатывает изменение статуса заявки автором объявления
+func (h *AdoptionApplicationHandler) ChangeStatus(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodPut) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	// Извлекаем ID заявки из path параметров
+	vars := mux.Vars(r)
+	id, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid application ID")
+		return
+	}
+
+	var req models.AdoptionApplicationStatusRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	application, err := h.service.ChangeStatus(id, &req, currentUser.UserID, currentUser.IsAdmin)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Success(application)
+}
+
+// handleServiceError обрабатывает ошибки сервиса
+func (h *AdoptionApplicationHandler) handleServiceError(rw *ResponseWriter, err error) {
+	switch err {
+	case services.ErrApplicationNotFound:
+		rw.Error(http.StatusNotFound, "Application not found")
+	case services.ErrListingNotFound:
+		rw.Error(http.StatusNotFound, "Listing not found")
+	case services.ErrCatNotFound:
+		rw.Error(http.StatusNotFound, "Cat not found")
+	case services.ErrInvalidApplicationData:
+		rw.Error(http.StatusBadRequest, "Invalid application data")
+	case services.ErrInvalidApplication:
+		rw.Error(http.StatusBadRequest, "Cannot apply to own listing")
+	case services.ErrApplicationExists:
+		rw.Error(http.StatusConflict, "Application for this listing already exists")
+	case services.ErrListingNotOpen:
+		rw.Error(http.StatusConflict, "Listing is not accepting applications")
+	case services.ErrListingClosed:
+		rw.Error(http.StatusConflict, "Listing is closed")
+	case services.ErrInvalidStatusTransition:
+		rw.Error(http.StatusConflict, "Application status transition is not allowed")
+	case services.ErrApplicationStatusChanged:
+		rw.Error(http.StatusConflict, "Application status has been changed")
+	case services.ErrCatOwnerChanged:
+		rw.Error(http.StatusConflict, "Cat owner has changed")
+	case services.ErrAccessDenied:
+		rw.Error(http.StatusForbidden, "Access denied")
+	default:
+		rw.Error(http.StatusInternalServerError, "Internal server error")
+	}
+}
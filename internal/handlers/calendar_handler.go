@@ -0,0 +1,89 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"meawle/internal/middleware"
+	"meawle/internal/services"
+)
+
+// CalendarHandler представляет хэндлер календаря ухода за котами
+type CalendarHandler struct {
+	service *services.CalendarService
+}
+
+// NewCalendarHandler создает новый экземпляр хэндлера календаря
+func NewCalendarHandler(service *services.CalendarService) *CalendarHandler {
+	return &CalendarHandler{service: service}
+}
+
+// GetFeed отдает календарь пользователя в формате iCalendar.
+// Календарные приложения не умеют передавать JWT, поэтому пользователь определяется по секретному токену в query
+func (h *CalendarHandler) GetFeed(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodGet) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	feed, err := h.service.BuildFeed(r.URL.Query().Get("token"))
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.Header().Set("Content-Disposition", `inline; filename="calendar.ics"`)
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(feed))
+}
+
+// RotateToken обрабатывает выпуск нового токена подписки на календарь
+func (h *CalendarHandler) RotateToken(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodPost) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	token, err := h.service.RotateToken(currentUser.UserID)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+
+	response := map[string]interface{}{
+		"token": token,
+		"url":   fmt.Sprintf("%s://%s/api/v1/users/me/calendar.ics?token=%s", scheme, r.Host, url.QueryEscape(token)),
+	}
+
+	rw.Success(response)
+}
+
+// handleServiceError обрабатывает ошибки сервиса
+func (h *CalendarHandler) handleServiceError(rw *ResponseWriter, err error) {
+	switch err {
+	case services.ErrInvalidCalendarToken:
+		rw.Error(http.StatusUnauthorized, "Invalid calendar token")
+	case services.ErrUserNotFound:
+		rw.Error(http.StatusNotFound, "User not found")
+	default:
+		rw.Error(http.StatusInternalServerError, "Internal server error")
+	}
+}
@@ -71,7 +71,9 @@ func (h *CatBreedHandler) GetCatBreed(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	breed, err := h.service.GetCatBreedByID(id)
+	userID, _ := currentViewer(r)
+
+	breed, err := h.service.GetCatBreedByID(id, userID)
 	if err != nil {
 		rw.Error(http.StatusNotFound, "Cat breed not found")
 		return
@@ -89,7 +91,20 @@ func (h *CatBreedHandler) GetAllCatBreeds(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	breeds, err := h.service.GetAllCatBreeds()
+	// Фильтр по организации
+	var organizationID *int
+	if value := r.URL.Query().Get("organization_id"); value != "" {
+		id, err := strconv.Atoi(value)
+		if err != nil {
+			rw.Error(http.StatusBadRequest, "Invalid organization ID")
+			return
+		}
+		organizationID = &id
+	}
+
+	userID, _ := currentViewer(r)
+
+	breeds, err := h.service.GetAllCatBreeds(organizationID, userID)
 	if err != nil {
 		rw.Error(http.StatusInternalServerError, "Internal server error")
 		return
@@ -172,6 +187,97 @@ func (h *CatBreedHandler) DeleteCatBreed(w http.ResponseWriter, r *http.Request)
 	rw.Success("Cat breed deleted successfully")
 }
 
+// GetFavorites обрабатывает получение пород кошек, отмеченных текущим пользователем
+func (h *CatBreedHandler) GetFavorites(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodGet) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	favorites, err := h.service.GetFavoriteBreeds(currentUser.UserID)
+	if err != nil {
+		rw.Error(http.StatusInternalServerError, "Internal server error")
+		return
+	}
+
+	rw.Success(favorites)
+}
+
+// Like обрабатывает отметку "нравится" для породы кошек
+func (h *CatBreedHandler) Like(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodPost) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	// Извлекаем ID из path параметров
+	vars := mux.Vars(r)
+	id, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid cat breed ID")
+		return
+	}
+
+	likes, err := h.service.LikeBreed(id, currentUser.UserID)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Success(likes)
+}
+
+// Unlike обрабатывает снятие отметки "нравится" с породы кошек
+func (h *CatBreedHandler) Unlike(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodDelete) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	// Извлекаем ID из path параметров
+	vars := mux.Vars(r)
+	id, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid cat breed ID")
+		return
+	}
+
+	likes, err := h.service.UnlikeBreed(id, currentUser.UserID)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Success(likes)
+}
+
 // handleServiceError обрабатывает ошибки сервиса
 func (h *CatBreedHandler) handleServiceError(rw *ResponseWriter, err error) {
 	switch err {
@@ -179,10 +285,14 @@ func (h *CatBreedHandler) handleServiceError(rw *ResponseWriter, err error) {
 		rw.Error(http.StatusNotFound, "Cat breed not found")
 	case services.ErrCatBreedNameExists:
 		rw.Error(http.StatusConflict, "Cat breed name already exists")
+	case services.ErrContentRejected:
+		rw.Error(http.StatusBadRequest, "Name or description contains prohibited words, too many links or repetitions")
 	case services.ErrInvalidCatBreedData:
 		rw.Error(http.StatusBadRequest, "Invalid cat breed data")
 	case services.ErrInvalidCreationDate:
 		rw.Error(http.StatusBadRequest, "Invalid creation date")
+	case services.ErrOrganizationNotFound:
+		rw.Error(http.StatusBadRequest, "Organization not found")
 	case services.ErrAccessDenied:
 		rw.Error(http.StatusForbidden, "Access denied")
 	default:
@@ -71,7 +71,9 @@ func (h *CatHandler) GetCat(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	cat, err := h.service.GetCatByID(id)
+	userID, isAdmin := currentViewer(r)
+
+	cat, err := h.service.GetCatByID(id, userID, isAdmin)
 	if err != nil {
 		h.handleServiceError(rw, err)
 		return
@@ -89,9 +91,55 @@ func (h *CatHandler) GetAllCats(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	cats, err := h.service.GetAllCats()
+	filter, err := parseCatFilter(r)
 	if err != nil {
-		rw.Error(http.StatusInternalServerError, "Internal server error")
+		rw.Error(http.StatusBadRequest, "Invalid filter parameters")
+		return
+	}
+
+	userID, isAdmin := currentViewer(r)
+
+	cats, err := h.service.GetAllCats(filter, userID, isAdmin)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Success(cats)
+}
+
+// GetOrganizationCats обрабатывает получение котов организации
+func (h *CatHandler) GetOrganizationCats(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodGet) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Извлекаем ID организации из path параметров
+	vars := mux.Vars(r)
+	organizationID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid organization ID")
+		return
+	}
+
+	filter, err := parseCatFilter(r)
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid filter parameters")
+		return
+	}
+
+	userID, isAdmin := currentViewer(r)
+
+	cats, err := h.service.GetOrganizationCats(organizationID, filter, userID, isAdmin)
+	if err == services.ErrOrganizationNotFound {
+		rw.Error(http.StatusNotFound, "Organization not found")
+		return
+	}
+	if err != nil {
+		h.handleServiceError(rw, err)
 		return
 	}
 
@@ -123,6 +171,31 @@ func (h *CatHandler) GetUserCats(w http.ResponseWriter, r *http.Request) {
 	rw.Success(cats)
 }
 
+// GetSharedCats обрабатывает получение котов, к которым текущий пользователь имеет совместный доступ
+func (h *CatHandler) GetSharedCats(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodGet) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	cats, err := h.service.GetSharedCats(currentUser.UserID)
+	if err != nil {
+		rw.Error(http.StatusInternalServerError, "Internal server error")
+		return
+	}
+
+	rw.Success(cats)
+}
+
 // UpdateCat обрабатывает обновление кота
 func (h *CatHandler) UpdateCat(w http.ResponseWriter, r *http.Request) {
 	rw := NewResponseWriter(w)
@@ -197,18 +270,179 @@ func (h *CatHandler) DeleteCat(w http.ResponseWriter, r *http.Request) {
 	rw.Success("Cat deleted successfully")
 }
 
+// GetFavorites обрабатывает получение котов, отмеченных текущим пользователем
+func (h *CatHandler) GetFavorites(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodGet) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	favorites, err := h.service.GetFavoriteCats(currentUser.UserID, currentUser.IsAdmin)
+	if err != nil {
+		rw.Error(http.StatusInternalServerError, "Internal server error")
+		return
+	}
+
+	rw.Success(favorites)
+}
+
+// Like обрабатывает отметку "нравится" для кота
+func (h *CatHandler) Like(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodPost) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	// Извлекаем ID из path параметров
+	vars := mux.Vars(r)
+	id, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid cat ID")
+		return
+	}
+
+	likes, err := h.service.LikeCat(id, currentUser.UserID, currentUser.IsAdmin)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Success(likes)
+}
+
+// Unlike обрабатывает снятие отметки "нравится" с кота
+func (h *CatHandler) Unlike(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodDelete) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	// Извлекаем ID из path параметров
+	vars := mux.Vars(r)
+	id, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid cat ID")
+		return
+	}
+
+	likes, err := h.service.UnlikeCat(id, currentUser.UserID, currentUser.IsAdmin)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Success(likes)
+}
+
 // handleServiceError обрабатывает ошибки сервиса
 func (h *CatHandler) handleServiceError(rw *ResponseWriter, err error) {
 	switch err {
 	case services.ErrCatNotFound:
 		rw.Error(http.StatusNotFound, "Cat not found")
+	case services.ErrContentRejected:
+		rw.Error(http.StatusBadRequest, "Name or description contains prohibited words, too many links or repetitions")
 	case services.ErrInvalidCatData:
 		rw.Error(http.StatusBadRequest, "Invalid cat data")
-	case services.ErrInvalidCatAge:
-		rw.Error(http.StatusBadRequest, "Cat age must be between 0 and 30 years")
+	case services.ErrInvalidCatBirthDate:
+		rw.Error(http.StatusBadRequest, "Cat birth date must be YYYY, YYYY-MM or YYYY-MM-DD, not in the future and within 30 years")
+	case services.ErrInvalidCatSex:
+		rw.Error(http.StatusBadRequest, "Cat sex must be male or female")
+	case services.ErrInvalidCoatPattern:
+		rw.Error(http.StatusBadRequest, "Invalid cat coat pattern")
+	case services.ErrInvalidVisibility:
+		rw.Error(http.StatusBadRequest, "Cat visibility must be public, unlisted or private")
+	case services.ErrInvalidCatSort:
+		rw.Error(http.StatusBadRequest, "Cat sort must be newest or popular")
+	case services.ErrInvalidCatWeight:
+		rw.Error(http.StatusBadRequest, "Cat weight must be greater than 0 and not more than 30 kg")
+	case services.ErrInvalidMicrochip:
+		rw.Error(http.StatusBadRequest, "Microchip number must be 15 digits according to ISO 11784")
+	case services.ErrMicrochipExists:
+		rw.Error(http.StatusConflict, "Microchip number already registered")
+	case services.ErrInvalidNeuteredData:
+		rw.Error(http.StatusBadRequest, "Neutered date requires neutered flag and must be YYYY-MM-DD not in the future")
+	case services.ErrParentNotFound:
+		rw.Error(http.StatusBadRequest, "Parent not found")
+	case services.ErrInvalidParent:
+		rw.Error(http.StatusBadRequest, "Parent must be either a registered cat or an external ancestor")
+	case services.ErrInvalidParentSex:
+		rw.Error(http.StatusBadRequest, "Sire must be male and dam must be female")
+	case services.ErrPedigreeCycle:
+		rw.Error(http.StatusConflict, "Cat cannot be its own ancestor")
+	case services.ErrOrganizationNotFound:
+		rw.Error(http.StatusBadRequest, "Organization not found")
 	case services.ErrAccessDenied:
 		rw.Error(http.StatusForbidden, "Access denied")
 	default:
 		rw.Error(http.StatusInternalServerError, "Internal server error")
 	}
 }
+
+// parseCatFilter извлекает параметры фильтрации котов из query строки
+func parseCatFilter(r *http.Request) (*models.CatFilter, error) {
+	query := r.URL.Query()
+	filter := &models.CatFilter{}
+
+	if sex := query.Get("sex"); sex != "" {
+		filter.Sex = &sex
+	}
+
+	if color := query.Get("color"); color != "" {
+		filter.Color = &color
+	}
+
+	if coatPattern := query.Get("coat_pattern"); coatPattern != "" {
+		filter.CoatPattern = &coatPattern
+	}
+
+	if eyeColor := query.Get("eye_color"); eyeColor != "" {
+		filter.EyeColor = &eyeColor
+	}
+
+	if neuteredStr := query.Get("is_neutered"); neuteredStr != "" {
+		isNeutered, err := strconv.ParseBool(neuteredStr)
+		if err != nil {
+			return nil, err
+		}
+		filter.IsNeutered = &isNeutered
+	}
+
+	if organizationStr := query.Get("organization_id"); organizationStr != "" {
+		organizationID, err := strconv.Atoi(organizationStr)
+		if err != nil {
+			return nil, err
+		}
+		filter.OrganizationID = &organizationID
+	}
+
+	filter.Sort = query.Get("sort")
+
+	return filter, nil
+}
@@ -0,0 +1,289 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"meawle/internal/middleware"
+	"meawle/internal/models"
+	"meawle/internal/services"
+
+	"github.com/gorilla/mux"
+)
+
+// CatMemberHandler представляет хэндлер для совместного доступа к котам
+type CatMemberHandler struct {
+	service *services.CatMemberService
+}
+
+// NewCatMemberHandler создает новый экземпляр хэндлера участников котов
+func NewCatMemberHandler(service *services.CatMemberService) *CatMemberHandler {
+	return &CatMemberHandler{service: service}
+}
+
+// Invite обрабатывает приглашение участника кота по email
+func (h *CatMemberHandler) Invite(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodPost) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	// Извлекаем ID кота из path параметров
+	vars := mux.Vars(r)
+	catID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid cat ID")
+		return
+	}
+
+	var req models.CatMemberInviteRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	member, err := h.service.Invite(catID, &req, currentUser.UserID, currentUser.IsAdmin)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Created(member)
+}
+
+// GetMembers обрабатывает получение участников кота
+func (h *CatMemberHandler) GetMembers(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodGet) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	// Извлекаем ID кота из path параметров
+	vars := mux.Vars(r)
+	catID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid cat ID")
+		return
+	}
+
+	members, err := h.service.GetMembers(catID, currentUser.UserID, currentUser.IsAdmin)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Success(members)
+}
+
+// UpdateRole обрабатывает изменение роли участника кота
+func (h *CatMemberHandler) UpdateRole(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodPut) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	// Извлекаем ID кота и участника из path параметров
+	vars := mux.Vars(r)
+	catID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid cat ID")
+		return
+	}
+	memberID, err := strconv.Atoi(vars["memberId"])
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid member ID")
+		return
+	}
+
+	var req models.CatMemberUpdateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	err = h.service.UpdateRole(catID, memberID, &req, currentUser.UserID, currentUser.IsAdmin)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Success("Cat member role updated successfully")
+}
+
+// RemoveMember обрабатывает удаление участника кота
+func (h *CatMemberHandler) RemoveMember(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodDelete) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	// Извлекаем ID кота и участника из path параметров
+	vars := mux.Vars(r)
+	catID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid cat ID")
+		return
+	}
+	memberID, err := strconv.Atoi(vars["memberId"])
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid member ID")
+		return
+	}
+
+	err = h.service.RemoveMember(catID, memberID, currentUser.UserID, currentUser.IsAdmin)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Success("Cat member removed successfully")
+}
+
+// GetInvitations обрабатывает получение приглашений текущего пользователя
+func (h *CatMemberHandler) GetInvitations(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodGet) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	invitations, err := h.service.GetInvitations(currentUser.UserID)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Success(invitations)
+}
+
+// AcceptInvitation обрабатывает принятие приглашения
+func (h *CatMemberHandler) AcceptInvitation(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodPost) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	// Извлекаем ID приглашения из path параметров
+	vars := mux.Vars(r)
+	id, err := strconv.Atoi(vars["memberId"])
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid invitation ID")
+		return
+	}
+
+	member, err := h.service.AcceptInvitation(id, currentUser.UserID)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Success(member)
+}
+
+// DeclineInvitation обрабатывает отклонение приглашения
+func (h *CatMemberHandler) DeclineInvitation(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodPost) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	// Извлекаем ID приглашения из path параметров
+	vars := mux.Vars(r)
+	id, err := strconv.Atoi(vars["memberId"])
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid invitation ID")
+		return
+	}
+
+	if err := h.service.DeclineInvitation(id, currentUser.UserID); err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Success("Invitation declined successfully")
+}
+
+// handleServiceError обрабатывает ошибки сервиса
+func (h *CatMemberHandler) handleServiceError(rw *ResponseWriter, err error) {
+	switch err {
+	case services.ErrCatNotFound:
+		rw.Error(http.StatusNotFound, "Cat not found")
+	case services.ErrCatMemberNotFound:
+		rw.Error(http.StatusNotFound, "Cat member not found")
+	case services.ErrInvitationNotFound:
+		rw.Error(http.StatusNotFound, "Invitation not found")
+	case services.ErrCatMemberExists:
+		rw.Error(http.StatusConflict, "User is already a member of the cat")
+	case services.ErrInvalidCatRole:
+		rw.Error(http.StatusBadRequest, "Cat role must be owner, caretaker or viewer")
+	case services.ErrInvalidCatMember:
+		rw.Error(http.StatusBadRequest, "Cat owner cannot be invited as a member")
+	case services.ErrAccessDenied:
+		rw.Error(http.StatusForbidden, "Access denied")
+	default:
+		rw.Error(http.StatusInternalServerError, "Internal server error")
+	}
+}
@@ -0,0 +1,112 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"meawle/internal/middleware"
+	"meawle/internal/models"
+	"meawle/internal/services"
+
+	"github.com/gorilla/mux"
+)
+
+// ChipHandler представляет хэндлер для поиска котов по микрочипу
+type ChipHandler struct {
+	service *services.ChipService
+}
+
+// NewChipHandler создает новый экземпляр хэндлера микрочипов
+func NewChipHandler(service *services.ChipService) *ChipHandler {
+	return &ChipHandler{service: service}
+}
+
+// Lookup обрабатывает проверку регистрации микрочипа
+func (h *ChipHandler) Lookup(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodGet) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Извлекаем номер микрочипа из path параметров
+	vars := mux.Vars(r)
+	number := vars["number"]
+
+	result, err := h.service.Lookup(number)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Success(result)
+}
+
+// SendContactRequest обрабатывает отправку запроса на связь с владельцем
+func (h *ChipHandler) SendContactRequest(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodPost) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Извлекаем номер микрочипа из path параметров
+	vars := mux.Vars(r)
+	number := vars["number"]
+
+	var req models.ChipContactCreateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	_, err := h.service.SendContactRequest(number, &req)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	// Не возвращаем сохраненный запрос, чтобы не раскрывать идентификатор кота
+	rw.JSON(http.StatusCreated, models.Success("Contact request sent to the owner"))
+}
+
+// GetMyContactRequests обрабатывает получение запросов на связь по котам текущего пользователя
+func (h *ChipHandler) GetMyContactRequests(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodGet) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	requests, err := h.service.GetOwnerContactRequests(currentUser.UserID)
+	if err != nil {
+		rw.Error(http.StatusInternalServerError, "Internal server error")
+		return
+	}
+
+	rw.Success(requests)
+}
+
+// handleServiceError обрабатывает ошибки сервиса
+func (h *ChipHandler) handleServiceError(rw *ResponseWriter, err error) {
+	switch err {
+	case services.ErrInvalidMicrochip:
+		rw.Error(http.StatusBadRequest, "Microchip number must be 15 digits according to ISO 11784")
+	case services.ErrChipNotRegistered:
+		rw.Error(http.StatusNotFound, "Microchip is not registered")
+	case services.ErrInvalidContactData:
+		rw.Error(http.StatusBadRequest, "Invalid contact request data")
+	default:
+		rw.Error(http.StatusInternalServerError, "Internal server error")
+	}
+}
@@ -0,0 +1,326 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"meawle/internal/middleware"
+	"meawle/internal/models"
+	"meawle/internal/services"
+
+	"github.com/gorilla/mux"
+)
+
+// CommentHandler представляет хэндлер для комментариев на страницах котов и пород
+type CommentHandler struct {
+	service *services.CommentService
+}
+
+// NewCommentHandler создает новый экземпляр хэндлера комментариев
+func NewCommentHandler(service *services.CommentService) *CommentHandler {
+	return &CommentHandler{service: service}
+}
+
+// GetCatComments обрабатывает получение комментариев к коту
+func (h *CommentHandler) GetCatComments(w http.ResponseWriter, r *http.Request) {
+	h.getComments(w, r, models.CommentTargetCat)
+}
+
+// GetBreedComments обрабатывает получение комментариев к породе
+func (h *CommentHandler) GetBreedComments(w http.ResponseWriter, r *http.Request) {
+	h.getComments(w, r, models.CommentTargetBreed)
+}
+
+// CreateCatComment обрабатывает добавление комментария к коту
+func (h *CommentHandler) CreateCatComment(w http.ResponseWriter, r *http.Request) {
+	h.create(w, r, models.CommentTargetCat)
+}
+
+// CreateBreedComment обрабатывает добавление комментария к породе
+func (h *CommentHandler) CreateBreedComment(w http.ResponseWriter, r *http.Request) {
+	h.create(w, r, models.CommentTargetBreed)
+}
+
+// LockCatComments обрабатывает закрытие ветки комментариев кота
+func (h *CommentHandler) LockCatComments(w http.ResponseWriter, r *http.Request) {
+	h.setLocked(w, r, models.CommentTargetCat, true)
+}
+
+// UnlockCatComments обрабатывает открытие ветки комментариев кота
+func (h *CommentHandler) UnlockCatComments(w http.ResponseWriter, r *http.Request) {
+	h.setLocked(w, r, models.CommentTargetCat, false)
+}
+
+// LockBreedComments обрабатывает закрытие ветки комментариев породы
+func (h *CommentHandler) LockBreedComments(w http.ResponseWriter, r *http.Request) {
+	h.setLocked(w, r, models.CommentTargetBreed, true)
+}
+
+// UnlockBreedComments обрабатывает открытие ветки комментариев породы
+func (h *CommentHandler) UnlockBreedComments(w http.ResponseWriter, r *http.Request) {
+	h.setLocked(w, r, models.CommentTargetBreed, false)
+}
+
+// UpdateComment обрабатывает редактирование комментария
+func (h *CommentHandler) UpdateComment(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodPut) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	// Извлекаем ID комментария из path параметров
+	vars := mux.Vars(r)
+	id, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid comment ID")
+		return
+	}
+
+	var req models.CommentUpdateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	comment, err := h.service.Update(id, &req, currentUser.UserID, currentUser.IsAdmin)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Success(comment)
+}
+
+// DeleteComment обрабатывает удаление комментария
+func (h *CommentHandler) DeleteComment(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodDelete) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	// Извлекаем ID комментария из path параметров
+	vars := mux.Vars(r)
+	id, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid comment ID")
+		return
+	}
+
+	err = h.service.Delete(id, currentUser.UserID, currentUser.IsAdmin)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Success("Comment deleted successfully")
+}
+
+// HideComment обрабатывает скрытие комментария модератором
+func (h *CommentHandler) HideComment(w http.ResponseWriter, r *http.Request) {
+	h.setHidden(w, r, http.MethodPost, true)
+}
+
+// UnhideComment обрабатывает возвращение скрытого комментария в ветку
+func (h *CommentHandler) UnhideComment(w http.ResponseWriter, r *http.Request) {
+	h.setHidden(w, r, http.MethodDelete, false)
+}
+
+// getComments возвращает страницу комментариев к коту или породе
+func (h *CommentHandler) getComments(w http.ResponseWriter, r *http.Request, targetType string) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodGet) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Извлекаем ID кота или породы из path параметров
+	vars := mux.Vars(r)
+	targetID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid ID")
+		return
+	}
+
+	limit, offset, err := parsePaging(r)
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Parameters limit and offset must be integers")
+		return
+	}
+
+	userID, isAdmin := currentViewer(r)
+
+	thread, err := h.service.GetComments(targetType, targetID, limit, offset, userID, isAdmin)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Success(thread)
+}
+
+// create добавляет комментарий к коту или породе
+func (h *CommentHandler) create(w http.ResponseWriter, r *http.Request, targetType string) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodPost) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	// Извлекаем ID кота или породы из path параметров
+	vars := mux.Vars(r)
+	targetID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid ID")
+		return
+	}
+
+	var req models.CommentCreateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	comment, err := h.service.Create(targetType, targetID, &req, currentUser.UserID, currentUser.IsAdmin)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Created(comment)
+}
+
+// setLocked закрывает или открывает ветку комментариев кота или породы
+func (h *CommentHandler) setLocked(w http.ResponseWriter, r *http.Request, targetType string, locked bool) {
+	rw := NewResponseWriter(w)
+
+	method := http.MethodPost
+	if !locked {
+		method = http.MethodDelete
+	}
+	if !ValidateMethod(r, method) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	// Извлекаем ID кота или породы из path параметров
+	vars := mux.Vars(r)
+	targetID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid ID")
+		return
+	}
+
+	err = h.service.SetLocked(targetType, targetID, locked, currentUser.UserID, currentUser.IsAdmin)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	if locked {
+		rw.Success("Comment thread locked successfully")
+	} else {
+		rw.Success("Comment thread unlocked successfully")
+	}
+}
+
+// setHidden скрывает комментарий или возвращает его в ветку
+func (h *CommentHandler) setHidden(w http.ResponseWriter, r *http.Request, method string, hidden bool) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, method) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	// Извлекаем ID комментария из path параметров
+	vars := mux.Vars(r)
+	id, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid comment ID")
+		return
+	}
+
+	err = h.service.SetHidden(id, hidden, currentUser.UserID, currentUser.IsAdmin)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	if hidden {
+		rw.Success("Comment hidden successfully")
+	} else {
+		rw.Success("Comment restored successfully")
+	}
+}
+
+// handleServiceError обрабатывает ошибки сервиса
+func (h *CommentHandler) handleServiceError(rw *ResponseWriter, err error) {
+	switch err {
+	case services.ErrCommentNotFound:
+		rw.Error(http.StatusNotFound, "Comment not found")
+	case services.ErrCatNotFound:
+		rw.Error(http.StatusNotFound, "Cat not found")
+	case services.ErrCatBreedNotFound:
+		rw.Error(http.StatusNotFound, "Cat breed not found")
+	case services.ErrInvalidCommentData:
+		rw.Error(http.StatusBadRequest, "Comment must be between 1 and 2000 characters")
+	case services.ErrInvalidCommentPaging:
+		rw.Error(http.StatusBadRequest, "Limit must be between 1 and 100 and offset not negative")
+	case services.ErrInvalidCommentReplyTo:
+		rw.Error(http.StatusBadRequest, "Reply must belong to the same thread")
+	case services.ErrCommentDeleted:
+		rw.Error(http.StatusConflict, "Comment has been deleted")
+	case services.ErrCommentEditExpired:
+		rw.Error(http.StatusConflict, "Comment can only be edited within 15 minutes")
+	case services.ErrCommentThreadLocked:
+		rw.Error(http.StatusForbidden, "Comment thread is locked")
+	case services.ErrCommentRateLimited:
+		rw.Error(http.StatusTooManyRequests, "Too many comments, try again later")
+	case services.ErrAccessDenied:
+		rw.Error(http.StatusForbidden, "Access denied")
+	default:
+		rw.Error(http.StatusInternalServerError, "Internal server error")
+	}
+}
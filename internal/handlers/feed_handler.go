@@ -0,0 +1,176 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"meawle/internal/middleware"
+	"meawle/internal/services"
+
+	"github.com/gorilla/mux"
+)
+
+// FeedHandler представляет хэндлер для подписок на пользователей и ленты активности
+type FeedHandler struct {
+	service *services.FeedService
+}
+
+// NewFeedHandler создает новый экземпляр хэндлера ленты
+func NewFeedHandler(service *services.FeedService) *FeedHandler {
+	return &FeedHandler{service: service}
+}
+
+// Follow обрабатывает подписку на пользователя
+func (h *FeedHandler) Follow(w http.ResponseWriter, r *http.Request) {
+	h.setFollowing(w, r, http.MethodPost, true)
+}
+
+// Unfollow обрабатывает отмену подписки на пользователя
+func (h *FeedHandler) Unfollow(w http.ResponseWriter, r *http.Request) {
+	h.setFollowing(w, r, http.MethodDelete, false)
+}
+
+// GetFollowers обрабатывает получение подписчиков пользователя
+func (h *FeedHandler) GetFollowers(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodGet) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Извлекаем ID пользователя из path параметров
+	vars := mux.Vars(r)
+	id, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid user ID")
+		return
+	}
+
+	followers, err := h.service.GetFollowers(id)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Success(followers)
+}
+
+// GetFollowing обрабатывает получение пользователей, на которых подписан пользователь
+func (h *FeedHandler) GetFollowing(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodGet) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Извлекаем ID пользователя из path параметров
+	vars := mux.Vars(r)
+	id, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid user ID")
+		return
+	}
+
+	following, err := h.service.GetFollowing(id)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Success(following)
+}
+
+// GetFeed обрабатывает получение ленты активности текущего пользователя
+func (h *FeedHandler) GetFeed(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodGet) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	query := r.URL.Query()
+
+	var limit int
+	if limitStr := query.Get("limit"); limitStr != "" {
+		var err error
+		if limit, err = strconv.Atoi(limitStr); err != nil {
+			rw.Error(http.StatusBadRequest, "Parameter limit must be an integer")
+			return
+		}
+	}
+
+	feed, err := h.service.GetFeed(query.Get("cursor"), limit, currentUser.UserID)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Success(feed)
+}
+
+// setFollowing подписывает текущего пользователя на автора или отменяет подписку
+func (h *FeedHandler) setFollowing(w http.ResponseWriter, r *http.Request, method string, follow bool) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, method) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	// Извлекаем ID автора из path параметров
+	vars := mux.Vars(r)
+	id, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid user ID")
+		return
+	}
+
+	if follow {
+		err = h.service.Follow(id, currentUser.UserID)
+	} else {
+		err = h.service.Unfollow(id, currentUser.UserID)
+	}
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	if follow {
+		rw.Success("User followed successfully")
+	} else {
+		rw.Success("User unfollowed successfully")
+	}
+}
+
+// handleServiceError обрабатывает ошибки сервиса
+func (h *FeedHandler) handleServiceError(rw *ResponseWriter, err error) {
+	switch err {
+	case services.ErrUserNotFound:
+		rw.Error(http.StatusNotFound, "User not found")
+	case services.ErrCannotFollowSelf:
+		rw.Error(http.StatusBadRequest, "Users cannot follow themselves")
+	case services.ErrInvalidFeedCursor:
+		rw.Error(http.StatusBadRequest, "Invalid feed cursor")
+	case services.ErrInvalidFeedLimit:
+		rw.Error(http.StatusBadRequest, "Feed limit must be between 1 and 50")
+	default:
+		rw.Error(http.StatusInternalServerError, "Internal server error")
+	}
+}
@@ -0,0 +1,236 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"meawle/internal/middleware"
+	"meawle/internal/models"
+	"meawle/internal/services"
+
+	"github.com/gorilla/mux"
+)
+
+// HealthRecordHandler представляет хэндлер для работы с медицинской историей котов
+type HealthRecordHandler struct {
+	service *services.HealthRecordService
+}
+
+// NewHealthRecordHandler создает новый экземпляр хэндлера медицинских записей
+func NewHealthRecordHandler(service *services.HealthRecordService) *HealthRecordHandler {
+	return &HealthRecordHandler{service: service}
+}
+
+// Create обрабатывает создание медицинской записи кота
+func (h *HealthRecordHandler) Create(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodPost) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	// Извлекаем ID кота из path параметров
+	vars := mux.Vars(r)
+	catID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid cat ID")
+		return
+	}
+
+	var req models.HealthRecordCreateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	record, err := h.service.Create(catID, &req, currentUser.UserID, currentUser.IsAdmin)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Created(record)
+}
+
+// GetCatRecords обрабатывает получение медицинской истории кота
+func (h *HealthRecordHandler) GetCatRecords(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodGet) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	// Извлекаем ID кота из path параметров
+	vars := mux.Vars(r)
+	catID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid cat ID")
+		return
+	}
+
+	// Необязательный фильтр по типу записи
+	var recordType *string
+	if t := r.URL.Query().Get("type"); t != "" {
+		recordType = &t
+	}
+
+	records, err := h.service.GetCatRecords(catID, recordType, currentUser.UserID, currentUser.IsAdmin)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Success(records)
+}
+
+// GetRecord обрабатывает получение медицинской записи по ID
+func (h *HealthRecordHandler) GetRecord(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodGet) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	catID, recordID, ok := parseHealthRecordPath(rw, r)
+	if !ok {
+		return
+	}
+
+	record, err := h.service.GetRecord(catID, recordID, currentUser.UserID, currentUser.IsAdmin)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Success(record)
+}
+
+// UpdateRecord обрабатывает обновление медицинской записи
+func (h *HealthRecordHandler) UpdateRecord(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodPut) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	catID, recordID, ok := parseHealthRecordPath(rw, r)
+	if !ok {
+		return
+	}
+
+	var req models.HealthRecordUpdateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	err := h.service.UpdateRecord(catID, recordID, &req, currentUser.UserID, currentUser.IsAdmin)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Success("Health record updated successfully")
+}
+
+// DeleteRecord обрабатывает удаление медицинской записи
+func (h *HealthRecordHandler) DeleteRecord(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodDelete) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	catID, recordID, ok := parseHealthRecordPath(rw, r)
+	if !ok {
+		return
+	}
+
+	err := h.service.DeleteRecord(catID, recordID, currentUser.UserID, currentUser.IsAdmin)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Success("Health record deleted successfully")
+}
+
+// parseHealthRecordPath извлекает ID кота и ID записи из path параметров
+func parseHealthRecordPath(rw *ResponseWriter, r *http.Request) (int, int, bool) {
+	vars := mux.Vars(r)
+
+	catID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid cat ID")
+		return 0, 0, false
+	}
+
+	recordID, err := strconv.Atoi(vars["recordId"])
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid health record ID")
+		return 0, 0, false
+	}
+
+	return catID, recordID, true
+}
+
+// handleServiceError обрабатывает ошибки сервиса
+func (h *HealthRecordHandler) handleServiceError(rw *ResponseWriter, err error) {
+	switch err {
+	case services.ErrCatNotFound:
+		rw.Error(http.StatusNotFound, "Cat not found")
+	case services.ErrHealthRecordNotFound:
+		rw.Error(http.StatusNotFound, "Health record not found")
+	case services.ErrInvalidHealthRecordData:
+		rw.Error(http.StatusBadRequest, "Invalid health record data")
+	case services.ErrInvalidHealthRecordType:
+		rw.Error(http.StatusBadRequest, "Health record type must be vaccination, vet_visit, medication or allergy")
+	case services.ErrInvalidHealthRecordDate:
+		rw.Error(http.StatusBadRequest, "Health record dates must be YYYY-MM-DD and due date cannot be before record date")
+	case services.ErrAccessDenied:
+		rw.Error(http.StatusForbidden, "Access denied")
+	default:
+		rw.Error(http.StatusInternalServerError, "Internal server error")
+	}
+}
@@ -0,0 +1,270 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"meawle/internal/middleware"
+	"meawle/internal/models"
+	"meawle/internal/services"
+
+	"github.com/gorilla/mux"
+)
+
+// ListingHandler представляет хэндлер для объявлений о пристройстве котов
+type ListingHandler struct {
+	service *services.ListingService
+}
+
+// NewListingHandler создает новый экземпляр хэндлера объявлений
+func NewListingHandler(service *services.ListingService) *ListingHandler {
+	return &ListingHandler{service: service}
+}
+
+// Create обрабатывает создание объявления
+func (h *ListingHandler) Create(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodPost) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	var req models.ListingCreateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	listing, err := h.service.Create(&req, currentUser.UserID, currentUser.IsAdmin)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Created(listing)
+}
+
+// GetListing обрабатывает получение объявления по ID
+func (h *ListingHandler) GetListing(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodGet) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Извлекаем ID из path параметров
+	vars := mux.Vars(r)
+	id, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid listing ID")
+		return
+	}
+
+	userID, isAdmin := currentViewer(r)
+
+	listing, err := h.service.GetListing(id, userID, isAdmin)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Success(listing)
+}
+
+// Search обрабатывает поиск объявлений
+func (h *ListingHandler) Search(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodGet) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	filter, err := parseListingFilter(r)
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid filter parameters")
+		return
+	}
+
+	userID, isAdmin := currentViewer(r)
+	listings, err := h.service.Search(filter, userID, isAdmin)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Success(listings)
+}
+
+// GetUserListings обрабатывает получение объявлений текущего пользователя
+func (h *ListingHandler) GetUserListings(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodGet) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	listings, err := h.service.GetUserListings(currentUser.UserID)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Success(listings)
+}
+
+// UpdateListing обрабатывает обновление объявления
+func (h *ListingHandler) UpdateListing(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodPut) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	// Извлекаем ID из path параметров
+	vars := mux.Vars(r)
+	id, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid listing ID")
+		return
+	}
+
+	var req models.ListingUpdateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	err = h.service.UpdateListing(id, &req, currentUser.UserID, currentUser.IsAdmin)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Success("Listing updated successfully")
+}
+
+// DeleteListing обрабатывает удаление объявления
+func (h *ListingHandler) DeleteListing(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodDelete) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	// Извлекаем ID из path параметров
+	vars := mux.Vars(r)
+	id, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid listing ID")
+		return
+	}
+
+	err = h.service.DeleteListing(id, currentUser.UserID, currentUser.IsAdmin)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Success("Listing deleted successfully")
+}
+
+// parseListingFilter извлекает параметры поиска объявлений из query строки
+func parseListingFilter(r *http.Request) (*models.ListingFilter, error) {
+	query := r.URL.Query()
+	filter := &models.ListingFilter{}
+
+	if status := query.Get("status"); status != "" {
+		filter.Status = &status
+	}
+
+	if location := query.Get("location"); location != "" {
+		filter.Location = &location
+	}
+
+	if q := query.Get("q"); q != "" {
+		filter.Query = &q
+	}
+
+	if sex := query.Get("sex"); sex != "" {
+		filter.CatSex = &sex
+	}
+
+	if maxFeeStr := query.Get("max_fee"); maxFeeStr != "" {
+		maxFee, err := strconv.ParseFloat(maxFeeStr, 64)
+		if err != nil {
+			return nil, err
+		}
+		filter.MaxFee = &maxFee
+	}
+
+	if userIDStr := query.Get("user_id"); userIDStr != "" {
+		userID, err := strconv.Atoi(userIDStr)
+		if err != nil {
+			return nil, err
+		}
+		filter.UserID = &userID
+	}
+
+	return filter, nil
+}
+
+// handleServiceError обрабатывает ошибки сервиса
+func (h *ListingHandler) handleServiceError(rw *ResponseWriter, err error) {
+	switch err {
+	case services.ErrListingNotFound:
+		rw.Error(http.StatusNotFound, "Listing not found")
+	case services.ErrCatNotFound:
+		rw.Error(http.StatusNotFound, "Cat not found")
+	case services.ErrInvalidListingData:
+		rw.Error(http.StatusBadRequest, "Invalid listing data")
+	case services.ErrInvalidListingStatus:
+		rw.Error(http.StatusBadRequest, "Invalid listing status")
+	case services.ErrInvalidCatSex:
+		rw.Error(http.StatusBadRequest, "Cat sex must be male or female")
+	case services.ErrListingCatPrivate:
+		rw.Error(http.StatusBadRequest, "Private cat cannot be listed for adoption")
+	case services.ErrListingExists:
+		rw.Error(http.StatusConflict, "Cat already has an active listing")
+	case services.ErrListingClosed:
+		rw.Error(http.StatusConflict, "Listing is closed")
+	case services.ErrAccessDenied:
+		rw.Error(http.StatusForbidden, "Access denied")
+	default:
+		rw.Error(http.StatusInternalServerError, "Internal server error")
+	}
+}
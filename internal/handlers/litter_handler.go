@@ -0,0 +1,282 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"meawle/internal/middleware"
+	"meawle/internal/models"
+	"meawle/internal/services"
+
+	"github.com/gorilla/mux"
+)
+
+// LitterHandler представляет хэндлер для работы с пометами
+type LitterHandler struct {
+	service *services.LitterService
+}
+
+// NewLitterHandler создает новый экземпляр хэндлера пометов
+func NewLitterHandler(service *services.LitterService) *LitterHandler {
+	return &LitterHandler{service: service}
+}
+
+// Create обрабатывает регистрацию помета вместе с котятами
+func (h *LitterHandler) Create(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodPost) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	var req models.LitterCreateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	litter, err := h.service.Create(&req, currentUser.UserID, currentUser.IsAdmin)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Created(litter)
+}
+
+// GetLitter обрабатывает получение помета со списком котят
+func (h *LitterHandler) GetLitter(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodGet) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Извлекаем ID из path параметров
+	vars := mux.Vars(r)
+	id, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid litter ID")
+		return
+	}
+
+	userID, isAdmin := currentViewer(r)
+
+	litter, err := h.service.GetLitter(id, userID, isAdmin)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Success(litter)
+}
+
+// GetLitters обрабатывает получение списка пометов
+func (h *LitterHandler) GetLitters(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodGet) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	filter, err := parseLitterFilter(r)
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid filter parameters")
+		return
+	}
+
+	userID, isAdmin := currentViewer(r)
+
+	litters, err := h.service.GetLitters(filter, userID, isAdmin)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Success(litters)
+}
+
+// UpdateLitter обрабатывает обновление помета
+func (h *LitterHandler) UpdateLitter(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodPut) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	// Извлекаем ID из path параметров
+	vars := mux.Vars(r)
+	id, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid litter ID")
+		return
+	}
+
+	var req models.LitterUpdateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	err = h.service.UpdateLitter(id, &req, currentUser.UserID, currentUser.IsAdmin)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Success("Litter updated successfully")
+}
+
+// DeleteLitter обрабатывает удаление помета
+func (h *LitterHandler) DeleteLitter(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodDelete) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	// Извлекаем ID из path параметров
+	vars := mux.Vars(r)
+	id, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid litter ID")
+		return
+	}
+
+	err = h.service.DeleteLitter(id, currentUser.UserID, currentUser.IsAdmin)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Success("Litter deleted successfully")
+}
+
+// UpdateKittenAvailability обрабатывает изменение статуса котенка из помета
+func (h *LitterHandler) UpdateKittenAvailability(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodPut) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	// Извлекаем ID помета и котенка из path параметров
+	vars := mux.Vars(r)
+	litterID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid litter ID")
+		return
+	}
+	catID, err := strconv.Atoi(vars["catId"])
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid cat ID")
+		return
+	}
+
+	var req models.KittenAvailabilityRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	err = h.service.UpdateKittenAvailability(litterID, catID, &req, currentUser.UserID, currentUser.IsAdmin)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Success("Kitten availability updated successfully")
+}
+
+// parseLitterFilter извлекает параметры фильтрации пометов из query строки
+func parseLitterFilter(r *http.Request) (*models.LitterFilter, error) {
+	query := r.URL.Query()
+	filter := &models.LitterFilter{}
+
+	if parentStr := query.Get("parent_id"); parentStr != "" {
+		parentID, err := strconv.Atoi(parentStr)
+		if err != nil {
+			return nil, err
+		}
+		filter.ParentID = &parentID
+	}
+
+	if availableStr := query.Get("available"); availableStr != "" {
+		available, err := strconv.ParseBool(availableStr)
+		if err != nil {
+			return nil, err
+		}
+		filter.Available = &available
+	}
+
+	return filter, nil
+}
+
+// handleServiceError обрабатывает ошибки сервиса
+func (h *LitterHandler) handleServiceError(rw *ResponseWriter, err error) {
+	switch err {
+	case services.ErrLitterNotFound:
+		rw.Error(http.StatusNotFound, "Litter not found")
+	case services.ErrCatNotFound:
+		rw.Error(http.StatusNotFound, "Cat not found")
+	case services.ErrInvalidLitterData:
+		rw.Error(http.StatusBadRequest, "Invalid litter data")
+	case services.ErrInvalidLitterBirthDate:
+		rw.Error(http.StatusBadRequest, "Litter birth date must be YYYY-MM-DD not in the future")
+	case services.ErrInvalidAvailability:
+		rw.Error(http.StatusBadRequest, "Kitten availability must be available, reserved, placed or kept")
+	case services.ErrKittenNotInLitter:
+		rw.Error(http.StatusBadRequest, "Kitten does not belong to litter")
+	case services.ErrInvalidCatSex:
+		rw.Error(http.StatusBadRequest, "Cat sex must be male or female")
+	case services.ErrInvalidCoatPattern:
+		rw.Error(http.StatusBadRequest, "Invalid cat coat pattern")
+	case services.ErrInvalidCatData:
+		rw.Error(http.StatusBadRequest, "Invalid cat data")
+	case services.ErrParentNotFound:
+		rw.Error(http.StatusBadRequest, "Parent not found")
+	case services.ErrInvalidParent:
+		rw.Error(http.StatusBadRequest, "Parent must be either a registered cat or an external ancestor")
+	case services.ErrInvalidParentSex:
+		rw.Error(http.StatusBadRequest, "Sire must be male and dam must be female")
+	case services.ErrAccessDenied:
+		rw.Error(http.StatusForbidden, "Access denied")
+	default:
+		rw.Error(http.StatusInternalServerError, "Internal server error")
+	}
+}
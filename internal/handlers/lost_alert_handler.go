@@ -0,0 +1,114 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"meawle/internal/middleware"
+	"meawle/internal/models"
+	"meawle/internal/services"
+)
+
+// LostAlertHandler представляет хэндлер для подписки на оповещения о потерявшихся рядом котах
+type LostAlertHandler struct {
+	service *services.LostAlertService
+}
+
+// NewLostAlertHandler создает новый экземпляр хэндлера оповещений о потерявшихся котах
+func NewLostAlertHandler(service *services.LostAlertService) *LostAlertHandler {
+	return &LostAlertHandler{service: service}
+}
+
+// GetSubscription обрабатывает получение подписки текущего пользователя
+func (h *LostAlertHandler) GetSubscription(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodGet) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	subscription, err := h.service.GetSubscription(currentUser.UserID)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Success(subscription)
+}
+
+// Subscribe обрабатывает создание или замену подписки текущего пользователя
+func (h *LostAlertHandler) Subscribe(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodPut) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	var req models.LostAlertSubscriptionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	subscription, err := h.service.Subscribe(&req, currentUser.UserID)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Success(subscription)
+}
+
+// Unsubscribe обрабатывает удаление подписки текущего пользователя
+func (h *LostAlertHandler) Unsubscribe(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodDelete) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	if err := h.service.Unsubscribe(currentUser.UserID); err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Success("Lost cat alerts disabled successfully")
+}
+
+// handleServiceError обрабатывает ошибки сервиса
+func (h *LostAlertHandler) handleServiceError(rw *ResponseWriter, err error) {
+	switch err {
+	case services.ErrLostAlertNotFound:
+		rw.Error(http.StatusNotFound, "Lost cat alert subscription not found")
+	case services.ErrInvalidCoordinates:
+		rw.Error(http.StatusBadRequest, "Latitude must be between -90 and 90 and longitude between -180 and 180")
+	case services.ErrInvalidLostAlertRadius:
+		rw.Error(http.StatusBadRequest, "Alert radius must be greater than 0 and not more than 50 km")
+	default:
+		rw.Error(http.StatusInternalServerError, "Internal server error")
+	}
+}
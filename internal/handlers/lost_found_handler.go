@@ -0,0 +1,293 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"meawle/internal/middleware"
+	"meawle/internal/models"
+	"meawle/internal/services"
+
+	"github.com/gorilla/mux"
+)
+
+// LostFoundHandler представляет хэндлер для объявлений о потерянных и найденных котах
+type LostFoundHandler struct {
+	service *services.LostFoundService
+}
+
+// NewLostFoundHandler создает новый экземпляр хэндлера объявлений о потерянных и найденных котах
+func NewLostFoundHandler(service *services.LostFoundService) *LostFoundHandler {
+	return &LostFoundHandler{service: service}
+}
+
+// Create обрабатывает создание объявления
+func (h *LostFoundHandler) Create(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodPost) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	var req models.LostFoundCreateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	report, err := h.service.Create(&req, currentUser.UserID, currentUser.IsAdmin)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Created(report)
+}
+
+// GetReport обрабатывает получение объявления по ID
+func (h *LostFoundHandler) GetReport(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodGet) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Извлекаем ID объявления из path параметров
+	vars := mux.Vars(r)
+	id, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid report ID")
+		return
+	}
+
+	report, err := h.service.GetReport(id)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Success(report)
+}
+
+// Search обрабатывает поиск объявлений рядом с точкой
+func (h *LostFoundHandler) Search(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodGet) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	filter, err := parseLostFoundFilter(r)
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Parameters lat and lon are required, radius_km must be a number")
+		return
+	}
+
+	reports, err := h.service.Search(filter)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Success(reports)
+}
+
+// GetMatches обрабатывает поиск совпадений для объявления
+func (h *LostFoundHandler) GetMatches(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodGet) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Извлекаем ID объявления из path параметров
+	vars := mux.Vars(r)
+	id, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid report ID")
+		return
+	}
+
+	var radiusKm float64
+	if radiusStr := r.URL.Query().Get("radius_km"); radiusStr != "" {
+		radiusKm, err = strconv.ParseFloat(radiusStr, 64)
+		if err != nil {
+			rw.Error(http.StatusBadRequest, "Invalid search radius")
+			return
+		}
+	}
+
+	reports, err := h.service.GetMatches(id, radiusKm)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Success(reports)
+}
+
+// GetUserReports обрабатывает получение объявлений текущего пользователя
+func (h *LostFoundHandler) GetUserReports(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodGet) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	reports, err := h.service.GetUserReports(currentUser.UserID)
+	if err != nil {
+		rw.Error(http.StatusInternalServerError, "Internal server error")
+		return
+	}
+
+	rw.Success(reports)
+}
+
+// UpdateReport обрабатывает обновление объявления
+func (h *LostFoundHandler) UpdateReport(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodPut) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	// Извлекаем ID объявления из path параметров
+	vars := mux.Vars(r)
+	id, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid report ID")
+		return
+	}
+
+	var req models.LostFoundUpdateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	err = h.service.UpdateReport(id, &req, currentUser.UserID, currentUser.IsAdmin)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Success("Report updated successfully")
+}
+
+// DeleteReport обрабатывает удаление объявления
+func (h *LostFoundHandler) DeleteReport(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodDelete) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	// Извлекаем ID объявления из path параметров
+	vars := mux.Vars(r)
+	id, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid report ID")
+		return
+	}
+
+	err = h.service.DeleteReport(id, currentUser.UserID, currentUser.IsAdmin)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Success("Report deleted successfully")
+}
+
+// parseLostFoundFilter извлекает точку и параметры поиска объявлений из query строки.
+// Координаты lat и lon обязательны
+func parseLostFoundFilter(r *http.Request) (*models.LostFoundFilter, error) {
+	query := r.URL.Query()
+	filter := &models.LostFoundFilter{}
+
+	var err error
+	if filter.Latitude, err = strconv.ParseFloat(query.Get("lat"), 64); err != nil {
+		return nil, err
+	}
+	if filter.Longitude, err = strconv.ParseFloat(query.Get("lon"), 64); err != nil {
+		return nil, err
+	}
+
+	if radiusStr := query.Get("radius_km"); radiusStr != "" {
+		if filter.RadiusKm, err = strconv.ParseFloat(radiusStr, 64); err != nil {
+			return nil, err
+		}
+	}
+
+	if reportType := query.Get("type"); reportType != "" {
+		filter.ReportType = &reportType
+	}
+
+	return filter, nil
+}
+
+// handleServiceError обрабатывает ошибки сервиса
+func (h *LostFoundHandler) handleServiceError(rw *ResponseWriter, err error) {
+	switch err {
+	case services.ErrLostFoundNotFound:
+		rw.Error(http.StatusNotFound, "Report not found")
+	case services.ErrCatNotFound:
+		rw.Error(http.StatusNotFound, "Cat not found")
+	case services.ErrCatBreedNotFound:
+		rw.Error(http.StatusBadRequest, "Cat breed not found")
+	case services.ErrInvalidLostFoundData:
+		rw.Error(http.StatusBadRequest, "Invalid report data")
+	case services.ErrInvalidLostFoundType:
+		rw.Error(http.StatusBadRequest, "Report type must be lost or found")
+	case services.ErrInvalidCoordinates:
+		rw.Error(http.StatusBadRequest, "Latitude must be between -90 and 90 and longitude between -180 and 180")
+	case services.ErrInvalidSearchRadius:
+		rw.Error(http.StatusBadRequest, "Search radius must be greater than 0 and not more than 100 km")
+	case services.ErrInvalidLastSeenAt:
+		rw.Error(http.StatusBadRequest, "Last seen time cannot be in the future")
+	case services.ErrInvalidPhotoURLs:
+		rw.Error(http.StatusBadRequest, "Up to 10 photo URLs with http or https scheme are allowed")
+	case services.ErrInvalidCatSex:
+		rw.Error(http.StatusBadRequest, "Cat sex must be male or female")
+	case services.ErrAccessDenied:
+		rw.Error(http.StatusForbidden, "Access denied")
+	default:
+		rw.Error(http.StatusInternalServerError, "Internal server error")
+	}
+}
@@ -0,0 +1,201 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"meawle/internal/middleware"
+	"meawle/internal/models"
+	"meawle/internal/services"
+
+	"github.com/gorilla/mux"
+)
+
+// MeasurementHandler представляет хэндлер для отслеживания веса и роста котов
+type MeasurementHandler struct {
+	service *services.MeasurementService
+}
+
+// NewMeasurementHandler создает новый экземпляр хэндлера измерений
+func NewMeasurementHandler(service *services.MeasurementService) *MeasurementHandler {
+	return &MeasurementHandler{service: service}
+}
+
+// Create обрабатывает добавление измерения кота
+func (h *MeasurementHandler) Create(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodPost) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	// Извлекаем ID кота из path параметров
+	vars := mux.Vars(r)
+	catID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid cat ID")
+		return
+	}
+
+	var req models.MeasurementCreateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	measurement, err := h.service.Create(catID, &req, currentUser.UserID, currentUser.IsAdmin)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Created(measurement)
+}
+
+// GetCatMeasurements обрабатывает получение измерений кота
+func (h *MeasurementHandler) GetCatMeasurements(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodGet) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	// Извлекаем ID кота из path параметров
+	vars := mux.Vars(r)
+	catID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid cat ID")
+		return
+	}
+
+	from, to := parsePeriod(r)
+	measurements, err := h.service.GetCatMeasurements(catID, from, to, currentUser.UserID, currentUser.IsAdmin)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Success(measurements)
+}
+
+// GetWeeklySeries обрабатывает получение среднего веса кота по неделям
+func (h *MeasurementHandler) GetWeeklySeries(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodGet) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	// Извлекаем ID кота из path параметров
+	vars := mux.Vars(r)
+	catID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid cat ID")
+		return
+	}
+
+	from, to := parsePeriod(r)
+	series, err := h.service.GetWeeklySeries(catID, from, to, currentUser.UserID, currentUser.IsAdmin)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Success(series)
+}
+
+// DeleteMeasurement обрабатывает удаление измерения
+func (h *MeasurementHandler) DeleteMeasurement(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodDelete) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	// Извлекаем ID кота и измерения из path параметров
+	vars := mux.Vars(r)
+	catID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid cat ID")
+		return
+	}
+	measurementID, err := strconv.Atoi(vars["measurementId"])
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid measurement ID")
+		return
+	}
+
+	err = h.service.DeleteMeasurement(catID, measurementID, currentUser.UserID, currentUser.IsAdmin)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Success("Measurement deleted successfully")
+}
+
+// parsePeriod извлекает необязательные границы периода from и to из query строки
+func parsePeriod(r *http.Request) (*string, *string) {
+	var from, to *string
+
+	if value := r.URL.Query().Get("from"); value != "" {
+		from = &value
+	}
+
+	if value := r.URL.Query().Get("to"); value != "" {
+		to = &value
+	}
+
+	return from, to
+}
+
+// handleServiceError обрабатывает ошибки сервиса
+func (h *MeasurementHandler) handleServiceError(rw *ResponseWriter, err error) {
+	switch err {
+	case services.ErrCatNotFound:
+		rw.Error(http.StatusNotFound, "Cat not found")
+	case services.ErrMeasurementNotFound:
+		rw.Error(http.StatusNotFound, "Measurement not found")
+	case services.ErrInvalidMeasurementData:
+		rw.Error(http.StatusBadRequest, "Measurement date must be YYYY-MM-DD not in the future, weight between 0 and 30 kg")
+	case services.ErrInvalidMeasurementDate:
+		rw.Error(http.StatusBadRequest, "Period dates must be YYYY-MM-DD")
+	case services.ErrAccessDenied:
+		rw.Error(http.StatusForbidden, "Access denied")
+	default:
+		rw.Error(http.StatusInternalServerError, "Internal server error")
+	}
+}
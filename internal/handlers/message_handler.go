@@ -0,0 +1,409 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"meawle/internal/middleware"
+	"meawle/internal/models"
+	"meawle/internal/services"
+
+	"github.com/gorilla/mux"
+)
+
+// streamHeartbeatInterval - период комментариев-пингов в потоке событий, чтобы прокси не закрывали соединение
+const streamHeartbeatInterval = 30 * time.Second
+
+// MessageHandler представляет хэндлер для личных сообщений и блокировок пользователей
+type MessageHandler struct {
+	service *services.MessageService
+}
+
+// NewMessageHandler создает новый экземпляр хэндлера сообщений
+func NewMessageHandler(service *services.MessageService) *MessageHandler {
+	return &MessageHandler{service: service}
+}
+
+// StartConversation обрабатывает начало переписки о коте или объявлении
+func (h *MessageHandler) StartConversation(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodPost) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	var req models.ConversationCreateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	conversation, err := h.service.StartConversation(&req, currentUser.UserID, currentUser.IsAdmin)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Created(conversation)
+}
+
+// GetConversations обрабатывает получение переписок текущего пользователя
+func (h *MessageHandler) GetConversations(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodGet) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	conversations, err := h.service.GetConversations(currentUser.UserID)
+	if err != nil {
+		rw.Error(http.StatusInternalServerError, "Internal server error")
+		return
+	}
+
+	rw.Success(conversations)
+}
+
+// GetMessages обрабатывает получение страницы сообщений переписки
+func (h *MessageHandler) GetMessages(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodGet) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	// Извлекаем ID переписки из path параметров
+	vars := mux.Vars(r)
+	id, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid conversation ID")
+		return
+	}
+
+	query := r.URL.Query()
+
+	var limit int
+	if limitStr := query.Get("limit"); limitStr != "" {
+		if limit, err = strconv.Atoi(limitStr); err != nil {
+			rw.Error(http.StatusBadRequest, "Parameter limit must be an integer")
+			return
+		}
+	}
+
+	page, err := h.service.GetMessages(id, query.Get("cursor"), limit, currentUser.UserID)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Success(page)
+}
+
+// SendMessage обрабатывает отправку сообщения в переписку
+func (h *MessageHandler) SendMessage(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodPost) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	// Извлекаем ID переписки из path параметров
+	vars := mux.Vars(r)
+	id, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid conversation ID")
+		return
+	}
+
+	var req models.MessageCreateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	message, err := h.service.SendMessage(id, &req, currentUser.UserID)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Created(message)
+}
+
+// MarkRead обрабатывает отметку переписки прочитанной
+func (h *MessageHandler) MarkRead(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodPost) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	// Извлекаем ID переписки из path параметров
+	vars := mux.Vars(r)
+	id, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid conversation ID")
+		return
+	}
+
+	if err := h.service.MarkRead(id, currentUser.UserID); err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Success("Conversation marked as read")
+}
+
+// GetUnreadCount обрабатывает получение числа непрочитанных сообщений
+func (h *MessageHandler) GetUnreadCount(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodGet) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	unread, err := h.service.GetUnreadCount(currentUser.UserID)
+	if err != nil {
+		rw.Error(http.StatusInternalServerError, "Internal server error")
+		return
+	}
+
+	rw.Success(unread)
+}
+
+// Stream обрабатывает подключение к потоку событий сообщений (Server-Sent Events).
+// Поток открыт, пока клиент не отключится или сервер не начнет остановку
+func (h *MessageHandler) Stream(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodGet) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	// Поток живет дольше таймаута записи сервера, поэтому снимаем его для этого соединения
+	rc := http.NewResponseController(w)
+	if err := rc.SetWriteDeadline(time.Time{}); err != nil {
+		rw.Error(http.StatusInternalServerError, "Streaming is not supported")
+		return
+	}
+
+	events, unsubscribe := h.service.Subscribe(currentUser.UserID)
+	defer unsubscribe()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	// Сразу отправляем число непрочитанных, чтобы клиент не запрашивал его отдельно после подключения
+	if unread, err := h.service.GetUnreadCount(currentUser.UserID); err == nil {
+		if writeStreamEvent(w, "unread", unread) != nil {
+			return
+		}
+	}
+	if rc.Flush() != nil {
+		return
+	}
+
+	heartbeat := time.NewTicker(streamHeartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+			if writeStreamEvent(w, event.Type, event) != nil {
+				return
+			}
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(w, ": ping\n\n"); err != nil {
+				return
+			}
+		}
+
+		if rc.Flush() != nil {
+			return
+		}
+	}
+}
+
+// BlockUser обрабатывает блокировку пользователя
+func (h *MessageHandler) BlockUser(w http.ResponseWriter, r *http.Request) {
+	h.setBlocked(w, r, http.MethodPost, true)
+}
+
+// UnblockUser обрабатывает снятие блокировки пользователя
+func (h *MessageHandler) UnblockUser(w http.ResponseWriter, r *http.Request) {
+	h.setBlocked(w, r, http.MethodDelete, false)
+}
+
+// GetBlockedUsers обрабатывает получение пользователей, заблокированных текущим пользователем
+func (h *MessageHandler) GetBlockedUsers(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodGet) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	blocks, err := h.service.GetBlockedUsers(currentUser.UserID)
+	if err != nil {
+		rw.Error(http.StatusInternalServerError, "Internal server error")
+		return
+	}
+
+	rw.Success(blocks)
+}
+
+// setBlocked блокирует пользователя или снимает блокировку
+func (h *MessageHandler) setBlocked(w http.ResponseWriter, r *http.Request, method string, blocked bool) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, method) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	// Извлекаем ID пользователя из path параметров
+	vars := mux.Vars(r)
+	id, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid user ID")
+		return
+	}
+
+	if blocked {
+		err = h.service.BlockUser(id, currentUser.UserID)
+	} else {
+		err = h.service.UnblockUser(id, currentUser.UserID)
+	}
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	if blocked {
+		rw.Success("User blocked successfully")
+	} else {
+		rw.Success("User unblocked successfully")
+	}
+}
+
+// writeStreamEvent записывает событие в поток в формате Server-Sent Events
+func writeStreamEvent(w http.ResponseWriter, eventType string, data interface{}) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", eventType, payload)
+	return err
+}
+
+// handleServiceError обрабатывает ошибки сервиса
+func (h *MessageHandler) handleServiceError(rw *ResponseWriter, err error) {
+	switch err {
+	case services.ErrConversationNotFound:
+		rw.Error(http.StatusNotFound, "Conversation not found")
+	case services.ErrCatNotFound:
+		rw.Error(http.StatusNotFound, "Cat not found")
+	case services.ErrListingNotFound:
+		rw.Error(http.StatusNotFound, "Listing not found")
+	case services.ErrUserNotFound:
+		rw.Error(http.StatusNotFound, "User not found")
+	case services.ErrInvalidConversationData:
+		rw.Error(http.StatusBadRequest, "Conversation must be about exactly one cat or listing")
+	case services.ErrInvalidMessageData:
+		rw.Error(http.StatusBadRequest, "Message must be between 1 and 2000 characters")
+	case services.ErrInvalidMessageCursor:
+		rw.Error(http.StatusBadRequest, "Invalid message cursor")
+	case services.ErrInvalidMessageLimit:
+		rw.Error(http.StatusBadRequest, "Message limit must be between 1 and 100")
+	case services.ErrCannotMessageSelf:
+		rw.Error(http.StatusBadRequest, "Users cannot message themselves")
+	case services.ErrCannotBlockSelf:
+		rw.Error(http.StatusBadRequest, "Users cannot block themselves")
+	case services.ErrUserBlocked:
+		rw.Error(http.StatusForbidden, "Messaging between these users is blocked")
+	default:
+		rw.Error(http.StatusInternalServerError, "Internal server error")
+	}
+}
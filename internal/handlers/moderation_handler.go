@@ -0,0 +1,265 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"meawle/internal/middleware"
+	"meawle/internal/models"
+	"meawle/internal/services"
+
+	"github.com/gorilla/mux"
+)
+
+// ModerationHandler представляет хэндлер для жалоб и очереди модерации
+type ModerationHandler struct {
+	service *services.ModerationService
+}
+
+// NewModerationHandler создает новый экземпляр хэндлера модерации
+func NewModerationHandler(service *services.ModerationService) *ModerationHandler {
+	return &ModerationHandler{service: service}
+}
+
+// CreateReport обрабатывает создание жалобы
+func (h *ModerationHandler) CreateReport(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodPost) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	var req models.ContentReportCreateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	report, err := h.service.CreateReport(&req, currentUser.UserID, currentUser.IsAdmin)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Created(report)
+}
+
+// GetQueue обрабатывает получение очереди жалоб
+func (h *ModerationHandler) GetQueue(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodGet) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	limit, offset, err := parsePaging(r)
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Parameters limit and offset must be integers")
+		return
+	}
+
+	query := r.URL.Query()
+	filter := &models.ContentReportFilter{
+		Status: query.Get("status"),
+		Limit:  limit,
+		Offset: offset,
+	}
+	if targetType := query.Get("target_type"); targetType != "" {
+		filter.TargetType = &targetType
+	}
+
+	reports, err := h.service.GetQueue(filter, currentUser.UserID, currentUser.IsAdmin)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Success(reports)
+}
+
+// GetReport обрабатывает получение жалобы по ID
+func (h *ModerationHandler) GetReport(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodGet) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	// Извлекаем ID жалобы из path параметров
+	vars := mux.Vars(r)
+	id, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid report ID")
+		return
+	}
+
+	report, err := h.service.GetReport(id, currentUser.UserID, currentUser.IsAdmin)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Success(report)
+}
+
+// ResolveReport обрабатывает решение модератора по жалобе
+func (h *ModerationHandler) ResolveReport(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodPost) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	// Извлекаем ID жалобы из path параметров
+	vars := mux.Vars(r)
+	id, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid report ID")
+		return
+	}
+
+	var req models.ContentReportResolveRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	report, err := h.service.ResolveReport(id, &req, currentUser.UserID, currentUser.IsAdmin)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Success(report)
+}
+
+// UnbanUser обрабатывает снятие блокировки с пользователя
+func (h *ModerationHandler) UnbanUser(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodDelete) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	// Извлекаем ID пользователя из path параметров
+	vars := mux.Vars(r)
+	id, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid user ID")
+		return
+	}
+
+	err = h.service.UnbanUser(id, currentUser.UserID, currentUser.IsAdmin)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Success("User unbanned successfully")
+}
+
+// GetMyWarnings обрабатывает получение предупреждений текущего пользователя
+func (h *ModerationHandler) GetMyWarnings(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodGet) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	warnings, err := h.service.GetUserWarnings(currentUser.UserID)
+	if err != nil {
+		rw.Error(http.StatusInternalServerError, "Internal server error")
+		return
+	}
+
+	rw.Success(warnings)
+}
+
+// handleServiceError обрабатывает ошибки сервиса
+func (h *ModerationHandler) handleServiceError(rw *ResponseWriter, err error) {
+	switch err {
+	case services.ErrReportNotFound:
+		rw.Error(http.StatusNotFound, "Report not found")
+	case services.ErrCatNotFound:
+		rw.Error(http.StatusNotFound, "Cat not found")
+	case services.ErrCatBreedNotFound:
+		rw.Error(http.StatusNotFound, "Cat breed not found")
+	case services.ErrCommentNotFound:
+		rw.Error(http.StatusNotFound, "Comment not found")
+	case services.ErrUserNotFound:
+		rw.Error(http.StatusNotFound, "User not found")
+	case services.ErrInvalidReportTarget:
+		rw.Error(http.StatusBadRequest, "Report target must be cat, breed, comment or user")
+	case services.ErrInvalidReportReason:
+		rw.Error(http.StatusBadRequest, "Report reason must be spam, abuse, inappropriate or other")
+	case services.ErrInvalidReportDetails:
+		rw.Error(http.StatusBadRequest, "Report details and notes must not exceed 1000 characters")
+	case services.ErrInvalidReportStatus:
+		rw.Error(http.StatusBadRequest, "Report status must be open or resolved")
+	case services.ErrInvalidModerationAction:
+		rw.Error(http.StatusBadRequest, "Moderation action must be dismiss, hide, warn or ban")
+	case services.ErrModerationActionNotAllowed:
+		rw.Error(http.StatusBadRequest, "Users can only be warned or banned")
+	case services.ErrInvalidModerationPaging:
+		rw.Error(http.StatusBadRequest, "Limit must be between 1 and 100 and offset not negative")
+	case services.ErrCannotReportSelf:
+		rw.Error(http.StatusBadRequest, "Users cannot report themselves")
+	case services.ErrReportExists:
+		rw.Error(http.StatusConflict, "Report for this content is already open")
+	case services.ErrReportResolved:
+		rw.Error(http.StatusConflict, "Report is already resolved")
+	case services.ErrAccessDenied:
+		rw.Error(http.StatusForbidden, "Access denied")
+	default:
+		rw.Error(http.StatusInternalServerError, "Internal server error")
+	}
+}
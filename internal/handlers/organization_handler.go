@@ -0,0 +1,379 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"meawle/internal/middleware"
+	"meawle/internal/models"
+	"meawle/internal/services"
+
+	"github.com/gorilla/mux"
+)
+
+// OrganizationHandler представляет хэндлер для работы с организациями и их сотрудниками
+type OrganizationHandler struct {
+	service *services.OrganizationService
+}
+
+// NewOrganizationHandler создает новый экземпляр хэндлера организаций
+func NewOrganizationHandler(service *services.OrganizationService) *OrganizationHandler {
+	return &OrganizationHandler{service: service}
+}
+
+// Create обрабатывает создание организации
+func (h *OrganizationHandler) Create(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodPost) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	var req models.OrganizationCreateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	org, err := h.service.Create(&req, currentUser.UserID)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Created(org)
+}
+
+// GetOrganization обрабатывает получение организации по ID
+func (h *OrganizationHandler) GetOrganization(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodGet) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Извлекаем ID организации из path параметров
+	vars := mux.Vars(r)
+	id, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid organization ID")
+		return
+	}
+
+	userID, _ := currentViewer(r)
+
+	org, err := h.service.GetOrganization(id, userID)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Success(org)
+}
+
+// GetAllOrganizations обрабатывает получение всех организаций
+func (h *OrganizationHandler) GetAllOrganizations(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodGet) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	userID, _ := currentViewer(r)
+
+	orgs, err := h.service.GetAllOrganizations(userID)
+	if err != nil {
+		rw.Error(http.StatusInternalServerError, "Internal server error")
+		return
+	}
+
+	rw.Success(orgs)
+}
+
+// GetUserOrganizations обрабатывает получение организаций, где текущий пользователь сотрудник
+func (h *OrganizationHandler) GetUserOrganizations(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodGet) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	orgs, err := h.service.GetUserOrganizations(currentUser.UserID)
+	if err != nil {
+		rw.Error(http.StatusInternalServerError, "Internal server error")
+		return
+	}
+
+	rw.Success(orgs)
+}
+
+// UpdateOrganization обрабатывает обновление организации
+func (h *OrganizationHandler) UpdateOrganization(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodPut) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	// Извлекаем ID организации из path параметров
+	vars := mux.Vars(r)
+	id, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid organization ID")
+		return
+	}
+
+	var req models.OrganizationUpdateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	err = h.service.UpdateOrganization(id, &req, currentUser.UserID, currentUser.IsAdmin)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Success("Organization updated successfully")
+}
+
+// DeleteOrganization обрабатывает удаление организации
+func (h *OrganizationHandler) DeleteOrganization(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodDelete) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	// Извлекаем ID организации из path параметров
+	vars := mux.Vars(r)
+	id, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid organization ID")
+		return
+	}
+
+	err = h.service.DeleteOrganization(id, currentUser.UserID, currentUser.IsAdmin)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Success("Organization deleted successfully")
+}
+
+// GetMembers обрабатывает получение сотрудников организации
+func (h *OrganizationHandler) GetMembers(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodGet) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	// Извлекаем ID организации из path параметров
+	vars := mux.Vars(r)
+	id, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid organization ID")
+		return
+	}
+
+	members, err := h.service.GetMembers(id, currentUser.UserID, currentUser.IsAdmin)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Success(members)
+}
+
+// AddMember обрабатывает добавление сотрудника организации по email
+func (h *OrganizationHandler) AddMember(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodPost) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	// Извлекаем ID организации из path параметров
+	vars := mux.Vars(r)
+	id, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid organization ID")
+		return
+	}
+
+	var req models.OrganizationMemberAddRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	member, err := h.service.AddMember(id, &req, currentUser.UserID, currentUser.IsAdmin)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Created(member)
+}
+
+// UpdateMemberRole обрабатывает изменение роли сотрудника организации
+func (h *OrganizationHandler) UpdateMemberRole(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodPut) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	// Извлекаем ID организации и сотрудника из path параметров
+	vars := mux.Vars(r)
+	id, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid organization ID")
+		return
+	}
+	memberID, err := strconv.Atoi(vars["memberId"])
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid member ID")
+		return
+	}
+
+	var req models.OrganizationMemberUpdateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	err = h.service.UpdateMemberRole(id, memberID, &req, currentUser.UserID, currentUser.IsAdmin)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Success("Organization member role updated successfully")
+}
+
+// RemoveMember обрабатывает удаление сотрудника из организации
+func (h *OrganizationHandler) RemoveMember(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodDelete) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	// Извлекаем ID организации и сотрудника из path параметров
+	vars := mux.Vars(r)
+	id, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid organization ID")
+		return
+	}
+	memberID, err := strconv.Atoi(vars["memberId"])
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid member ID")
+		return
+	}
+
+	err = h.service.RemoveMember(id, memberID, currentUser.UserID, currentUser.IsAdmin)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Success("Organization member removed successfully")
+}
+
+// handleServiceError обрабатывает ошибки сервиса
+func (h *OrganizationHandler) handleServiceError(rw *ResponseWriter, err error) {
+	switch err {
+	case services.ErrOrganizationNotFound:
+		rw.Error(http.StatusNotFound, "Organization not found")
+	case services.ErrOrganizationMemberNotFound:
+		rw.Error(http.StatusNotFound, "Organization member not found")
+	case services.ErrInviteeNotFound:
+		rw.Error(http.StatusNotFound, "User not found")
+	case services.ErrOrganizationNameExists:
+		rw.Error(http.StatusConflict, "Organization name already exists")
+	case services.ErrOrganizationMemberExists:
+		rw.Error(http.StatusConflict, "User is already a member of the organization")
+	case services.ErrLastOrganizationAdmin:
+		rw.Error(http.StatusConflict, "Organization must keep at least one admin")
+	case services.ErrInvalidOrganizationData:
+		rw.Error(http.StatusBadRequest, "Invalid organization data")
+	case services.ErrInvalidOrganizationRole:
+		rw.Error(http.StatusBadRequest, "Organization role must be admin or staff")
+	case services.ErrAccessDenied:
+		rw.Error(http.StatusForbidden, "Access denied")
+	default:
+		rw.Error(http.StatusInternalServerError, "Internal server error")
+	}
+}
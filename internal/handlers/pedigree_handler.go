@@ -0,0 +1,185 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"meawle/internal/middleware"
+	"meawle/internal/models"
+	"meawle/internal/services"
+
+	"github.com/gorilla/mux"
+)
+
+// PedigreeHandler представляет хэндлер для работы с родословными котов
+type PedigreeHandler struct {
+	service *services.PedigreeService
+}
+
+// NewPedigreeHandler создает новый экземпляр хэндлера родословных
+func NewPedigreeHandler(service *services.PedigreeService) *PedigreeHandler {
+	return &PedigreeHandler{service: service}
+}
+
+// GetPedigree обрабатывает получение родословной кота
+func (h *PedigreeHandler) GetPedigree(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodGet) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Извлекаем ID кота из path параметров
+	vars := mux.Vars(r)
+	catID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid cat ID")
+		return
+	}
+
+	generations, err := parseGenerations(r)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	userID, isAdmin := currentViewer(r)
+
+	pedigree, err := h.service.GetPedigree(catID, generations, userID, isAdmin)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Success(pedigree)
+}
+
+// GetDescendants обрабатывает получение потомков кота
+func (h *PedigreeHandler) GetDescendants(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodGet) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Извлекаем ID кота из path параметров
+	vars := mux.Vars(r)
+	catID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid cat ID")
+		return
+	}
+
+	generations, err := parseGenerations(r)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	userID, isAdmin := currentViewer(r)
+
+	descendants, err := h.service.GetDescendants(catID, generations, userID, isAdmin)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Success(descendants)
+}
+
+// CreateExternalAncestor обрабатывает создание внешнего предка
+func (h *PedigreeHandler) CreateExternalAncestor(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodPost) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	var req models.ExternalAncestorCreateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	ancestor, err := h.service.CreateExternalAncestor(&req, currentUser.UserID)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Created(ancestor)
+}
+
+// GetExternalAncestor обрабатывает получение внешнего предка
+func (h *PedigreeHandler) GetExternalAncestor(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodGet) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Извлекаем ID предка из path параметров
+	vars := mux.Vars(r)
+	id, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid ancestor ID")
+		return
+	}
+
+	ancestor, err := h.service.GetExternalAncestor(id)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Success(ancestor)
+}
+
+// parseGenerations извлекает необязательное число поколений из query строки
+func parseGenerations(r *http.Request) (int, error) {
+	value := r.URL.Query().Get("generations")
+	if value == "" {
+		return services.DefaultPedigreeGenerations, nil
+	}
+
+	generations, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, services.ErrInvalidGenerations
+	}
+
+	return generations, nil
+}
+
+// handleServiceError обрабатывает ошибки сервиса
+func (h *PedigreeHandler) handleServiceError(rw *ResponseWriter, err error) {
+	switch err {
+	case services.ErrCatNotFound:
+		rw.Error(http.StatusNotFound, "Cat not found")
+	case services.ErrExternalAncestorNotFound:
+		rw.Error(http.StatusNotFound, "External ancestor not found")
+	case services.ErrInvalidAncestorData:
+		rw.Error(http.StatusBadRequest, "Invalid external ancestor data")
+	case services.ErrInvalidCatSex:
+		rw.Error(http.StatusBadRequest, "Cat sex must be male or female")
+	case services.ErrParentNotFound:
+		rw.Error(http.StatusBadRequest, "Parent not found")
+	case services.ErrInvalidParentSex:
+		rw.Error(http.StatusBadRequest, "Sire must be male and dam must be female")
+	case services.ErrInvalidGenerations:
+		rw.Error(http.StatusBadRequest, "Generations must be a number from 1 to 10")
+	default:
+		rw.Error(http.StatusInternalServerError, "Internal server error")
+	}
+}
@@ -0,0 +1,202 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"meawle/internal/middleware"
+	"meawle/internal/models"
+	"meawle/internal/services"
+
+	"github.com/gorilla/mux"
+)
+
+// ReminderHandler представляет хэндлер для работы с напоминаниями по уходу за котами
+type ReminderHandler struct {
+	service *services.ReminderService
+}
+
+// NewReminderHandler создает новый экземпляр хэндлера напоминаний
+func NewReminderHandler(service *services.ReminderService) *ReminderHandler {
+	return &ReminderHandler{service: service}
+}
+
+// Create обрабатывает создание напоминания
+func (h *ReminderHandler) Create(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodPost) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	// Извлекаем ID кота из path параметров
+	vars := mux.Vars(r)
+	catID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid cat ID")
+		return
+	}
+
+	var req models.ReminderCreateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	reminder, err := h.service.Create(catID, &req, currentUser.UserID, currentUser.IsAdmin)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Created(reminder)
+}
+
+// GetCatReminders обрабатывает получение напоминаний кота
+func (h *ReminderHandler) GetCatReminders(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodGet) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	// Извлекаем ID кота из path параметров
+	vars := mux.Vars(r)
+	catID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid cat ID")
+		return
+	}
+
+	reminders, err := h.service.GetCatReminders(catID, currentUser.UserID, currentUser.IsAdmin)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Success(reminders)
+}
+
+// UpdateReminder обрабатывает обновление напоминания
+func (h *ReminderHandler) UpdateReminder(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodPut) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	catID, reminderID, ok := parseReminderPath(rw, r)
+	if !ok {
+		return
+	}
+
+	var req models.ReminderUpdateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	err := h.service.UpdateReminder(catID, reminderID, &req, currentUser.UserID, currentUser.IsAdmin)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Success("Reminder updated successfully")
+}
+
+// DeleteReminder обрабатывает удаление напоминания
+func (h *ReminderHandler) DeleteReminder(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodDelete) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	catID, reminderID, ok := parseReminderPath(rw, r)
+	if !ok {
+		return
+	}
+
+	err := h.service.DeleteReminder(catID, reminderID, currentUser.UserID, currentUser.IsAdmin)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Success("Reminder deleted successfully")
+}
+
+// parseReminderPath извлекает ID кота и ID напоминания из path параметров
+func parseReminderPath(rw *ResponseWriter, r *http.Request) (int, int, bool) {
+	vars := mux.Vars(r)
+
+	catID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid cat ID")
+		return 0, 0, false
+	}
+
+	reminderID, err := strconv.Atoi(vars["reminderId"])
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid reminder ID")
+		return 0, 0, false
+	}
+
+	return catID, reminderID, true
+}
+
+// handleServiceError обрабатывает ошибки сервиса
+func (h *ReminderHandler) handleServiceError(rw *ResponseWriter, err error) {
+	switch err {
+	case services.ErrCatNotFound:
+		rw.Error(http.StatusNotFound, "Cat not found")
+	case services.ErrReminderNotFound:
+		rw.Error(http.StatusNotFound, "Reminder not found")
+	case services.ErrInvalidReminderData:
+		rw.Error(http.StatusBadRequest, "Invalid reminder data")
+	case services.ErrInvalidReminderType:
+		rw.Error(http.StatusBadRequest, "Reminder type must be vaccination, deworming, flea_treatment, feeding or other")
+	case services.ErrInvalidRecurrenceRule:
+		rw.Error(http.StatusBadRequest, "Invalid recurrence rule")
+	case services.ErrReminderHasNoOccurrence:
+		rw.Error(http.StatusBadRequest, "Reminder has no future occurrences")
+	case services.ErrAccessDenied:
+		rw.Error(http.StatusForbidden, "Access denied")
+	default:
+		rw.Error(http.StatusInternalServerError, "Internal server error")
+	}
+}
@@ -0,0 +1,268 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"meawle/internal/middleware"
+	"meawle/internal/models"
+	"meawle/internal/services"
+
+	"github.com/gorilla/mux"
+)
+
+// TransferHandler представляет хэндлер для передачи котов другим владельцам
+type TransferHandler struct {
+	service *services.TransferService
+}
+
+// NewTransferHandler создает новый экземпляр хэндлера передачи котов
+func NewTransferHandler(service *services.TransferService) *TransferHandler {
+	return &TransferHandler{service: service}
+}
+
+// Initiate обрабатывает начало передачи кота
+func (h *TransferHandler) Initiate(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodPost) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	// Извлекаем ID кота из path параметров
+	vars := mux.Vars(r)
+	catID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid cat ID")
+		return
+	}
+
+	var req models.CatTransferCreateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	transfer, err := h.service.Initiate(catID, &req, currentUser.UserID)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Created(transfer)
+}
+
+// ForceTransfer обрабатывает принудительную передачу кота администратором
+func (h *TransferHandler) ForceTransfer(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodPost) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	// Извлекаем ID кота из path параметров
+	vars := mux.Vars(r)
+	catID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid cat ID")
+		return
+	}
+
+	var req models.CatForceTransferRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	record, err := h.service.ForceTransfer(catID, &req, currentUser.UserID, currentUser.IsAdmin)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Success(record)
+}
+
+// GetHistory обрабатывает получение истории владельцев кота
+func (h *TransferHandler) GetHistory(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodGet) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	// Извлекаем ID кота из path параметров
+	vars := mux.Vars(r)
+	catID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid cat ID")
+		return
+	}
+
+	history, err := h.service.GetHistory(catID, currentUser.UserID, currentUser.IsAdmin)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Success(history)
+}
+
+// GetIncoming обрабатывает получение входящих передач текущего пользователя
+func (h *TransferHandler) GetIncoming(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodGet) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	transfers, err := h.service.GetIncoming(currentUser.UserID)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Success(transfers)
+}
+
+// GetOutgoing обрабатывает получение исходящих передач текущего пользователя
+func (h *TransferHandler) GetOutgoing(w http.ResponseWriter, r *http.Request) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodGet) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	transfers, err := h.service.GetOutgoing(currentUser.UserID)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Success(transfers)
+}
+
+// Accept обрабатывает принятие передачи получателем
+func (h *TransferHandler) Accept(w http.ResponseWriter, r *http.Request) {
+	h.resolve(w, r, func(id int, user *services.JWTClaims) (*models.CatTransferResponse, error) {
+		return h.service.Accept(id, user.UserID)
+	})
+}
+
+// Decline обрабатывает отклонение передачи получателем
+func (h *TransferHandler) Decline(w http.ResponseWriter, r *http.Request) {
+	h.resolve(w, r, func(id int, user *services.JWTClaims) (*models.CatTransferResponse, error) {
+		return h.service.Decline(id, user.UserID)
+	})
+}
+
+// Cancel обрабатывает отмену передачи отправителем или админом
+func (h *TransferHandler) Cancel(w http.ResponseWriter, r *http.Request) {
+	h.resolve(w, r, func(id int, user *services.JWTClaims) (*models.CatTransferResponse, error) {
+		return h.service.Cancel(id, user.UserID, user.IsAdmin)
+	})
+}
+
+// resolve выполняет общую часть обработки решения по передаче
+func (h *TransferHandler) resolve(
+	w http.ResponseWriter,
+	r *http.Request,
+	action func(id int, user *services.JWTClaims) (*models.CatTransferResponse, error),
+) {
+	rw := NewResponseWriter(w)
+
+	if !ValidateMethod(r, http.MethodPost) {
+		rw.Error(ErrMethodNotAllowed.StatusCode, ErrMethodNotAllowed.Message)
+		return
+	}
+
+	// Получаем пользователя из контекста
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		rw.Error(http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	// Извлекаем ID передачи из path параметров
+	vars := mux.Vars(r)
+	id, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		rw.Error(http.StatusBadRequest, "Invalid transfer ID")
+		return
+	}
+
+	transfer, err := action(id, currentUser)
+	if err != nil {
+		h.handleServiceError(rw, err)
+		return
+	}
+
+	rw.Success(transfer)
+}
+
+// handleServiceError обрабатывает ошибки сервиса
+func (h *TransferHandler) handleServiceError(rw *ResponseWriter, err error) {
+	switch err {
+	case services.ErrCatNotFound:
+		rw.Error(http.StatusNotFound, "Cat not found")
+	case services.ErrTransferNotFound:
+		rw.Error(http.StatusNotFound, "Transfer not found")
+	case services.ErrRecipientNotFound:
+		rw.Error(http.StatusNotFound, "Recipient not found")
+	case services.ErrTransferNotPending:
+		rw.Error(http.StatusConflict, "Transfer is already resolved")
+	case services.ErrTransferPending:
+		rw.Error(http.StatusConflict, "Cat already has a pending transfer")
+	case services.ErrCatOwnerChanged:
+		rw.Error(http.StatusConflict, "Cat owner has changed, the transfer is cancelled")
+	case services.ErrInvalidTransfer:
+		rw.Error(http.StatusBadRequest, "Cat cannot be transferred to its current owner")
+	case services.ErrInvalidTransferData:
+		rw.Error(http.StatusBadRequest, "Invalid transfer data")
+	case services.ErrAccessDenied:
+		rw.Error(http.StatusForbidden, "Access denied")
+	default:
+		rw.Error(http.StatusInternalServerError, "Internal server error")
+	}
+}
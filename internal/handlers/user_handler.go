@@ -62,6 +62,10 @@ func (h *UserHandler) Login(w http.ResponseWriter, r *http.Request) {
 	}
 
 	token, user, err := h.service.Login(&req)
+	if err == services.ErrUserBanned {
+		rw.Error(http.StatusForbidden, "User is banned")
+		return
+	}
 	if err != nil {
 		rw.Error(http.StatusUnauthorized, "Invalid credentials")
 		return
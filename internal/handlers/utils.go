@@ -3,7 +3,9 @@ package handlers
 import (
 	"encoding/json"
 	"net/http"
+	"strconv"
 
+	"meawle/internal/middleware"
 	"meawle/internal/models"
 )
 
@@ -44,6 +46,37 @@ func ValidateMethod(r *http.Request, allowedMethod string) bool {
 	return r.Method == allowedMethod
 }
 
+// currentViewer возвращает ID и признак админа текущего пользователя для публичных маршрутов.
+// Для анонимного запроса возвращает 0 и false
+func currentViewer(r *http.Request) (int, bool) {
+	currentUser := middleware.GetUserFromContext(r.Context())
+	if currentUser == nil {
+		return 0, false
+	}
+
+	return currentUser.UserID, currentUser.IsAdmin
+}
+
+// parsePaging извлекает параметры limit и offset из query строки, отсутствующие параметры равны 0
+func parsePaging(r *http.Request) (int, int, error) {
+	query := r.URL.Query()
+
+	var limit, offset int
+	var err error
+	if limitStr := query.Get("limit"); limitStr != "" {
+		if limit, err = strconv.Atoi(limitStr); err != nil {
+			return 0, 0, err
+		}
+	}
+	if offsetStr := query.Get("offset"); offsetStr != "" {
+		if offset, err = strconv.Atoi(offsetStr); err != nil {
+			return 0, 0, err
+		}
+	}
+
+	return limit, offset, nil
+}
+
 // Ошибки
 var (
 	ErrMethodNotAllowed = &HandlerError{Message: "Method not allowed", StatusCode: http.StatusMethodNotAllowed}
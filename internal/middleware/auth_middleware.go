@@ -36,6 +36,10 @@ func (m *AuthMiddleware) RequireAuth(next http.Handler) http.Handler {
 		}
 
 		claims, err := m.service.ValidateToken(token)
+		if err == services.ErrUserBanned {
+			http.Error(w, "User is banned", http.StatusForbidden)
+			return
+		}
 		if err != nil {
 			http.Error(w, "Invalid or expired token", http.StatusUnauthorized)
 			return
@@ -47,6 +51,27 @@ func (m *AuthMiddleware) RequireAuth(next http.Handler) http.Handler {
 	})
 }
 
+// OptionalAuth middleware, добавляющий пользователя в контекст при наличии валидного токена.
+// Запрос без токена или с невалидным токеном обрабатывается как анонимный
+func (m *AuthMiddleware) OptionalAuth(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := m.extractToken(r)
+		if token == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		claims, err := m.service.ValidateToken(token)
+		if err != nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		ctx := context.WithValue(r.Context(), UserContextKey, claims)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
 // extractToken извлекает токен из заголовка Authorization
 func (m *AuthMiddleware) extractToken(r *http.Request) string {
 	authHeader := r.Header.Get("Authorization")
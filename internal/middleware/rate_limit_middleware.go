@@ -0,0 +1,86 @@
+package middleware
+
+import (
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// RateLimiter представляет middleware, ограничивающий число запросов с одного IP за окно времени
+type RateLimiter struct {
+	limit     int
+	window    time.Duration
+	mu        sync.Mutex
+	clients   map[string]*rateLimitEntry
+	lastSweep time.Time
+}
+
+// rateLimitEntry хранит счетчик запросов клиента в текущем окне
+type rateLimitEntry struct {
+	count   int
+	resetAt time.Time
+}
+
+// NewRateLimiter создает новый экземпляр ограничителя запросов
+func NewRateLimiter(limit int, window time.Duration) *RateLimiter {
+	return &RateLimiter{
+		limit:     limit,
+		window:    window,
+		clients:   make(map[string]*rateLimitEntry),
+		lastSweep: time.Now(),
+	}
+}
+
+// Limit middleware, отклоняющий запросы сверх лимита
+func (l *RateLimiter) Limit(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		allowed, retryAfter := l.allow(clientIP(r), time.Now())
+		if !allowed {
+			w.Header().Set("Retry-After", strconv.Itoa(int(retryAfter.Seconds())+1))
+			http.Error(w, "Too many requests", http.StatusTooManyRequests)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// allow учитывает запрос клиента и сообщает, укладывается ли он в лимит
+func (l *RateLimiter) allow(key string, now time.Time) (bool, time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	// Периодически удаляем устаревшие записи, чтобы карта не росла бесконечно
+	if now.Sub(l.lastSweep) > l.window {
+		for k, entry := range l.clients {
+			if now.After(entry.resetAt) {
+				delete(l.clients, k)
+			}
+		}
+		l.lastSweep = now
+	}
+
+	entry, ok := l.clients[key]
+	if !ok || now.After(entry.resetAt) {
+		l.clients[key] = &rateLimitEntry{count: 1, resetAt: now.Add(l.window)}
+		return true, 0
+	}
+
+	if entry.count >= l.limit {
+		return false, entry.resetAt.Sub(now)
+	}
+
+	entry.count++
+	return true, 0
+}
+
+// clientIP извлекает IP адрес клиента из запроса
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
@@ -0,0 +1,61 @@
+package models
+
+import (
+	"time"
+)
+
+// Типы событий ленты активности
+const (
+	ActivityCatCreated       = "cat_created"
+	ActivityBreedCreated     = "breed_created"
+	ActivityLostFoundCreated = "lost_found_created" // Объявление о потерянном или найденном коте с фотографиями
+)
+
+// Объекты, на которые ссылаются события ленты
+const (
+	ActivityTargetCat       = "cat"
+	ActivityTargetBreed     = "breed"
+	ActivityTargetLostFound = "lost_found"
+)
+
+// ActivityEvent представляет событие ленты активности пользователя
+type ActivityEvent struct {
+	ID         int       `json:"id"`
+	UserID     int       `json:"user_id"`
+	EventType  string    `json:"event_type"`
+	TargetType string    `json:"target_type"`
+	TargetID   int       `json:"target_id"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// Follow представляет подписку одного пользователя на другого
+type Follow struct {
+	UserID    int       `json:"user_id"` // Подписчик или автор, в зависимости от списка
+	Email     string    `json:"email"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// FeedItem представляет событие ленты вместе с объектом, к которому оно относится
+type FeedItem struct {
+	ID         int                `json:"id"`
+	UserID     int                `json:"user_id"`
+	EventType  string             `json:"event_type"`
+	CreatedAt  time.Time          `json:"created_at"`
+	Cat        *CatResponse       `json:"cat,omitempty"`
+	Breed      *CatBreedResponse  `json:"breed,omitempty"`
+	LostReport *LostFoundResponse `json:"lost_report,omitempty"`
+}
+
+// FeedResponse представляет страницу ленты. NextCursor передается в параметре cursor
+// для получения следующей страницы и отсутствует на последней странице
+type FeedResponse struct {
+	Items      []FeedItem `json:"items"`
+	NextCursor *string    `json:"next_cursor,omitempty"`
+}
+
+// FeedFilter представляет параметры выборки ленты
+type FeedFilter struct {
+	ViewerID int
+	BeforeID int // Только события раньше указанного, 0 - с самого нового
+	Limit    int
+}
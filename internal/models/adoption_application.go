@@ -0,0 +1,82 @@
+package models
+
+import (
+	"time"
+)
+
+// Статусы заявки на пристройство
+const (
+	ApplicationStatusSubmitted = "submitted" // Подана
+	ApplicationStatusReviewing = "reviewing" // На рассмотрении
+	ApplicationStatusApproved  = "approved"  // Одобрена, кот забронирован для соискателя
+	ApplicationStatusRejected  = "rejected"
+	ApplicationStatusCompleted = "completed" // Кот передан соискателю
+)
+
+// ApplicationNoteListingClosed - комментарий к заявкам, отклоненным автоматически при пристройстве кота
+const ApplicationNoteListingClosed = "the cat has been adopted"
+
+// AdoptionApplication представляет заявку на пристройство кота по объявлению
+type AdoptionApplication struct {
+	ID           int               `json:"id"`
+	ListingID    int               `json:"listing_id"`
+	ListingTitle string            `json:"listing_title"`
+	CatID        int               `json:"cat_id"`
+	ListerID     int               `json:"lister_id"` // Автор объявления
+	UserID       int               `json:"user_id"`
+	Email        string            `json:"email"`
+	Answers      map[string]string `json:"answers"`
+	Message      *string           `json:"message,omitempty"`
+	Status       string            `json:"status"`
+	StatusNote   *string           `json:"status_note,omitempty"`
+	CreatedAt    time.Time         `json:"created_at"`
+	UpdatedAt    time.Time         `json:"updated_at"`
+}
+
+// AdoptionApplicationCreateRequest представляет данные для подачи заявки
+type AdoptionApplicationCreateRequest struct {
+	Answers map[string]string `json:"answers" validate:"required,min=1"` // Ответы на анкету: вопрос - ответ
+	Message *string           `json:"message,omitempty" validate:"omitempty,min=1"`
+}
+
+// AdoptionApplicationStatusRequest представляет данные для изменения статуса заявки
+type AdoptionApplicationStatusRequest struct {
+	Status string  `json:"status" validate:"required,oneof=reviewing approved rejected completed"`
+	Note   *string `json:"note,omitempty" validate:"omitempty,min=1"`
+}
+
+// AdoptionApplicationResponse представляет ответ с данными заявки
+type AdoptionApplicationResponse struct {
+	ID           int               `json:"id"`
+	ListingID    int               `json:"listing_id"`
+	ListingTitle string            `json:"listing_title"`
+	CatID        int               `json:"cat_id"`
+	ListerID     int               `json:"lister_id"`
+	UserID       int               `json:"user_id"`
+	Email        string            `json:"email"`
+	Answers      map[string]string `json:"answers"`
+	Message      *string           `json:"message,omitempty"`
+	Status       string            `json:"status"`
+	StatusNote   *string           `json:"status_note,omitempty"`
+	CreatedAt    time.Time         `json:"created_at"`
+	UpdatedAt    time.Time         `json:"updated_at"`
+}
+
+// ToResponse преобразует AdoptionApplication в AdoptionApplicationResponse
+func (a *AdoptionApplication) ToResponse() AdoptionApplicationResponse {
+	return AdoptionApplicationResponse{
+		ID:           a.ID,
+		ListingID:    a.ListingID,
+		ListingTitle: a.ListingTitle,
+		CatID:        a.CatID,
+		ListerID:     a.ListerID,
+		UserID:       a.UserID,
+		Email:        a.Email,
+		Answers:      a.Answers,
+		Message:      a.Message,
+		Status:       a.Status,
+		StatusNote:   a.StatusNote,
+		CreatedAt:    a.CreatedAt,
+		UpdatedAt:    a.UpdatedAt,
+	}
+}
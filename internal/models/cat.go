@@ -4,48 +4,252 @@ import (
 	"time"
 )
 
+// Точность даты рождения кота
+const (
+	BirthDatePrecisionYear  = "year"
+	BirthDatePrecisionMonth = "month"
+	BirthDatePrecisionDay   = "day"
+)
+
+// Пол кота
+const (
+	CatSexMale   = "male"
+	CatSexFemale = "female"
+)
+
+// Сортировка списка котов
+const (
+	CatSortNewest  = "newest"  // Сначала недавно добавленные (по умолчанию)
+	CatSortPopular = "popular" // Сначала с наибольшим числом отметок "нравится"
+)
+
+// Видимость кота
+const (
+	CatVisibilityPublic   = "public"   // Виден в каталоге
+	CatVisibilityUnlisted = "unlisted" // Доступен только по прямой ссылке
+	CatVisibilityPrivate  = "private"  // Виден только владельцу, участникам и админу
+)
+
+// CatCoatPatterns содержит допустимые типы окраса шерсти
+var CatCoatPatterns = []string{
+	"solid",
+	"tabby",
+	"bicolor",
+	"tricolor",
+	"tortoiseshell",
+	"colorpoint",
+	"tuxedo",
+	"smoke",
+	"other",
+}
+
 // Cat представляет модель кота
 type Cat struct {
-	ID          int       `json:"id"`
-	Name        string    `json:"name"`
-	Age         *int      `json:"age,omitempty"`
-	Description *string   `json:"description,omitempty"`
-	UserID      int       `json:"user_id"`
-	CreatedAt   time.Time `json:"created_at"`
+	ID              int        `json:"id"`
+	Name            string     `json:"name"`
+	BirthDate       *string    `json:"birth_date,omitempty"` // YYYY, YYYY-MM или YYYY-MM-DD
+	Description     *string    `json:"description,omitempty"`
+	Sex             *string    `json:"sex,omitempty"`
+	Color           *string    `json:"color,omitempty"`
+	CoatPattern     *string    `json:"coat_pattern,omitempty"`
+	EyeColor        *string    `json:"eye_color,omitempty"`
+	WeightKg        *float64   `json:"weight_kg,omitempty"`
+	MicrochipNumber *string    `json:"microchip_number,omitempty"`
+	IsNeutered      bool       `json:"is_neutered"`
+	NeuteredAt      *string    `json:"neutered_at,omitempty"` // YYYY-MM-DD
+	SireID          *int       `json:"sire_id,omitempty"`
+	DamID           *int       `json:"dam_id,omitempty"`
+	SireExternalID  *int       `json:"sire_external_id,omitempty"` // Незарегистрированный отец
+	DamExternalID   *int       `json:"dam_external_id,omitempty"`  // Незарегистрированная мать
+	LitterID        *int       `json:"litter_id,omitempty"`
+	Availability    *string    `json:"availability,omitempty"` // Статус котенка из помета
+	Visibility      string     `json:"visibility"`
+	UserID          int        `json:"user_id"`
+	OrganizationID  *int       `json:"organization_id,omitempty"` // Организация, которой принадлежит кот
+	HiddenAt        *time.Time `json:"hidden_at,omitempty"`       // Скрыт модератором по жалобе
+	CreatedAt       time.Time  `json:"created_at"`
 }
 
 // CatCreateRequest представляет данные для создания кота
 type CatCreateRequest struct {
-	Name        string  `json:"name" validate:"required,min=1"`
-	Age         *int    `json:"age,omitempty" validate:"omitempty,min=0,max=30"`
-	Description *string `json:"description,omitempty" validate:"omitempty,min=1"`
+	Name            string   `json:"name" validate:"required,min=1"`
+	BirthDate       *string  `json:"birth_date,omitempty" validate:"omitempty"`
+	Description     *string  `json:"description,omitempty" validate:"omitempty,min=1"`
+	Sex             *string  `json:"sex,omitempty" validate:"omitempty,oneof=male female"`
+	Color           *string  `json:"color,omitempty" validate:"omitempty,min=1"`
+	CoatPattern     *string  `json:"coat_pattern,omitempty" validate:"omitempty"`
+	EyeColor        *string  `json:"eye_color,omitempty" validate:"omitempty,min=1"`
+	WeightKg        *float64 `json:"weight_kg,omitempty" validate:"omitempty,gt=0,max=30"`
+	MicrochipNumber *string  `json:"microchip_number,omitempty" validate:"omitempty,len=15,numeric"`
+	IsNeutered      bool     `json:"is_neutered"`
+	NeuteredAt      *string  `json:"neutered_at,omitempty" validate:"omitempty"`
+	SireID          *int     `json:"sire_id,omitempty" validate:"omitempty,gt=0"`
+	DamID           *int     `json:"dam_id,omitempty" validate:"omitempty,gt=0"`
+	SireExternalID  *int     `json:"sire_external_id,omitempty" validate:"omitempty,gt=0"`
+	DamExternalID   *int     `json:"dam_external_id,omitempty" validate:"omitempty,gt=0"`
+	Visibility      *string  `json:"visibility,omitempty" validate:"omitempty,oneof=public unlisted private"` // По умолчанию public
+	OrganizationID  *int     `json:"organization_id,omitempty" validate:"omitempty,gt=0"`                     // Создатель должен быть сотрудником
 }
 
 // CatUpdateRequest представляет данные для обновления кота
 type CatUpdateRequest struct {
-	Name        *string `json:"name,omitempty" validate:"omitempty,min=1"`
-	Age         *int    `json:"age,omitempty" validate:"omitempty,min=0,max=30"`
-	Description *string `json:"description,omitempty" validate:"omitempty,min=1"`
+	Name            *string  `json:"name,omitempty" validate:"omitempty,min=1"`
+	BirthDate       *string  `json:"birth_date,omitempty" validate:"omitempty"`
+	Description     *string  `json:"description,omitempty" validate:"omitempty,min=1"`
+	Sex             *string  `json:"sex,omitempty" validate:"omitempty,oneof=male female"`
+	Color           *string  `json:"color,omitempty" validate:"omitempty,min=1"`
+	CoatPattern     *string  `json:"coat_pattern,omitempty" validate:"omitempty"`
+	EyeColor        *string  `json:"eye_color,omitempty" validate:"omitempty,min=1"`
+	WeightKg        *float64 `json:"weight_kg,omitempty" validate:"omitempty,gt=0,max=30"`
+	MicrochipNumber *string  `json:"microchip_number,omitempty" validate:"omitempty,len=15,numeric"`
+	IsNeutered      *bool    `json:"is_neutered,omitempty"`
+	NeuteredAt      *string  `json:"neutered_at,omitempty" validate:"omitempty"`
+	// Значение 0 удаляет ссылку на родителя
+	SireID         *int    `json:"sire_id,omitempty" validate:"omitempty,gte=0"`
+	DamID          *int    `json:"dam_id,omitempty" validate:"omitempty,gte=0"`
+	SireExternalID *int    `json:"sire_external_id,omitempty" validate:"omitempty,gte=0"`
+	DamExternalID  *int    `json:"dam_external_id,omitempty" validate:"omitempty,gte=0"`
+	Visibility     *string `json:"visibility,omitempty" validate:"omitempty,oneof=public unlisted private"`
+	// Значение 0 выводит кота из организации
+	OrganizationID *int `json:"organization_id,omitempty" validate:"omitempty,gte=0"`
+}
+
+// CatFilter представляет параметры фильтрации списка котов
+type CatFilter struct {
+	Sex         *string
+	Color       *string
+	CoatPattern *string
+	EyeColor    *string
+	IsNeutered  *bool
+	// Коты только указанной организации
+	OrganizationID *int
+	Sort           string // newest или popular
+	// Заполняются сервисом по текущему пользователю, а не из query строки
+	ViewerID      int  // Скрытые коты этого пользователя и котов, где он участник, попадают в список
+	IncludeHidden bool // Список без ограничения видимости (для админа)
+	LikedByID     int  // Только коты, отмеченные этим пользователем
+}
+
+// CatAge представляет возраст кота, вычисленный по дате рождения
+type CatAge struct {
+	Years  int  `json:"years"`
+	Months *int `json:"months,omitempty"` // Не указывается, если известен только год рождения
 }
 
 // CatResponse представляет ответ с данными кота
 type CatResponse struct {
-	ID          int       `json:"id"`
-	Name        string    `json:"name"`
-	Age         *int      `json:"age,omitempty"`
-	Description *string   `json:"description,omitempty"`
-	UserID      int       `json:"user_id"`
-	CreatedAt   time.Time `json:"created_at"`
+	ID                 int        `json:"id"`
+	Name               string     `json:"name"`
+	BirthDate          *string    `json:"birth_date,omitempty"`
+	BirthDatePrecision string     `json:"birth_date_precision,omitempty"`
+	Age                *CatAge    `json:"age,omitempty"`
+	Description        *string    `json:"description,omitempty"`
+	Sex                *string    `json:"sex,omitempty"`
+	Color              *string    `json:"color,omitempty"`
+	CoatPattern        *string    `json:"coat_pattern,omitempty"`
+	EyeColor           *string    `json:"eye_color,omitempty"`
+	WeightKg           *float64   `json:"weight_kg,omitempty"`
+	MicrochipNumber    *string    `json:"microchip_number,omitempty"`
+	IsNeutered         bool       `json:"is_neutered"`
+	NeuteredAt         *string    `json:"neutered_at,omitempty"`
+	SireID             *int       `json:"sire_id,omitempty"`
+	DamID              *int       `json:"dam_id,omitempty"`
+	SireExternalID     *int       `json:"sire_external_id,omitempty"`
+	DamExternalID      *int       `json:"dam_external_id,omitempty"`
+	LitterID           *int       `json:"litter_id,omitempty"`
+	Availability       *string    `json:"availability,omitempty"`
+	Visibility         string     `json:"visibility"`
+	UserID             int        `json:"user_id"`
+	OrganizationID     *int       `json:"organization_id,omitempty"`
+	MyRole             *string    `json:"my_role,omitempty"` // Роль текущего пользователя, если он владелец или участник
+	LikeCount          int        `json:"like_count"`
+	LikedByMe          bool       `json:"liked_by_me"`
+	HiddenAt           *time.Time `json:"hidden_at,omitempty"`
+	CreatedAt          time.Time  `json:"created_at"`
 }
 
-// ToResponse преобразует Cat в CatResponse
+// ToResponse преобразует Cat в CatResponse. Номер микрочипа не включается: его видят только
+// владелец, участники кота и админ, иначе каталог обходил бы ограничение частоты поиска по микрочипу
 func (c *Cat) ToResponse() CatResponse {
-	return CatResponse{
-		ID:          c.ID,
-		Name:        c.Name,
-		Age:         c.Age,
-		Description: c.Description,
-		UserID:      c.UserID,
-		CreatedAt:   c.CreatedAt,
+	response := CatResponse{
+		ID:             c.ID,
+		Name:           c.Name,
+		BirthDate:      c.BirthDate,
+		Description:    c.Description,
+		Sex:            c.Sex,
+		Color:          c.Color,
+		CoatPattern:    c.CoatPattern,
+		EyeColor:       c.EyeColor,
+		WeightKg:       c.WeightKg,
+		IsNeutered:     c.IsNeutered,
+		NeuteredAt:     c.NeuteredAt,
+		SireID:         c.SireID,
+		DamID:          c.DamID,
+		SireExternalID: c.SireExternalID,
+		DamExternalID:  c.DamExternalID,
+		LitterID:       c.LitterID,
+		Availability:   c.Availability,
+		Visibility:     c.Visibility,
+		UserID:         c.UserID,
+		OrganizationID: c.OrganizationID,
+		HiddenAt:       c.HiddenAt,
+		CreatedAt:      c.CreatedAt,
+	}
+
+	// Возраст вычисляется на момент запроса, чтобы он не устаревал
+	if c.BirthDate != nil {
+		if birthDate, precision, ok := ParseBirthDate(*c.BirthDate); ok {
+			response.BirthDatePrecision = precision
+			response.Age = CalculateCatAge(birthDate, precision, time.Now())
+		}
 	}
-}
\ No newline at end of file
+
+	return response
+}
+
+// ParseBirthDate разбирает дату рождения в формате YYYY, YYYY-MM или YYYY-MM-DD
+// и возвращает дату вместе с ее точностью
+func ParseBirthDate(value string) (time.Time, string, bool) {
+	layouts := []struct {
+		layout    string
+		precision string
+	}{
+		{"2006-01-02", BirthDatePrecisionDay},
+		{"2006-01", BirthDatePrecisionMonth},
+		{"2006", BirthDatePrecisionYear},
+	}
+
+	for _, l := range layouts {
+		if len(value) != len(l.layout) {
+			continue
+		}
+		if t, err := time.Parse(l.layout, value); err == nil {
+			return t, l.precision, true
+		}
+	}
+
+	return time.Time{}, "", false
+}
+
+// CalculateCatAge вычисляет возраст кота на указанный момент с учетом точности даты рождения
+func CalculateCatAge(birthDate time.Time, precision string, now time.Time) *CatAge {
+	// Если известен только год, месяцы вычислить нельзя
+	if precision == BirthDatePrecisionYear {
+		years := now.Year() - birthDate.Year()
+		if years < 0 {
+			years = 0
+		}
+		return &CatAge{Years: years}
+	}
+
+	months := (now.Year()-birthDate.Year())*12 + int(now.Month()) - int(birthDate.Month())
+	if precision == BirthDatePrecisionDay && now.Day() < birthDate.Day() {
+		months--
+	}
+	if months < 0 {
+		months = 0
+	}
+
+	remainder := months % 12
+	return &CatAge{Years: months / 12, Months: &remainder}
+}
@@ -6,41 +6,53 @@ import (
 
 // CatBreed представляет модель породы кошек
 type CatBreed struct {
-	ID          int       `json:"id"`
-	Name        string    `json:"name"`
-	Description string    `json:"description"`
-	UserID      int       `json:"user_id"`
-	CreatedAt   time.Time `json:"created_at"`
+	ID             int        `json:"id"`
+	Name           string     `json:"name"`
+	Description    string     `json:"description"`
+	UserID         int        `json:"user_id"`
+	OrganizationID *int       `json:"organization_id,omitempty"` // Организация, которой принадлежит порода
+	HiddenAt       *time.Time `json:"hidden_at,omitempty"`       // Скрыта модератором по жалобе
+	CreatedAt      time.Time  `json:"created_at"`
 }
 
 // CatBreedCreateRequest представляет данные для создания породы кошек
 type CatBreedCreateRequest struct {
-	Name        string `json:"name" validate:"required,min=1"`
-	Description string `json:"description" validate:"required,min=1"`
+	Name           string `json:"name" validate:"required,min=1"`
+	Description    string `json:"description" validate:"required,min=1"`
+	OrganizationID *int   `json:"organization_id,omitempty" validate:"omitempty,gt=0"` // Создатель должен быть сотрудником
 }
 
 // CatBreedUpdateRequest представляет данные для обновления породы кошек
 type CatBreedUpdateRequest struct {
 	Name        *string `json:"name,omitempty" validate:"omitempty,min=1"`
 	Description *string `json:"description,omitempty" validate:"omitempty,min=1"`
+	// Значение 0 выводит породу из организации
+	OrganizationID *int `json:"organization_id,omitempty" validate:"omitempty,gte=0"`
 }
 
 // CatBreedResponse представляет ответ с данными породы кошек
 type CatBreedResponse struct {
-	ID          int       `json:"id"`
-	Name        string    `json:"name"`
-	Description string    `json:"description"`
-	UserID      int       `json:"user_id"`
-	CreatedAt   time.Time `json:"created_at"`
+	ID             int        `json:"id"`
+	Name           string     `json:"name"`
+	Description    string     `json:"description"`
+	UserID         int        `json:"user_id"`
+	OrganizationID *int       `json:"organization_id,omitempty"`
+	IsOwner        bool       `json:"is_owner"` // Порода добавлена текущим пользователем или его организацией
+	LikeCount      int        `json:"like_count"`
+	LikedByMe      bool       `json:"liked_by_me"`
+	HiddenAt       *time.Time `json:"hidden_at,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
 }
 
 // ToResponse преобразует CatBreed в CatBreedResponse
 func (c *CatBreed) ToResponse() CatBreedResponse {
 	return CatBreedResponse{
-		ID:          c.ID,
-		Name:        c.Name,
-		Description: c.Description,
-		UserID:      c.UserID,
-		CreatedAt:   c.CreatedAt,
+		ID:             c.ID,
+		Name:           c.Name,
+		Description:    c.Description,
+		UserID:         c.UserID,
+		OrganizationID: c.OrganizationID,
+		HiddenAt:       c.HiddenAt,
+		CreatedAt:      c.CreatedAt,
 	}
-}
\ No newline at end of file
+}
@@ -0,0 +1,86 @@
+package models
+
+import (
+	"time"
+)
+
+// Роли участников кота. Основной владелец (Cat.UserID) всегда имеет роль owner
+const (
+	CatRoleOwner     = "owner"     // Совладелец: редактирует профиль и управляет участниками
+	CatRoleCaretaker = "caretaker" // Опекун: ведет медицинскую историю, измерения и напоминания
+	CatRoleViewer    = "viewer"    // Наблюдатель: видит закрытые данные кота
+)
+
+// CatRoleRanks задает старшинство ролей: старшая роль включает права младших
+var CatRoleRanks = map[string]int{
+	CatRoleViewer:    1,
+	CatRoleCaretaker: 2,
+	CatRoleOwner:     3,
+}
+
+// Статусы участия
+const (
+	CatMemberStatusPending  = "pending"
+	CatMemberStatusAccepted = "accepted"
+)
+
+// CatMember представляет участника кота с ролью
+type CatMember struct {
+	ID         int        `json:"id"`
+	CatID      int        `json:"cat_id"`
+	CatName    string     `json:"cat_name"`
+	UserID     int        `json:"user_id"`
+	Email      string     `json:"email"`
+	Role       string     `json:"role"`
+	Status     string     `json:"status"`
+	InvitedBy  int        `json:"invited_by"`
+	CreatedAt  time.Time  `json:"created_at"`
+	AcceptedAt *time.Time `json:"accepted_at,omitempty"`
+}
+
+// CatMemberInviteRequest представляет данные для приглашения участника по email
+type CatMemberInviteRequest struct {
+	Email string `json:"email" validate:"required,email"`
+	Role  string `json:"role" validate:"required,oneof=owner caretaker viewer"`
+}
+
+// CatMemberUpdateRequest представляет данные для изменения роли участника
+type CatMemberUpdateRequest struct {
+	Role string `json:"role" validate:"required,oneof=owner caretaker viewer"`
+}
+
+// CatMemberResponse представляет ответ с данными участника кота
+type CatMemberResponse struct {
+	ID         int        `json:"id"`
+	CatID      int        `json:"cat_id"`
+	CatName    string     `json:"cat_name"`
+	UserID     *int       `json:"user_id,omitempty"` // Только у подтвержденного участника
+	Email      string     `json:"email"`
+	Role       string     `json:"role"`
+	Status     string     `json:"status"`
+	InvitedBy  int        `json:"invited_by"`
+	CreatedAt  time.Time  `json:"created_at"`
+	AcceptedAt *time.Time `json:"accepted_at,omitempty"`
+}
+
+// ToResponse преобразует CatMember в CatMemberResponse. ID пользователя неподтвержденного приглашения
+// не раскрывается, чтобы приглашение не показывало, зарегистрирован ли email
+func (m *CatMember) ToResponse() CatMemberResponse {
+	response := CatMemberResponse{
+		ID:         m.ID,
+		CatID:      m.CatID,
+		CatName:    m.CatName,
+		Email:      m.Email,
+		Role:       m.Role,
+		Status:     m.Status,
+		InvitedBy:  m.InvitedBy,
+		CreatedAt:  m.CreatedAt,
+		AcceptedAt: m.AcceptedAt,
+	}
+	if m.Status == CatMemberStatusAccepted {
+		userID := m.UserID
+		response.UserID = &userID
+	}
+
+	return response
+}
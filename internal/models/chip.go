@@ -0,0 +1,52 @@
+package models
+
+import (
+	"time"
+)
+
+// ChipLookupResponse представляет результат поиска кота по номеру микрочипа.
+// Данные владельца намеренно не раскрываются
+type ChipLookupResponse struct {
+	MicrochipNumber string  `json:"microchip_number"`
+	Registered      bool    `json:"registered"`
+	CatName         *string `json:"cat_name,omitempty"`
+}
+
+// ChipContactRequest представляет запрос нашедшего кота на связь с владельцем
+type ChipContactRequest struct {
+	ID            int       `json:"id"`
+	CatID         int       `json:"cat_id"`
+	FinderName    string    `json:"finder_name"`
+	FinderContact string    `json:"finder_contact"`
+	Message       *string   `json:"message,omitempty"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// ChipContactCreateRequest представляет данные для отправки запроса владельцу
+type ChipContactCreateRequest struct {
+	FinderName    string  `json:"finder_name" validate:"required,min=1"`
+	FinderContact string  `json:"finder_contact" validate:"required,min=1"`
+	Message       *string `json:"message,omitempty" validate:"omitempty,max=1000"`
+}
+
+// ChipContactRequestResponse представляет ответ с данными запроса на связь
+type ChipContactRequestResponse struct {
+	ID            int       `json:"id"`
+	CatID         int       `json:"cat_id"`
+	FinderName    string    `json:"finder_name"`
+	FinderContact string    `json:"finder_contact"`
+	Message       *string   `json:"message,omitempty"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// ToResponse преобразует ChipContactRequest в ChipContactRequestResponse
+func (c *ChipContactRequest) ToResponse() ChipContactRequestResponse {
+	return ChipContactRequestResponse{
+		ID:            c.ID,
+		CatID:         c.CatID,
+		FinderName:    c.FinderName,
+		FinderContact: c.FinderContact,
+		Message:       c.Message,
+		CreatedAt:     c.CreatedAt,
+	}
+}
@@ -0,0 +1,82 @@
+package models
+
+import (
+	"time"
+)
+
+// Объекты, к которым оставляются комментарии
+const (
+	CommentTargetCat   = "cat"
+	CommentTargetBreed = "breed"
+)
+
+// Comment представляет комментарий на странице кота или породы
+type Comment struct {
+	ID         int        `json:"id"`
+	TargetType string     `json:"target_type"`
+	TargetID   int        `json:"target_id"`
+	UserID     int        `json:"user_id"`
+	ParentID   *int       `json:"parent_id,omitempty"` // Комментарий, на который дан ответ
+	RootID     *int       `json:"root_id,omitempty"`   // Комментарий верхнего уровня ветки
+	Body       string     `json:"body"`
+	IsHidden   bool       `json:"is_hidden"`
+	HiddenBy   *int       `json:"hidden_by,omitempty"`
+	EditedAt   *time.Time `json:"edited_at,omitempty"`
+	DeletedAt  *time.Time `json:"deleted_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+// CommentCreateRequest представляет данные для создания комментария или ответа
+type CommentCreateRequest struct {
+	Body     string `json:"body" validate:"required,min=1,max=2000"`
+	ParentID *int   `json:"parent_id,omitempty" validate:"omitempty,gt=0"`
+}
+
+// CommentUpdateRequest представляет данные для редактирования комментария
+type CommentUpdateRequest struct {
+	Body string `json:"body" validate:"required,min=1,max=2000"`
+}
+
+// CommentResponse представляет ответ с данными комментария и ответами на него.
+// Текст удаленного комментария не возвращается, скрытого - возвращается только модераторам
+type CommentResponse struct {
+	ID        int               `json:"id"`
+	UserID    int               `json:"user_id"`
+	ParentID  *int              `json:"parent_id,omitempty"`
+	Body      *string           `json:"body,omitempty"`
+	IsHidden  bool              `json:"is_hidden"`
+	IsDeleted bool              `json:"is_deleted"`
+	EditedAt  *time.Time        `json:"edited_at,omitempty"`
+	CreatedAt time.Time         `json:"created_at"`
+	Replies   []CommentResponse `json:"replies,omitempty"`
+}
+
+// CommentThreadResponse представляет страницу комментариев верхнего уровня с ответами
+type CommentThreadResponse struct {
+	Comments    []CommentResponse `json:"comments"`
+	Total       int               `json:"total"` // Число комментариев верхнего уровня
+	Limit       int               `json:"limit"`
+	Offset      int               `json:"offset"`
+	IsLocked    bool              `json:"is_locked"`    // Новые комментарии оставлять нельзя
+	CanModerate bool              `json:"can_moderate"` // Текущий пользователь может скрывать комментарии и закрывать ветку
+}
+
+// ToResponse преобразует Comment в CommentResponse. showHidden открывает текст скрытого комментария
+func (c *Comment) ToResponse(showHidden bool) CommentResponse {
+	response := CommentResponse{
+		ID:        c.ID,
+		UserID:    c.UserID,
+		ParentID:  c.ParentID,
+		IsHidden:  c.IsHidden,
+		IsDeleted: c.DeletedAt != nil,
+		EditedAt:  c.EditedAt,
+		CreatedAt: c.CreatedAt,
+	}
+
+	if !response.IsDeleted && (!c.IsHidden || showHidden) {
+		body := c.Body
+		response.Body = &body
+	}
+
+	return response
+}
@@ -0,0 +1,91 @@
+package models
+
+import (
+	"time"
+)
+
+// Типы медицинских записей
+const (
+	HealthRecordVaccination = "vaccination"
+	HealthRecordVetVisit    = "vet_visit"
+	HealthRecordMedication  = "medication"
+	HealthRecordAllergy     = "allergy"
+)
+
+// HealthRecord представляет запись медицинской истории кота
+type HealthRecord struct {
+	ID         int       `json:"id"`
+	CatID      int       `json:"cat_id"`
+	RecordType string    `json:"record_type"`
+	Title      string    `json:"title"`
+	Notes      *string   `json:"notes,omitempty"`
+	RecordDate string    `json:"record_date"`        // YYYY-MM-DD
+	DueDate    *string   `json:"due_date,omitempty"` // YYYY-MM-DD: срок следующей прививки или окончания курса
+	VetName    *string   `json:"vet_name,omitempty"`
+	Dosage     *string   `json:"dosage,omitempty"`
+	IsPublic   bool      `json:"is_public"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// HealthRecordCreateRequest представляет данные для создания медицинской записи
+type HealthRecordCreateRequest struct {
+	RecordType string  `json:"record_type" validate:"required,oneof=vaccination vet_visit medication allergy"`
+	Title      string  `json:"title" validate:"required,min=1"`
+	Notes      *string `json:"notes,omitempty" validate:"omitempty,min=1"`
+	RecordDate string  `json:"record_date" validate:"required"`
+	DueDate    *string `json:"due_date,omitempty" validate:"omitempty"`
+	VetName    *string `json:"vet_name,omitempty" validate:"omitempty,min=1"`
+	Dosage     *string `json:"dosage,omitempty" validate:"omitempty,min=1"`
+	IsPublic   bool    `json:"is_public"`
+}
+
+// HealthRecordUpdateRequest представляет данные для обновления медицинской записи
+type HealthRecordUpdateRequest struct {
+	Title      *string `json:"title,omitempty" validate:"omitempty,min=1"`
+	Notes      *string `json:"notes,omitempty" validate:"omitempty,min=1"`
+	RecordDate *string `json:"record_date,omitempty" validate:"omitempty"`
+	DueDate    *string `json:"due_date,omitempty" validate:"omitempty"`
+	VetName    *string `json:"vet_name,omitempty" validate:"omitempty,min=1"`
+	Dosage     *string `json:"dosage,omitempty" validate:"omitempty,min=1"`
+	IsPublic   *bool   `json:"is_public,omitempty"`
+}
+
+// HealthRecordResponse представляет ответ с данными медицинской записи
+type HealthRecordResponse struct {
+	ID         int       `json:"id"`
+	CatID      int       `json:"cat_id"`
+	RecordType string    `json:"record_type"`
+	Title      string    `json:"title"`
+	Notes      *string   `json:"notes,omitempty"`
+	RecordDate string    `json:"record_date"`
+	DueDate    *string   `json:"due_date,omitempty"`
+	IsOverdue  bool      `json:"is_overdue"`
+	VetName    *string   `json:"vet_name,omitempty"`
+	Dosage     *string   `json:"dosage,omitempty"`
+	IsPublic   bool      `json:"is_public"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// ToResponse преобразует HealthRecord в HealthRecordResponse
+func (h *HealthRecord) ToResponse() HealthRecordResponse {
+	response := HealthRecordResponse{
+		ID:         h.ID,
+		CatID:      h.CatID,
+		RecordType: h.RecordType,
+		Title:      h.Title,
+		Notes:      h.Notes,
+		RecordDate: h.RecordDate,
+		DueDate:    h.DueDate,
+		VetName:    h.VetName,
+		Dosage:     h.Dosage,
+		IsPublic:   h.IsPublic,
+		CreatedAt:  h.CreatedAt,
+	}
+
+	// Просроченной считается только прививка, срок следующей дозы которой уже прошел
+	if h.RecordType == HealthRecordVaccination && h.DueDate != nil {
+		response.IsOverdue = *h.DueDate < time.Now().Format("2006-01-02")
+	}
+
+	return response
+}
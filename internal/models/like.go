@@ -0,0 +1,7 @@
+package models
+
+// LikeSummary представляет число отметок "нравится" у кота или породы и отметку текущего пользователя
+type LikeSummary struct {
+	LikeCount int  `json:"like_count"`
+	LikedByMe bool `json:"liked_by_me"`
+}
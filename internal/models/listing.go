@@ -0,0 +1,114 @@
+package models
+
+import (
+	"time"
+)
+
+// Статусы объявления о пристройстве
+const (
+	ListingStatusDraft    = "draft"    // Черновик, виден только автору
+	ListingStatusOpen     = "open"     // Принимает заявки
+	ListingStatusReserved = "reserved" // Кот забронирован
+	ListingStatusAdopted  = "adopted"  // Кот пристроен, объявление закрыто
+)
+
+// ListingStatuses содержит допустимые статусы объявления
+var ListingStatuses = []string{
+	ListingStatusDraft,
+	ListingStatusOpen,
+	ListingStatusReserved,
+	ListingStatusAdopted,
+}
+
+// Listing представляет объявление о пристройстве кота
+type Listing struct {
+	ID           int        `json:"id"`
+	CatID        int        `json:"cat_id"`
+	CatName      string     `json:"cat_name"`
+	CatSex       *string    `json:"cat_sex,omitempty"`
+	CatBirthDate *string    `json:"cat_birth_date,omitempty"`
+	UserID       int        `json:"user_id"`
+	Title        string     `json:"title"`
+	Description  *string    `json:"description,omitempty"`
+	Status       string     `json:"status"`
+	Location     string     `json:"location"`
+	Fee          *float64   `json:"fee,omitempty"`
+	Requirements *string    `json:"requirements,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+	ClosedAt     *time.Time `json:"closed_at,omitempty"`
+}
+
+// ListingCreateRequest представляет данные для создания объявления
+type ListingCreateRequest struct {
+	CatID        int      `json:"cat_id" validate:"required,gt=0"`
+	Title        string   `json:"title" validate:"required,min=1"`
+	Description  *string  `json:"description,omitempty" validate:"omitempty,min=1"`
+	Status       *string  `json:"status,omitempty" validate:"omitempty,oneof=draft open"` // По умолчанию draft
+	Location     string   `json:"location" validate:"required,min=1"`
+	Fee          *float64 `json:"fee,omitempty" validate:"omitempty,gte=0"`
+	Requirements *string  `json:"requirements,omitempty" validate:"omitempty,min=1"`
+}
+
+// ListingUpdateRequest представляет данные для обновления объявления
+type ListingUpdateRequest struct {
+	Title        *string  `json:"title,omitempty" validate:"omitempty,min=1"`
+	Description  *string  `json:"description,omitempty" validate:"omitempty,min=1"`
+	Status       *string  `json:"status,omitempty" validate:"omitempty,oneof=draft open reserved adopted"`
+	Location     *string  `json:"location,omitempty" validate:"omitempty,min=1"`
+	Fee          *float64 `json:"fee,omitempty" validate:"omitempty,gte=0"`
+	Requirements *string  `json:"requirements,omitempty" validate:"omitempty,min=1"`
+}
+
+// ListingFilter представляет параметры поиска объявлений
+type ListingFilter struct {
+	Status   *string // По умолчанию open
+	Location *string // Подстрока названия города или района
+	Query    *string // Поиск по заголовку и описанию
+	CatSex   *string
+	MaxFee   *float64 // Бесплатные объявления подходят под любой лимит
+	UserID   *int
+	// Заполняются сервисом по текущему пользователю, а не из query строки
+	ViewerID      int  // Объявления этого пользователя о скрытых модератором котах попадают в поиск
+	IncludeHidden bool // Поиск с объявлениями о скрытых модератором котах (для админа)
+}
+
+// ListingResponse представляет ответ с данными объявления
+type ListingResponse struct {
+	ID           int        `json:"id"`
+	CatID        int        `json:"cat_id"`
+	CatName      string     `json:"cat_name"`
+	CatSex       *string    `json:"cat_sex,omitempty"`
+	CatBirthDate *string    `json:"cat_birth_date,omitempty"`
+	UserID       int        `json:"user_id"`
+	Title        string     `json:"title"`
+	Description  *string    `json:"description,omitempty"`
+	Status       string     `json:"status"`
+	Location     string     `json:"location"`
+	Fee          *float64   `json:"fee,omitempty"`
+	Requirements *string    `json:"requirements,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+	ClosedAt     *time.Time `json:"closed_at,omitempty"`
+}
+
+// ToResponse преобразует Listing в ListingResponse
+func (l *Listing) ToResponse() ListingResponse {
+	return ListingResponse{
+		ID:           l.ID,
+		CatID:        l.CatID,
+		CatName:      l.CatName,
+		CatSex:       l.CatSex,
+		CatBirthDate: l.CatBirthDate,
+		UserID:       l.UserID,
+		Title:        l.Title,
+		Description:  l.Description,
+		Status:       l.Status,
+		Location:     l.Location,
+		Fee:          l.Fee,
+		Requirements: l.Requirements,
+		CreatedAt:    l.CreatedAt,
+		UpdatedAt:    l.UpdatedAt,
+		ClosedAt:     l.ClosedAt,
+	}
+}
@@ -0,0 +1,133 @@
+package models
+
+import (
+	"time"
+)
+
+// Статусы доступности котенка из помета
+const (
+	KittenAvailable = "available"
+	KittenReserved  = "reserved"
+	KittenPlaced    = "placed" // Передан новому владельцу
+	KittenKept      = "kept"   // Остается у заводчика
+)
+
+// KittenAvailabilities содержит допустимые статусы доступности котенка
+var KittenAvailabilities = []string{
+	KittenAvailable,
+	KittenReserved,
+	KittenPlaced,
+	KittenKept,
+}
+
+// Litter представляет модель помета
+type Litter struct {
+	ID             int       `json:"id"`
+	Name           *string   `json:"name,omitempty"`
+	DamID          int       `json:"dam_id"`
+	SireID         *int      `json:"sire_id,omitempty"`
+	SireExternalID *int      `json:"sire_external_id,omitempty"`
+	BirthDate      string    `json:"birth_date"` // YYYY-MM-DD
+	Notes          *string   `json:"notes,omitempty"`
+	UserID         int       `json:"user_id"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// LitterKittenRequest представляет данные котенка при регистрации помета
+type LitterKittenRequest struct {
+	Name         string  `json:"name" validate:"required,min=1"`
+	Sex          *string `json:"sex,omitempty" validate:"omitempty,oneof=male female"`
+	Color        *string `json:"color,omitempty" validate:"omitempty,min=1"`
+	CoatPattern  *string `json:"coat_pattern,omitempty" validate:"omitempty"`
+	EyeColor     *string `json:"eye_color,omitempty" validate:"omitempty,min=1"`
+	Description  *string `json:"description,omitempty" validate:"omitempty,min=1"`
+	Availability *string `json:"availability,omitempty" validate:"omitempty"` // По умолчанию available
+}
+
+// LitterCreateRequest представляет данные для регистрации помета вместе с котятами
+type LitterCreateRequest struct {
+	Name           *string               `json:"name,omitempty" validate:"omitempty,min=1"`
+	DamID          int                   `json:"dam_id" validate:"required,gt=0"`
+	SireID         *int                  `json:"sire_id,omitempty" validate:"omitempty,gt=0"`
+	SireExternalID *int                  `json:"sire_external_id,omitempty" validate:"omitempty,gt=0"`
+	BirthDate      string                `json:"birth_date" validate:"required"`
+	Notes          *string               `json:"notes,omitempty" validate:"omitempty,min=1"`
+	Kittens        []LitterKittenRequest `json:"kittens" validate:"required,min=1,max=20,dive"`
+}
+
+// LitterUpdateRequest представляет данные для обновления помета
+type LitterUpdateRequest struct {
+	Name  *string `json:"name,omitempty" validate:"omitempty,min=1"`
+	Notes *string `json:"notes,omitempty" validate:"omitempty,min=1"`
+}
+
+// KittenAvailabilityRequest представляет данные для изменения статуса котенка
+type KittenAvailabilityRequest struct {
+	Availability string `json:"availability" validate:"required"`
+}
+
+// LitterFilter представляет параметры фильтрации списка пометов
+type LitterFilter struct {
+	ParentID  *int  // Пометы, где кот является отцом или матерью
+	Available *bool // Только пометы со свободными котятами
+}
+
+// LitterKitten представляет котенка на странице помета
+type LitterKitten struct {
+	ID           int     `json:"id"`
+	Name         string  `json:"name"`
+	Sex          *string `json:"sex,omitempty"`
+	Color        *string `json:"color,omitempty"`
+	CoatPattern  *string `json:"coat_pattern,omitempty"`
+	Availability *string `json:"availability,omitempty"`
+	UserID       int     `json:"user_id"`
+}
+
+// LitterResponse представляет ответ с данными помета и его котятами
+type LitterResponse struct {
+	ID             int            `json:"id"`
+	Name           *string        `json:"name,omitempty"`
+	DamID          int            `json:"dam_id"`
+	SireID         *int           `json:"sire_id,omitempty"`
+	SireExternalID *int           `json:"sire_external_id,omitempty"`
+	BirthDate      string         `json:"birth_date"`
+	Notes          *string        `json:"notes,omitempty"`
+	UserID         int            `json:"user_id"`
+	Kittens        []LitterKitten `json:"kittens"`
+	AvailableCount int            `json:"available_count"`
+	CreatedAt      time.Time      `json:"created_at"`
+}
+
+// ToResponse преобразует Litter в LitterResponse с котятами помета
+func (l *Litter) ToResponse(kittens []Cat) LitterResponse {
+	response := LitterResponse{
+		ID:             l.ID,
+		Name:           l.Name,
+		DamID:          l.DamID,
+		SireID:         l.SireID,
+		SireExternalID: l.SireExternalID,
+		BirthDate:      l.BirthDate,
+		Notes:          l.Notes,
+		UserID:         l.UserID,
+		Kittens:        []LitterKitten{},
+		CreatedAt:      l.CreatedAt,
+	}
+
+	for _, kitten := range kittens {
+		response.Kittens = append(response.Kittens, LitterKitten{
+			ID:           kitten.ID,
+			Name:         kitten.Name,
+			Sex:          kitten.Sex,
+			Color:        kitten.Color,
+			CoatPattern:  kitten.CoatPattern,
+			Availability: kitten.Availability,
+			UserID:       kitten.UserID,
+		})
+
+		if kitten.Availability != nil && *kitten.Availability == KittenAvailable {
+			response.AvailableCount++
+		}
+	}
+
+	return response
+}
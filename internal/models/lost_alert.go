@@ -0,0 +1,52 @@
+package models
+
+import (
+	"time"
+)
+
+// LostAlertSubscription представляет подписку пользователя на оповещения о котах,
+// потерявшихся в радиусе от сохраненной точки
+type LostAlertSubscription struct {
+	ID         int       `json:"id"`
+	UserID     int       `json:"user_id"`
+	Email      string    `json:"email"`
+	Latitude   float64   `json:"latitude"`
+	Longitude  float64   `json:"longitude"`
+	RadiusKm   float64   `json:"radius_km"`
+	IsActive   bool      `json:"is_active"`
+	DistanceKm *float64  `json:"distance_km,omitempty"` // Расстояние до места пропажи при рассылке
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// LostAlertSubscriptionRequest представляет данные для создания или замены подписки
+type LostAlertSubscriptionRequest struct {
+	Latitude  *float64 `json:"latitude" validate:"required,gte=-90,lte=90"`
+	Longitude *float64 `json:"longitude" validate:"required,gte=-180,lte=180"`
+	RadiusKm  *float64 `json:"radius_km" validate:"required,gt=0,max=50"`
+	IsActive  *bool    `json:"is_active,omitempty"` // По умолчанию true
+}
+
+// LostAlertSubscriptionResponse представляет ответ с данными подписки
+type LostAlertSubscriptionResponse struct {
+	ID        int       `json:"id"`
+	Latitude  float64   `json:"latitude"`
+	Longitude float64   `json:"longitude"`
+	RadiusKm  float64   `json:"radius_km"`
+	IsActive  bool      `json:"is_active"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ToResponse преобразует LostAlertSubscription в LostAlertSubscriptionResponse
+func (s *LostAlertSubscription) ToResponse() LostAlertSubscriptionResponse {
+	return LostAlertSubscriptionResponse{
+		ID:        s.ID,
+		Latitude:  s.Latitude,
+		Longitude: s.Longitude,
+		RadiusKm:  s.RadiusKm,
+		IsActive:  s.IsActive,
+		CreatedAt: s.CreatedAt,
+		UpdatedAt: s.UpdatedAt,
+	}
+}
@@ -0,0 +1,131 @@
+package models
+
+import (
+	"time"
+)
+
+// Типы объявлений о котах
+const (
+	LostFoundTypeLost  = "lost"  // Кот потерялся
+	LostFoundTypeFound = "found" // Найден чужой кот
+)
+
+// Статусы объявлений о потерянных и найденных котах
+const (
+	LostFoundStatusActive   = "active"
+	LostFoundStatusResolved = "resolved" // Кот вернулся домой
+)
+
+// LostFoundReport представляет объявление о потерянном или найденном коте
+type LostFoundReport struct {
+	ID          int        `json:"id"`
+	ReportType  string     `json:"report_type"`
+	Status      string     `json:"status"`
+	UserID      int        `json:"user_id"`
+	CatID       *int       `json:"cat_id,omitempty"`
+	BreedID     *int       `json:"breed_id,omitempty"`
+	Color       *string    `json:"color,omitempty"`
+	Sex         *string    `json:"sex,omitempty"`
+	Description *string    `json:"description,omitempty"`
+	Latitude    float64    `json:"latitude"`
+	Longitude   float64    `json:"longitude"`
+	Address     *string    `json:"address,omitempty"`
+	LastSeenAt  time.Time  `json:"last_seen_at"`
+	PhotoURLs   []string   `json:"photo_urls"`
+	DistanceKm  *float64   `json:"distance_km,omitempty"` // Расстояние до точки поиска
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+	ResolvedAt  *time.Time `json:"resolved_at,omitempty"`
+}
+
+// LostFoundCreateRequest представляет данные для создания объявления.
+// Для связанного кота пустые цвет и пол заполняются из его профиля
+type LostFoundCreateRequest struct {
+	ReportType  string     `json:"report_type" validate:"required,oneof=lost found"`
+	CatID       *int       `json:"cat_id,omitempty" validate:"omitempty,gt=0"`
+	BreedID     *int       `json:"breed_id,omitempty" validate:"omitempty,gt=0"`
+	Color       *string    `json:"color,omitempty" validate:"omitempty,min=1"`
+	Sex         *string    `json:"sex,omitempty" validate:"omitempty,oneof=male female"`
+	Description *string    `json:"description,omitempty" validate:"omitempty,min=1"`
+	Latitude    *float64   `json:"latitude" validate:"required,gte=-90,lte=90"`
+	Longitude   *float64   `json:"longitude" validate:"required,gte=-180,lte=180"`
+	Address     *string    `json:"address,omitempty" validate:"omitempty,min=1"`
+	LastSeenAt  *time.Time `json:"last_seen_at,omitempty"` // По умолчанию текущее время
+	PhotoURLs   []string   `json:"photo_urls,omitempty" validate:"omitempty,max=10,dive,url"`
+}
+
+// LostFoundUpdateRequest представляет данные для обновления объявления
+type LostFoundUpdateRequest struct {
+	Status      *string    `json:"status,omitempty" validate:"omitempty,oneof=active resolved"`
+	BreedID     *int       `json:"breed_id,omitempty" validate:"omitempty,gte=0"` // Значение 0 удаляет породу
+	Color       *string    `json:"color,omitempty" validate:"omitempty,min=1"`
+	Sex         *string    `json:"sex,omitempty" validate:"omitempty,oneof=male female"`
+	Description *string    `json:"description,omitempty" validate:"omitempty,min=1"`
+	Latitude    *float64   `json:"latitude,omitempty" validate:"omitempty,gte=-90,lte=90"`
+	Longitude   *float64   `json:"longitude,omitempty" validate:"omitempty,gte=-180,lte=180"`
+	Address     *string    `json:"address,omitempty" validate:"omitempty,min=1"`
+	LastSeenAt  *time.Time `json:"last_seen_at,omitempty"`
+	PhotoURLs   []string   `json:"photo_urls,omitempty" validate:"omitempty,max=10,dive,url"`
+}
+
+// LostFoundFilter представляет параметры поиска объявлений рядом с точкой
+type LostFoundFilter struct {
+	Latitude   float64
+	Longitude  float64
+	RadiusKm   float64
+	ReportType *string
+	// Заполняются сервисом при поиске совпадений, а не из query строки
+	BreedID     *int
+	Color       *string
+	Sex         *string
+	SeenAfter   *time.Time // Найден после того, как потерялся
+	SeenBefore  *time.Time // Потерялся до того, как найден
+	ExcludeID   int
+	ExcludeUser int
+}
+
+// LostFoundResponse представляет ответ с данными объявления
+type LostFoundResponse struct {
+	ID          int        `json:"id"`
+	ReportType  string     `json:"report_type"`
+	Status      string     `json:"status"`
+	UserID      int        `json:"user_id"`
+	CatID       *int       `json:"cat_id,omitempty"`
+	BreedID     *int       `json:"breed_id,omitempty"`
+	Color       *string    `json:"color,omitempty"`
+	Sex         *string    `json:"sex,omitempty"`
+	Description *string    `json:"description,omitempty"`
+	Latitude    float64    `json:"latitude"`
+	Longitude   float64    `json:"longitude"`
+	Address     *string    `json:"address,omitempty"`
+	LastSeenAt  time.Time  `json:"last_seen_at"`
+	PhotoURLs   []string   `json:"photo_urls"`
+	DistanceKm  *float64   `json:"distance_km,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+	ResolvedAt  *time.Time `json:"resolved_at,omitempty"`
+}
+
+// ToResponse преобразует LostFoundReport в LostFoundResponse
+func (r *LostFoundReport) ToResponse() LostFoundResponse {
+	return LostFoundResponse{
+		ID:          r.ID,
+		ReportType:  r.ReportType,
+		Status:      r.Status,
+		UserID:      r.UserID,
+		CatID:       r.CatID,
+		BreedID:     r.BreedID,
+		Color:       r.Color,
+		Sex:         r.Sex,
+		Description: r.Description,
+		Latitude:    r.Latitude,
+		Longitude:   r.Longitude,
+		Address:     r.Address,
+		LastSeenAt:  r.LastSeenAt,
+		PhotoURLs:   r.PhotoURLs,
+		DistanceKm:  r.DistanceKm,
+		CreatedAt:   r.CreatedAt,
+		UpdatedAt:   r.UpdatedAt,
+		ResolvedAt:  r.ResolvedAt,
+	}
+}
@@ -0,0 +1,66 @@
+package models
+
+import (
+	"time"
+)
+
+// Measurement представляет измерение веса и роста кота
+type Measurement struct {
+	ID         int       `json:"id"`
+	CatID      int       `json:"cat_id"`
+	MeasuredAt string    `json:"measured_at"` // YYYY-MM-DD
+	WeightKg   float64   `json:"weight_kg"`
+	LengthCm   *float64  `json:"length_cm,omitempty"`
+	Notes      *string   `json:"notes,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// MeasurementCreateRequest представляет данные для добавления измерения
+type MeasurementCreateRequest struct {
+	MeasuredAt string   `json:"measured_at" validate:"required"`
+	WeightKg   float64  `json:"weight_kg" validate:"required,gt=0,max=30"`
+	LengthCm   *float64 `json:"length_cm,omitempty" validate:"omitempty,gt=0"`
+	Notes      *string  `json:"notes,omitempty" validate:"omitempty,min=1"`
+}
+
+// MeasurementResponse представляет ответ с данными измерения
+type MeasurementResponse struct {
+	ID            int       `json:"id"`
+	CatID         int       `json:"cat_id"`
+	MeasuredAt    string    `json:"measured_at"`
+	WeightKg      float64   `json:"weight_kg"`
+	LengthCm      *float64  `json:"length_cm,omitempty"`
+	Notes         *string   `json:"notes,omitempty"`
+	ChangePercent *float64  `json:"change_percent,omitempty"` // Изменение веса относительно предыдущего измерения
+	Alert         bool      `json:"alert"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// ToResponse преобразует Measurement в MeasurementResponse
+func (m *Measurement) ToResponse() MeasurementResponse {
+	return MeasurementResponse{
+		ID:         m.ID,
+		CatID:      m.CatID,
+		MeasuredAt: m.MeasuredAt,
+		WeightKg:   m.WeightKg,
+		LengthCm:   m.LengthCm,
+		Notes:      m.Notes,
+		CreatedAt:  m.CreatedAt,
+	}
+}
+
+// WeeklyWeight представляет средний вес кота за неделю
+type WeeklyWeight struct {
+	WeekStart       string  `json:"week_start"` // Понедельник недели, YYYY-MM-DD
+	AverageWeightKg float64 `json:"average_weight_kg"`
+	Count           int     `json:"count"`
+}
+
+// WeeklyWeightResponse представляет точку агрегированного ряда веса
+type WeeklyWeightResponse struct {
+	WeekStart       string   `json:"week_start"`
+	AverageWeightKg float64  `json:"average_weight_kg"`
+	Count           int      `json:"count"`
+	ChangePercent   *float64 `json:"change_percent,omitempty"` // Изменение относительно предыдущей недели
+	Alert           bool     `json:"alert"`
+}
@@ -0,0 +1,89 @@
+package models
+
+import (
+	"time"
+)
+
+// Типы событий, доставляемых пользователю в реальном времени
+const (
+	MessageEventNew  = "message" // Новое сообщение в переписке
+	MessageEventRead = "read"    // Собеседник прочитал переписку
+)
+
+// Conversation представляет переписку двух пользователей о коте или объявлении о пристройстве
+type Conversation struct {
+	ID            int       `json:"id"`
+	CatID         int       `json:"cat_id"`
+	ListingID     *int      `json:"listing_id,omitempty"`
+	CreatedBy     int       `json:"created_by"`
+	CreatedAt     time.Time `json:"created_at"`
+	LastMessageAt time.Time `json:"last_message_at"`
+}
+
+// Message представляет сообщение в переписке
+type Message struct {
+	ID             int       `json:"id"`
+	ConversationID int       `json:"conversation_id"`
+	SenderID       int       `json:"sender_id"`
+	Body           string    `json:"body"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// ConversationCreateRequest представляет данные для начала переписки с владельцем кота или автором объявления.
+// Указывается ровно одно из полей cat_id и listing_id
+type ConversationCreateRequest struct {
+	CatID     *int   `json:"cat_id,omitempty" validate:"omitempty,gt=0"`
+	ListingID *int   `json:"listing_id,omitempty" validate:"omitempty,gt=0"`
+	Body      string `json:"body" validate:"required,min=1,max=2000"`
+}
+
+// MessageCreateRequest представляет данные для отправки сообщения
+type MessageCreateRequest struct {
+	Body string `json:"body" validate:"required,min=1,max=2000"`
+}
+
+// ConversationResponse представляет переписку в списке пользователя
+type ConversationResponse struct {
+	ID             int       `json:"id"`
+	CatID          int       `json:"cat_id"`
+	ListingID      *int      `json:"listing_id,omitempty"`
+	InterlocutorID int       `json:"interlocutor_id"` // Собеседник текущего пользователя
+	Email          string    `json:"email"`
+	LastMessage    *Message  `json:"last_message,omitempty"`
+	UnreadCount    int       `json:"unread_count"`
+	CreatedAt      time.Time `json:"created_at"`
+	LastMessageAt  time.Time `json:"last_message_at"`
+}
+
+// MessageFilter представляет параметры выборки сообщений переписки
+type MessageFilter struct {
+	ConversationID int
+	BeforeID       int // Только сообщения раньше указанного, 0 - с самого нового
+	Limit          int
+}
+
+// MessagePage представляет страницу сообщений переписки, новые первыми
+type MessagePage struct {
+	Messages   []Message `json:"messages"`
+	NextCursor *string   `json:"next_cursor,omitempty"` // Отсутствует на последней странице
+}
+
+// UnreadCountResponse представляет число непрочитанных сообщений пользователя
+type UnreadCountResponse struct {
+	UnreadCount int `json:"unread_count"`
+}
+
+// UserBlock представляет заблокированного пользователя
+type UserBlock struct {
+	UserID    int       `json:"user_id"`
+	Email     string    `json:"email"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// MessageEvent представляет событие, доставляемое участнику переписки в реальном времени
+type MessageEvent struct {
+	Type           string   `json:"type"`
+	ConversationID int      `json:"conversation_id"`
+	Message        *Message `json:"message,omitempty"`
+	ReaderID       int      `json:"reader_id,omitempty"` // Для события read - кто прочитал переписку
+}
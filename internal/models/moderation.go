@@ -0,0 +1,84 @@
+package models
+
+import (
+	"time"
+)
+
+// Объекты, на которые можно пожаловаться
+const (
+	ReportTargetCat     = "cat"
+	ReportTargetBreed   = "breed"
+	ReportTargetComment = "comment"
+	ReportTargetUser    = "user"
+)
+
+// Причины жалоб
+const (
+	ReportReasonSpam          = "spam"
+	ReportReasonAbuse         = "abuse"
+	ReportReasonInappropriate = "inappropriate"
+	ReportReasonOther         = "other"
+)
+
+// Статусы жалоб
+const (
+	ReportStatusOpen     = "open"
+	ReportStatusResolved = "resolved"
+)
+
+// Действия модератора по жалобе
+const (
+	ModerationActionDismiss = "dismiss" // Жалоба отклонена
+	ModerationActionHide    = "hide"    // Объект скрыт из публичных списков
+	ModerationActionWarn    = "warn"    // Автору вынесено предупреждение
+	ModerationActionBan     = "ban"     // Автор заблокирован
+)
+
+// ContentReport представляет жалобу пользователя на кота, породу, комментарий или другого пользователя
+type ContentReport struct {
+	ID             int        `json:"id"`
+	TargetType     string     `json:"target_type"`
+	TargetID       int        `json:"target_id"`
+	ReporterID     *int       `json:"reporter_id,omitempty"` // nil для жалоб фильтра текста
+	Reason         string     `json:"reason"`
+	Details        *string    `json:"details,omitempty"`
+	Status         string     `json:"status"`
+	Action         *string    `json:"action,omitempty"`
+	ResolutionNote *string    `json:"resolution_note,omitempty"`
+	ResolvedBy     *int       `json:"resolved_by,omitempty"`
+	ResolvedAt     *time.Time `json:"resolved_at,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+	TargetReports  int        `json:"target_reports"` // Число открытых жалоб на тот же объект
+}
+
+// ContentReportCreateRequest представляет данные для создания жалобы
+type ContentReportCreateRequest struct {
+	TargetType string  `json:"target_type" validate:"required,oneof=cat breed comment user"`
+	TargetID   int     `json:"target_id" validate:"required,gt=0"`
+	Reason     string  `json:"reason" validate:"required,oneof=spam abuse inappropriate other"`
+	Details    *string `json:"details,omitempty" validate:"omitempty,max=1000"`
+}
+
+// ContentReportResolveRequest представляет решение модератора по жалобе
+type ContentReportResolveRequest struct {
+	Action string  `json:"action" validate:"required,oneof=dismiss hide warn ban"`
+	Note   *string `json:"note,omitempty" validate:"omitempty,max=1000"` // Для предупреждения передается автору
+}
+
+// ContentReportFilter представляет параметры очереди модерации
+type ContentReportFilter struct {
+	Status     string
+	TargetType *string
+	Limit      int
+	Offset     int
+}
+
+// UserWarning представляет предупреждение, вынесенное пользователю модератором
+type UserWarning struct {
+	ID        int       `json:"id"`
+	UserID    int       `json:"user_id"`
+	ReportID  *int      `json:"report_id,omitempty"`
+	Reason    string    `json:"reason"`
+	IssuedBy  int       `json:"issued_by"`
+	CreatedAt time.Time `json:"created_at"`
+}
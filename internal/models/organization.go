@@ -0,0 +1,113 @@
+package models
+
+import (
+	"time"
+)
+
+// Роли сотрудников организации
+const (
+	OrganizationRoleAdmin = "admin" // Управляет организацией и сотрудниками, имеет права owner во всех котах организации
+	OrganizationRoleStaff = "staff" // Имеет права caretaker во всех котах организации
+)
+
+// OrganizationCatRoles сопоставляет роль сотрудника с его ролью в котах организации
+var OrganizationCatRoles = map[string]string{
+	OrganizationRoleAdmin: CatRoleOwner,
+	OrganizationRoleStaff: CatRoleCaretaker,
+}
+
+// Organization представляет модель организации (приюта или питомника)
+type Organization struct {
+	ID           int       `json:"id"`
+	Name         string    `json:"name"`
+	Description  *string   `json:"description,omitempty"`
+	ContactEmail *string   `json:"contact_email,omitempty"`
+	CreatedBy    int       `json:"created_by"`
+	MemberCount  int       `json:"member_count"`
+	CatCount     int       `json:"cat_count"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// OrganizationCreateRequest представляет данные для создания организации
+type OrganizationCreateRequest struct {
+	Name         string  `json:"name" validate:"required,min=1"`
+	Description  *string `json:"description,omitempty" validate:"omitempty,min=1"`
+	ContactEmail *string `json:"contact_email,omitempty" validate:"omitempty,email"`
+}
+
+// OrganizationUpdateRequest представляет данные для обновления организации
+type OrganizationUpdateRequest struct {
+	Name         *string `json:"name,omitempty" validate:"omitempty,min=1"`
+	Description  *string `json:"description,omitempty" validate:"omitempty,min=1"`
+	ContactEmail *string `json:"contact_email,omitempty" validate:"omitempty,email"`
+}
+
+// OrganizationResponse представляет ответ с данными организации
+type OrganizationResponse struct {
+	ID           int       `json:"id"`
+	Name         string    `json:"name"`
+	Description  *string   `json:"description,omitempty"`
+	ContactEmail *string   `json:"contact_email,omitempty"`
+	CreatedBy    int       `json:"created_by"`
+	MemberCount  int       `json:"member_count"`
+	CatCount     int       `json:"cat_count"`
+	MyRole       *string   `json:"my_role,omitempty"` // Роль текущего пользователя, если он сотрудник
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// ToResponse преобразует Organization в OrganizationResponse
+func (o *Organization) ToResponse() OrganizationResponse {
+	return OrganizationResponse{
+		ID:           o.ID,
+		Name:         o.Name,
+		Description:  o.Description,
+		ContactEmail: o.ContactEmail,
+		CreatedBy:    o.CreatedBy,
+		MemberCount:  o.MemberCount,
+		CatCount:     o.CatCount,
+		CreatedAt:    o.CreatedAt,
+	}
+}
+
+// OrganizationMember представляет сотрудника организации
+type OrganizationMember struct {
+	ID             int       `json:"id"`
+	OrganizationID int       `json:"organization_id"`
+	UserID         int       `json:"user_id"`
+	Email          string    `json:"email"`
+	Role           string    `json:"role"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// OrganizationMemberAddRequest представляет данные для добавления сотрудника по email
+type OrganizationMemberAddRequest struct {
+	Email string `json:"email" validate:"required,email"`
+	Role  string `json:"role" validate:"required,oneof=admin staff"`
+}
+
+// OrganizationMemberUpdateRequest представляет данные для изменения роли сотрудника
+type OrganizationMemberUpdateRequest struct {
+	Role string `json:"role" validate:"required,oneof=admin staff"`
+}
+
+// OrganizationMemberResponse представляет ответ с данными сотрудника организации
+type OrganizationMemberResponse struct {
+	ID             int       `json:"id"`
+	OrganizationID int       `json:"organization_id"`
+	UserID         int       `json:"user_id"`
+	Email          string    `json:"email"`
+	Role           string    `json:"role"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// ToResponse преобразует OrganizationMember в OrganizationMemberResponse
+func (m *OrganizationMember) ToResponse() OrganizationMemberResponse {
+	return OrganizationMemberResponse{
+		ID:             m.ID,
+		OrganizationID: m.OrganizationID,
+		UserID:         m.UserID,
+		Email:          m.Email,
+		Role:           m.Role,
+		CreatedAt:      m.CreatedAt,
+	}
+}
@@ -0,0 +1,106 @@
+package models
+
+import (
+	"time"
+)
+
+// Виды узлов родословной
+const (
+	PedigreeKindCat      = "cat"
+	PedigreeKindExternal = "external"
+)
+
+// Роли родителей в родословной
+const (
+	PedigreeRoleSire = "sire"
+	PedigreeRoleDam  = "dam"
+)
+
+// ExternalAncestor представляет предка, не зарегистрированного в системе
+type ExternalAncestor struct {
+	ID                 int       `json:"id"`
+	Name               string    `json:"name"`
+	Sex                *string   `json:"sex,omitempty"`
+	RegistrationNumber *string   `json:"registration_number,omitempty"` // Номер в племенной книге клуба
+	SireID             *int      `json:"sire_id,omitempty"`
+	DamID              *int      `json:"dam_id,omitempty"`
+	UserID             int       `json:"user_id"`
+	CreatedAt          time.Time `json:"created_at"`
+}
+
+// ExternalAncestorCreateRequest представляет данные для создания внешнего предка
+type ExternalAncestorCreateRequest struct {
+	Name               string  `json:"name" validate:"required,min=1"`
+	Sex                *string `json:"sex,omitempty" validate:"omitempty,oneof=male female"`
+	RegistrationNumber *string `json:"registration_number,omitempty" validate:"omitempty,min=1"`
+	SireID             *int    `json:"sire_id,omitempty" validate:"omitempty,gt=0"` // Родители внешнего предка тоже внешние
+	DamID              *int    `json:"dam_id,omitempty" validate:"omitempty,gt=0"`
+}
+
+// ExternalAncestorResponse представляет ответ с данными внешнего предка
+type ExternalAncestorResponse struct {
+	ID                 int       `json:"id"`
+	Name               string    `json:"name"`
+	Sex                *string   `json:"sex,omitempty"`
+	RegistrationNumber *string   `json:"registration_number,omitempty"`
+	SireID             *int      `json:"sire_id,omitempty"`
+	DamID              *int      `json:"dam_id,omitempty"`
+	UserID             int       `json:"user_id"`
+	CreatedAt          time.Time `json:"created_at"`
+}
+
+// ToResponse преобразует ExternalAncestor в ExternalAncestorResponse
+func (a *ExternalAncestor) ToResponse() ExternalAncestorResponse {
+	return ExternalAncestorResponse{
+		ID:                 a.ID,
+		Name:               a.Name,
+		Sex:                a.Sex,
+		RegistrationNumber: a.RegistrationNumber,
+		SireID:             a.SireID,
+		DamID:              a.DamID,
+		UserID:             a.UserID,
+		CreatedAt:          a.CreatedAt,
+	}
+}
+
+// PedigreeLink представляет связь "потомок - родитель" в родословной
+type PedigreeLink struct {
+	ChildKind  string
+	ChildID    int
+	ParentKind string
+	ParentID   int
+	Role       string
+	ParentName string
+	ParentSex  *string
+	// Родитель - кот, которого пользователь не видит. Связь остается для расчета инбридинга
+	ParentHidden bool
+}
+
+// PedigreeNode представляет узел дерева родословной
+type PedigreeNode struct {
+	ID         int           `json:"id"`
+	Kind       string        `json:"kind"` // cat или external
+	Name       string        `json:"name"`
+	Sex        *string       `json:"sex,omitempty"`
+	Generation int           `json:"generation"`
+	Hidden     bool          `json:"hidden,omitempty"` // Скрытый от пользователя кот показывается без ID и имени
+	Sire       *PedigreeNode `json:"sire,omitempty"`
+	Dam        *PedigreeNode `json:"dam,omitempty"`
+}
+
+// PedigreeResponse представляет родословную кота на N поколений
+type PedigreeResponse struct {
+	CatID                 int           `json:"cat_id"`
+	Generations           int           `json:"generations"`
+	InbreedingCoefficient float64       `json:"inbreeding_coefficient"` // Коэффициент инбридинга по Райту
+	Tree                  *PedigreeNode `json:"tree"`
+}
+
+// CatDescendant представляет потомка кота
+type CatDescendant struct {
+	ID         int     `json:"id"`
+	Name       string  `json:"name"`
+	Sex        *string `json:"sex,omitempty"`
+	BirthDate  *string `json:"birth_date,omitempty"`
+	Generation int     `json:"generation"` // 1 - дети, 2 - внуки и т.д.
+}
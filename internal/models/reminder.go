@@ -0,0 +1,81 @@
+package models
+
+import (
+	"time"
+)
+
+// Типы напоминаний по уходу за котом
+const (
+	ReminderVaccination   = "vaccination"
+	ReminderDeworming     = "deworming"
+	ReminderFleaTreatment = "flea_treatment"
+	ReminderFeeding       = "feeding"
+	ReminderOther         = "other"
+)
+
+// Reminder представляет напоминание по уходу за котом
+type Reminder struct {
+	ID           int        `json:"id"`
+	CatID        int        `json:"cat_id"`
+	UserID       int        `json:"user_id"`
+	ReminderType string     `json:"reminder_type"`
+	Title        string     `json:"title"`
+	Notes        *string    `json:"notes,omitempty"`
+	StartsAt     time.Time  `json:"starts_at"`
+	RRule        *string    `json:"rrule,omitempty"` // Подмножество RRULE RFC 5545, например FREQ=MONTHLY;INTERVAL=3
+	NextRunAt    *time.Time `json:"next_run_at,omitempty"`
+	LastSentAt   *time.Time `json:"last_sent_at,omitempty"`
+	IsActive     bool       `json:"is_active"`
+	CreatedAt    time.Time  `json:"created_at"`
+}
+
+// ReminderCreateRequest представляет данные для создания напоминания
+type ReminderCreateRequest struct {
+	ReminderType string    `json:"reminder_type" validate:"required,oneof=vaccination deworming flea_treatment feeding other"`
+	Title        string    `json:"title" validate:"required,min=1"`
+	Notes        *string   `json:"notes,omitempty" validate:"omitempty,min=1"`
+	StartsAt     time.Time `json:"starts_at" validate:"required"`
+	RRule        *string   `json:"rrule,omitempty" validate:"omitempty"`
+}
+
+// ReminderUpdateRequest представляет данные для обновления напоминания.
+// Пустая строка в RRule отключает повторение
+type ReminderUpdateRequest struct {
+	Title    *string    `json:"title,omitempty" validate:"omitempty,min=1"`
+	Notes    *string    `json:"notes,omitempty" validate:"omitempty,min=1"`
+	StartsAt *time.Time `json:"starts_at,omitempty" validate:"omitempty"`
+	RRule    *string    `json:"rrule,omitempty" validate:"omitempty"`
+	IsActive *bool      `json:"is_active,omitempty"`
+}
+
+// ReminderResponse представляет ответ с данными напоминания
+type ReminderResponse struct {
+	ID           int        `json:"id"`
+	CatID        int        `json:"cat_id"`
+	ReminderType string     `json:"reminder_type"`
+	Title        string     `json:"title"`
+	Notes        *string    `json:"notes,omitempty"`
+	StartsAt     time.Time  `json:"starts_at"`
+	RRule        *string    `json:"rrule,omitempty"`
+	NextRunAt    *time.Time `json:"next_run_at,omitempty"`
+	LastSentAt   *time.Time `json:"last_sent_at,omitempty"`
+	IsActive     bool       `json:"is_active"`
+	CreatedAt    time.Time  `json:"created_at"`
+}
+
+// ToResponse преобразует Reminder в ReminderResponse
+func (r *Reminder) ToResponse() ReminderResponse {
+	return ReminderResponse{
+		ID:           r.ID,
+		CatID:        r.CatID,
+		ReminderType: r.ReminderType,
+		Title:        r.Title,
+		Notes:        r.Notes,
+		StartsAt:     r.StartsAt,
+		RRule:        r.RRule,
+		NextRunAt:    r.NextRunAt,
+		LastSentAt:   r.LastSentAt,
+		IsActive:     r.IsActive,
+		CreatedAt:    r.CreatedAt,
+	}
+}
@@ -0,0 +1,92 @@
+package models
+
+import (
+	"time"
+)
+
+// Статусы передачи кота
+const (
+	TransferStatusPending   = "pending"
+	TransferStatusAccepted  = "accepted"
+	TransferStatusDeclined  = "declined"
+	TransferStatusCancelled = "cancelled"
+)
+
+// Причины смены владельца кота
+const (
+	OwnershipReasonTransfer = "transfer"
+	OwnershipReasonForced   = "forced"   // Передача, выполненная администратором
+	OwnershipReasonAdoption = "adoption" // Пристройство по заявке на объявление
+)
+
+// CatTransfer представляет передачу кота другому владельцу
+type CatTransfer struct {
+	ID         int        `json:"id"`
+	CatID      int        `json:"cat_id"`
+	CatName    string     `json:"cat_name"`
+	FromUserID int        `json:"from_user_id"`
+	FromEmail  string     `json:"from_email"`
+	ToUserID   int        `json:"to_user_id"`
+	ToEmail    string     `json:"to_email"`
+	Status     string     `json:"status"`
+	Message    *string    `json:"message,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+	ResolvedAt *time.Time `json:"resolved_at,omitempty"`
+}
+
+// CatTransferCreateRequest представляет данные для начала передачи кота
+type CatTransferCreateRequest struct {
+	Email   string  `json:"email" validate:"required,email"` // Email нового владельца
+	Message *string `json:"message,omitempty" validate:"omitempty,min=1"`
+}
+
+// CatForceTransferRequest представляет данные для принудительной передачи кота администратором
+type CatForceTransferRequest struct {
+	Email string  `json:"email" validate:"required,email"`
+	Note  *string `json:"note,omitempty" validate:"omitempty,min=1"`
+}
+
+// CatTransferResponse представляет ответ с данными передачи кота
+type CatTransferResponse struct {
+	ID         int        `json:"id"`
+	CatID      int        `json:"cat_id"`
+	CatName    string     `json:"cat_name"`
+	FromUserID int        `json:"from_user_id"`
+	FromEmail  string     `json:"from_email"`
+	ToUserID   int        `json:"to_user_id"`
+	ToEmail    string     `json:"to_email"`
+	Status     string     `json:"status"`
+	Message    *string    `json:"message,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+	ResolvedAt *time.Time `json:"resolved_at,omitempty"`
+}
+
+// ToResponse преобразует CatTransfer в CatTransferResponse
+func (t *CatTransfer) ToResponse() CatTransferResponse {
+	return CatTransferResponse{
+		ID:         t.ID,
+		CatID:      t.CatID,
+		CatName:    t.CatName,
+		FromUserID: t.FromUserID,
+		FromEmail:  t.FromEmail,
+		ToUserID:   t.ToUserID,
+		ToEmail:    t.ToEmail,
+		Status:     t.Status,
+		Message:    t.Message,
+		CreatedAt:  t.CreatedAt,
+		ResolvedAt: t.ResolvedAt,
+	}
+}
+
+// OwnershipRecord представляет запись истории владельцев кота
+type OwnershipRecord struct {
+	ID         int       `json:"id"`
+	CatID      int       `json:"cat_id"`
+	FromUserID *int      `json:"from_user_id,omitempty"`
+	ToUserID   int       `json:"to_user_id"`
+	TransferID *int      `json:"transfer_id,omitempty"`
+	Reason     string    `json:"reason"`
+	ChangedBy  int       `json:"changed_by"`
+	Note       *string   `json:"note,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+}
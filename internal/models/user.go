@@ -1,11 +1,17 @@
 package models
 
+import (
+	"time"
+)
+
 // User представляет модель пользователя
 type User struct {
-	ID       int    `json:"id"`
-	Email    string `json:"email"`
-	Password string `json:"-"` // Пароль не должен сериализоваться в JSON
-	IsAdmin  bool   `json:"is_admin"`
+	ID          int        `json:"id"`
+	Email       string     `json:"email"`
+	Password    string     `json:"-"` // Пароль не должен сериализоваться в JSON
+	IsAdmin     bool       `json:"is_admin"`
+	IsModerator bool       `json:"is_moderator"`
+	BannedAt    *time.Time `json:"banned_at,omitempty"` // Заблокированный пользователь не может войти
 }
 
 // UserCreateRequest представляет данные для создания пользователя
@@ -17,9 +23,10 @@ type UserCreateRequest struct {
 
 // UserUpdateRequest представляет данные для обновления пользователя
 type UserUpdateRequest struct {
-	Email    *string `json:"email,omitempty" validate:"omitempty,email"`
-	Password *string `json:"password,omitempty" validate:"omitempty,min=6"`
-	IsAdmin  *bool   `json:"is_admin,omitempty"`
+	Email       *string `json:"email,omitempty" validate:"omitempty,email"`
+	Password    *string `json:"password,omitempty" validate:"omitempty,min=6"`
+	IsAdmin     *bool   `json:"is_admin,omitempty"`
+	IsModerator *bool   `json:"is_moderator,omitempty"` // Назначать модераторов может только админ
 }
 
 // UserLoginRequest представляет данные для входа пользователя
@@ -30,16 +37,20 @@ type UserLoginRequest struct {
 
 // UserResponse представляет ответ с данными пользователя
 type UserResponse struct {
-	ID      int    `json:"id"`
-	Email   string `json:"email"`
-	IsAdmin bool   `json:"is_admin"`
+	ID          int        `json:"id"`
+	Email       string     `json:"email"`
+	IsAdmin     bool       `json:"is_admin"`
+	IsModerator bool       `json:"is_moderator"`
+	BannedAt    *time.Time `json:"banned_at,omitempty"`
 }
 
 // ToResponse преобразует User в UserResponse
 func (u *User) ToResponse() UserResponse {
 	return UserResponse{
-		ID:      u.ID,
-		Email:   u.Email,
-		IsAdmin: u.IsAdmin,
+		ID:          u.ID,
+		Email:       u.Email,
+		IsAdmin:     u.IsAdmin,
+		IsModerator: u.IsModerator,
+		BannedAt:    u.BannedAt,
 	}
 }
@@ -0,0 +1,125 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+)
+
+// Notification представляет уведомление для пользователя
+type Notification struct {
+	UserID  int               `json:"user_id"`
+	Email   string            `json:"email"`
+	Subject string            `json:"subject"`
+	Body    string            `json:"body"`
+	Data    map[string]string `json:"data,omitempty"`
+}
+
+// Notifier определяет интерфейс доставки уведомлений
+type Notifier interface {
+	Notify(ctx context.Context, notification Notification) error
+}
+
+// PartialError сообщает, что уведомление доставлено не всеми каналами
+type PartialError struct {
+	Err error
+}
+
+func (e *PartialError) Error() string {
+	return "notification partially delivered: " + e.Err.Error()
+}
+
+func (e *PartialError) Unwrap() error {
+	return e.Err
+}
+
+// IsDelivered сообщает, что уведомление дошло до пользователя хотя бы по одному каналу.
+// Повторная отправка после частичной ошибки продублировала бы уведомление в работающих каналах
+func IsDelivered(err error) bool {
+	var partial *PartialError
+	return err == nil || errors.As(err, &partial)
+}
+
+// EmailNotifier - заглушка отправки email, записывающая письма в лог
+type EmailNotifier struct {
+	logger *log.Logger
+}
+
+// NewEmailNotifier создает новый экземпляр email уведомителя
+func NewEmailNotifier(logger *log.Logger) *EmailNotifier {
+	return &EmailNotifier{logger: logger}
+}
+
+// Notify записывает письмо в лог вместо реальной отправки
+func (n *EmailNotifier) Notify(ctx context.Context, notification Notification) error {
+	if notification.Email == "" {
+		return nil
+	}
+
+	n.logger.Printf("Email to %s: %s - %s", notification.Email, notification.Subject, notification.Body)
+	return nil
+}
+
+// WebhookNotifier отправляет уведомления POST запросом с JSON телом на заданный URL
+type WebhookNotifier struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhookNotifier создает новый экземпляр webhook уведомителя
+func NewWebhookNotifier(url string) *WebhookNotifier {
+	return &WebhookNotifier{
+		url:    url,
+		client: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// Notify отправляет уведомление на webhook
+func (n *WebhookNotifier) Notify(ctx context.Context, notification Notification) error {
+	body, err := json.Marshal(notification)
+	if err != nil {
+		return fmt.Errorf("failed to encode notification: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send webhook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// MultiNotifier доставляет уведомление через все вложенные уведомители
+type MultiNotifier []Notifier
+
+// Notify вызывает все уведомители и объединяет их ошибки.
+// Если хотя бы один уведомитель сработал, ошибки оборачиваются в PartialError
+func (m MultiNotifier) Notify(ctx context.Context, notification Notification) error {
+	var errs []error
+	for _, n := range m {
+		if err := n.Notify(ctx, notification); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if len(errs) > 0 && len(errs) < len(m) {
+		return &PartialError{Err: errors.Join(errs...)}
+	}
+	return errors.Join(errs...)
+}
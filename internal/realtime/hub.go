@@ -0,0 +1,93 @@
+package realtime
+
+import (
+	"sync"
+
+	"meawle/internal/models"
+)
+
+// subscriberBuffer - число событий, которые подключение может не успеть отправить клиенту
+const subscriberBuffer = 16
+
+// Hub доставляет события пользователям, подключенным к серверу. У пользователя может быть
+// несколько подключений, например в разных вкладках. Хаб хранит подписки в памяти процесса,
+// поэтому события получают только клиенты, подключенные к этому экземпляру сервера
+type Hub struct {
+	mu          sync.Mutex
+	subscribers map[int]map[chan models.MessageEvent]struct{}
+	closed      bool
+}
+
+// NewHub создает новый экземпляр хаба событий
+func NewHub() *Hub {
+	return &Hub{subscribers: make(map[int]map[chan models.MessageEvent]struct{})}
+}
+
+// Subscribe подписывает подключение пользователя на события. Канал закрывается после вызова
+// возвращаемой функции отписки или остановки хаба
+func (h *Hub) Subscribe(userID int) (<-chan models.MessageEvent, func()) {
+	ch := make(chan models.MessageEvent, subscriberBuffer)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.closed {
+		close(ch)
+		return ch, func() {}
+	}
+
+	if h.subscribers[userID] == nil {
+		h.subscribers[userID] = make(map[chan models.MessageEvent]struct{})
+	}
+	h.subscribers[userID][ch] = struct{}{}
+
+	return ch, func() { h.unsubscribe(userID, ch) }
+}
+
+// Publish отправляет событие во все подключения пользователя. Событие пропускается для подключения,
+// которое не успевает его принять, чтобы медленный клиент не задерживал отправителя сообщения
+func (h *Hub) Publish(userID int, event models.MessageEvent) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for ch := range h.subscribers[userID] {
+		select {
+		case ch <- event:
+		default:
+		}
+	}
+}
+
+// Close закрывает все подключения, чтобы сервер мог завершить открытые потоки событий при остановке
+func (h *Hub) Close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for userID, channels := range h.subscribers {
+		for ch := range channels {
+			close(ch)
+		}
+		delete(h.subscribers, userID)
+	}
+	h.closed = true
+}
+
+// unsubscribe удаляет подключение пользователя и закрывает его канал
+func (h *Hub) unsubscribe(userID int, ch chan models.MessageEvent) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	channels, ok := h.subscribers[userID]
+	if !ok {
+		return
+	}
+	if _, ok := channels[ch]; !ok {
+		return
+	}
+
+	delete(channels, ch)
+	close(ch)
+	if len(channels) == 0 {
+		delete(h.subscribers, userID)
+	}
+}
@@ -0,0 +1,86 @@
+package repositories
+
+import (
+	"meawle/internal/models"
+)
+
+// ActivityRepository определяет интерфейс для работы с событиями ленты активности
+type ActivityRepository interface {
+	Record(event *models.ActivityEvent) error
+	GetFeed(filter *models.FeedFilter) ([]models.ActivityEvent, error)
+}
+
+type activityRepository struct {
+	db Database
+}
+
+// NewActivityRepository создает новый экземпляр репозитория событий ленты
+func NewActivityRepository(db Database) ActivityRepository {
+	return &activityRepository{db: db}
+}
+
+// Record сохраняет событие ленты
+func (r *activityRepository) Record(event *models.ActivityEvent) error {
+	query := `INSERT INTO activity_events (user_id, event_type, target_type, target_id, created_at) VALUES (?, ?, ?, ?, ?)`
+
+	result, err := r.db.Execute(query, event.UserID, event.EventType, event.TargetType, event.TargetID, event.CreatedAt)
+	if err != nil {
+		return err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	event.ID = int(id)
+	return nil
+}
+
+// GetFeed возвращает события авторов, на которых подписан пользователь, новые первыми.
+// События удаленных и скрытых модератором объектов и котов, которых пользователь не видит в каталоге, пропускаются
+func (r *activityRepository) GetFeed(filter *models.FeedFilter) ([]models.ActivityEvent, error) {
+	query := `SELECT e.id, e.user_id, e.event_type, e.target_type, e.target_id, e.created_at
+		FROM activity_events e
+		JOIN follows f ON f.followee_id = e.user_id AND f.follower_id = ?
+		WHERE (
+			(e.target_type = ? AND EXISTS (SELECT 1 FROM cats c WHERE c.id = e.target_id AND ((c.visibility = ? AND c.hidden_at IS NULL)
+				OR c.id IN (SELECT cat_id FROM cat_members WHERE user_id = ? AND status = ?)
+				OR c.organization_id IN (SELECT organization_id FROM organization_members WHERE user_id = ?))))
+			OR (e.target_type = ? AND EXISTS (SELECT 1 FROM cat_breeds b WHERE b.id = e.target_id AND b.hidden_at IS NULL))
+			OR (e.target_type = ? AND EXISTS (SELECT 1 FROM lost_found_reports l WHERE l.id = e.target_id))
+		)`
+	params := []interface{}{
+		filter.ViewerID,
+		models.ActivityTargetCat, models.CatVisibilityPublic, filter.ViewerID, models.CatMemberStatusAccepted, filter.ViewerID,
+		models.ActivityTargetBreed,
+		models.ActivityTargetLostFound,
+	}
+
+	// Курсор - ID последнего события предыдущей страницы
+	if filter.BeforeID != 0 {
+		query += " AND e.id < ?"
+		params = append(params, filter.BeforeID)
+	}
+
+	query += " ORDER BY e.id DESC LIMIT ?"
+	params = append(params, filter.Limit)
+
+	rows, err := r.db.Query(query, params...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []models.ActivityEvent
+	for rows.Next() {
+		var event models.ActivityEvent
+		err := rows.Scan(&event.ID, &event.UserID, &event.EventType, &event.TargetType, &event.TargetID, &event.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+
+	return events, nil
+}
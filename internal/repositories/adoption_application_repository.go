@@ -0,0 +1,183 @@
+package repositories
+
+import (
+	"database/sql"
+	"encoding/json"
+
+	"meawle/internal/models"
+)
+
+// AdoptionApplicationRepository определяет интерфейс для работы с заявками на пристройство
+type AdoptionApplicationRepository interface {
+	Create(application *models.AdoptionApplication) error
+	GetByID(id int) (*models.AdoptionApplication, error)
+	GetByListingAndUser(listingID int, userID int) (*models.AdoptionApplication, error)
+	GetByListingID(listingID int) ([]models.AdoptionApplication, error)
+	GetByUserID(userID int) ([]models.AdoptionApplication, error)
+	UpdateStatus(id int, fromStatus string, toStatus string, note *string) error
+	Complete(application *models.AdoptionApplication, record *models.OwnershipRecord, note *string) error
+}
+
+type adoptionApplicationRepository struct {
+	db Database
+}
+
+// applicationSelect содержит запрос заявок с данными объявления и email соискателя
+const applicationSelect = `SELECT a.id, a.listing_id, l.title, l.cat_id, l.user_id, a.user_id, u.email,
+	a.answers, a.message, a.status, a.status_note, a.created_at, a.updated_at
+	FROM adoption_applications a
+	JOIN listings l ON l.id = a.listing_id
+	JOIN users u ON u.id = a.user_id`
+
+// NewAdoptionApplicationRepository создает новый экземпляр репозитория заявок на пристройство
+func NewAdoptionApplicationRepository(db Database) AdoptionApplicationRepository {
+	return &adoptionApplicationRepository{db: db}
+}
+
+// scanApplication сканирует строку результата в модель заявки
+func scanApplication(row rowScanner) (*models.AdoptionApplication, error) {
+	var a models.AdoptionApplication
+	var answers string
+	err := row.Scan(
+		&a.ID, &a.ListingID, &a.ListingTitle, &a.CatID, &a.ListerID, &a.UserID, &a.Email,
+		&answers, &a.Message, &a.Status, &a.StatusNote, &a.CreatedAt, &a.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ответы на анкету хранятся в JSON
+	if err := json.Unmarshal([]byte(answers), &a.Answers); err != nil {
+		return nil, err
+	}
+
+	return &a, nil
+}
+
+// Create создает новую заявку
+func (r *adoptionApplicationRepository) Create(application *models.AdoptionApplication) error {
+	answers, err := json.Marshal(application.Answers)
+	if err != nil {
+		return err
+	}
+
+	query := `INSERT INTO adoption_applications (listing_id, user_id, answers, message, status) VALUES (?, ?, ?, ?, ?)`
+
+	result, err := r.db.Execute(query,
+		application.ListingID, application.UserID, string(answers), application.Message, application.Status,
+	)
+	if err != nil {
+		return err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	application.ID = int(id)
+	return nil
+}
+
+// GetByID возвращает заявку по ID
+func (r *adoptionApplicationRepository) GetByID(id int) (*models.AdoptionApplication, error) {
+	query := applicationSelect + ` WHERE a.id = ?`
+
+	return scanApplication(r.db.QueryRow(query, id))
+}
+
+// GetByListingAndUser возвращает заявку пользователя на объявление
+func (r *adoptionApplicationRepository) GetByListingAndUser(listingID int, userID int) (*models.AdoptionApplication, error) {
+	query := applicationSelect + ` WHERE a.listing_id = ? AND a.user_id = ?`
+
+	return scanApplication(r.db.QueryRow(query, listingID, userID))
+}
+
+// GetByListingID возвращает заявки на объявление в порядке подачи
+func (r *adoptionApplicationRepository) GetByListingID(listingID int) ([]models.AdoptionApplication, error) {
+	query := applicationSelect + ` WHERE a.listing_id = ? ORDER BY a.created_at, a.id`
+
+	return r.queryApplications(query, listingID)
+}
+
+// GetByUserID возвращает заявки пользователя
+func (r *adoptionApplicationRepository) GetByUserID(userID int) ([]models.AdoptionApplication, error) {
+	query := applicationSelect + ` WHERE a.user_id = ? ORDER BY a.created_at DESC, a.id DESC`
+
+	return r.queryApplications(query, userID)
+}
+
+// UpdateStatus переводит заявку из статуса fromStatus в toStatus.
+// Возвращает sql.ErrNoRows, если статус заявки уже изменился
+func (r *adoptionApplicationRepository) UpdateStatus(id int, fromStatus string, toStatus string, note *string) error {
+	query := `UPDATE adoption_applications SET status = ?, status_note = ?, updated_at = CURRENT_TIMESTAMP
+		WHERE id = ? AND status = ?`
+
+	result, err := r.db.Execute(query, toStatus, note, id, fromStatus)
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// Complete завершает одобренную заявку: передает кота соискателю, закрывает объявление
+// и отклоняет остальные заявки в одной транзакции
+func (r *adoptionApplicationRepository) Complete(application *models.AdoptionApplication, record *models.OwnershipRecord, note *string) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	// После успешного Commit откат ничего не делает
+	defer tx.Rollback()
+
+	query := `UPDATE adoption_applications SET status = ?, status_note = ?, updated_at = CURRENT_TIMESTAMP
+		WHERE id = ? AND status = ?`
+	result, err := tx.Exec(query, models.ApplicationStatusCompleted, note, application.ID, models.ApplicationStatusApproved)
+	if err != nil {
+		return err
+	}
+
+	// Заявка могла быть изменена параллельным запросом
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	if err := changeCatOwner(tx, record); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// queryApplications выполняет запрос и возвращает список заявок
+func (r *adoptionApplicationRepository) queryApplications(query string, args ...interface{}) ([]models.AdoptionApplication, error) {
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var applications []models.AdoptionApplication
+	for rows.Next() {
+		application, err := scanApplication(rows)
+		if err != nil {
+			return nil, err
+		}
+		applications = append(applications, *application)
+	}
+
+	return applications, nil
+}
@@ -0,0 +1,75 @@
+package repositories
+
+import (
+	"meawle/internal/models"
+)
+
+// BlockRepository определяет интерфейс для работы с блокировками пользователей
+type BlockRepository interface {
+	Block(blockerID int, blockedID int) error
+	Unblock(blockerID int, blockedID int) error
+	IsBlockedBetween(userID int, otherID int) (bool, error)
+	GetBlocked(blockerID int) ([]models.UserBlock, error)
+}
+
+type blockRepository struct {
+	db Database
+}
+
+// NewBlockRepository создает новый экземпляр репозитория блокировок
+func NewBlockRepository(db Database) BlockRepository {
+	return &blockRepository{db: db}
+}
+
+// Block блокирует пользователя. Повторная блокировка ничего не меняет
+func (r *blockRepository) Block(blockerID int, blockedID int) error {
+	query := `INSERT OR IGNORE INTO user_blocks (blocker_id, blocked_id) VALUES (?, ?)`
+	_, err := r.db.Execute(query, blockerID, blockedID)
+	return err
+}
+
+// Unblock снимает блокировку
+func (r *blockRepository) Unblock(blockerID int, blockedID int) error {
+	query := `DELETE FROM user_blocks WHERE blocker_id = ? AND blocked_id = ?`
+	_, err := r.db.Execute(query, blockerID, blockedID)
+	return err
+}
+
+// IsBlockedBetween проверяет, заблокировал ли один из пользователей другого
+func (r *blockRepository) IsBlockedBetween(userID int, otherID int) (bool, error) {
+	query := `SELECT COUNT(*) FROM user_blocks
+		WHERE (blocker_id = ? AND blocked_id = ?) OR (blocker_id = ? AND blocked_id = ?)`
+
+	var count int
+	if err := r.db.QueryRow(query, userID, otherID, otherID, userID).Scan(&count); err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// GetBlocked возвращает пользователей, заблокированных пользователем, последние первыми
+func (r *blockRepository) GetBlocked(blockerID int) ([]models.UserBlock, error) {
+	query := `SELECT u.id, u.email, b.created_at
+		FROM user_blocks b
+		JOIN users u ON u.id = b.blocked_id
+		WHERE b.blocker_id = ?
+		ORDER BY b.created_at DESC, u.id`
+
+	rows, err := r.db.Query(query, blockerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var blocks []models.UserBlock
+	for rows.Next() {
+		var block models.UserBlock
+		if err := rows.Scan(&block.UserID, &block.Email, &block.CreatedAt); err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, block)
+	}
+
+	return blocks, nil
+}
@@ -1,6 +1,8 @@
 package repositories
 
 import (
+	"time"
+
 	"meawle/internal/models"
 )
 
@@ -10,10 +12,13 @@ type CatBreedRepository interface {
 	GetByID(id int) (*models.CatBreed, error)
 	GetAll() ([]models.CatBreed, error)
 	GetByUserID(userID int) ([]models.CatBreed, error)
+	GetByOrganizationID(organizationID int) ([]models.CatBreed, error)
+	GetLikedByUserID(userID int) ([]models.CatBreed, error)
 	Update(id int, breed *models.CatBreedUpdateRequest) error
 	Delete(id int) error
 	ExistsByName(name string) (bool, error)
 	IsOwner(breedID int, userID int) (bool, error)
+	SetHidden(id int, hiddenAt *time.Time) error
 }
 
 type catBreedRepository struct {
@@ -27,9 +32,9 @@ func NewCatBreedRepository(db Database) CatBreedRepository {
 
 // Create создает новую породу кошек
 func (r *catBreedRepository) Create(breed *models.CatBreed) error {
-	query := `INSERT INTO cat_breeds (name, description, user_id) VALUES (?, ?, ?)`
+	query := `INSERT INTO cat_breeds (name, description, user_id, organization_id) VALUES (?, ?, ?, ?)`
 
-	result, err := r.db.Execute(query, breed.Name, breed.Description, breed.UserID)
+	result, err := r.db.Execute(query, breed.Name, breed.Description, breed.UserID, breed.OrganizationID)
 	if err != nil {
 		return err
 	}
@@ -45,12 +50,12 @@ func (r *catBreedRepository) Create(breed *models.CatBreed) error {
 
 // GetByID возвращает породу кошек по ID
 func (r *catBreedRepository) GetByID(id int) (*models.CatBreed, error) {
-	query := `SELECT id, name, description, user_id, created_at FROM cat_breeds WHERE id = ?`
+	query := `SELECT id, name, description, user_id, organization_id, hidden_at, created_at FROM cat_breeds WHERE id = ?`
 
 	row := r.db.QueryRow(query, id)
 
 	var breed models.CatBreed
-	err := row.Scan(&breed.ID, &breed.Name, &breed.Description, &breed.UserID, &breed.CreatedAt)
+	err := row.Scan(&breed.ID, &breed.Name, &breed.Description, &breed.UserID, &breed.OrganizationID, &breed.HiddenAt, &breed.CreatedAt)
 	if err != nil {
 		return nil, err
 	}
@@ -58,9 +63,9 @@ func (r *catBreedRepository) GetByID(id int) (*models.CatBreed, error) {
 	return &breed, nil
 }
 
-// GetAll возвращает все породы кошек
+// GetAll возвращает все породы кошек, кроме скрытых модератором
 func (r *catBreedRepository) GetAll() ([]models.CatBreed, error) {
-	query := `SELECT id, name, description, user_id, created_at FROM cat_breeds ORDER BY created_at DESC`
+	query := `SELECT id, name, description, user_id, organization_id, hidden_at, created_at FROM cat_breeds WHERE hidden_at IS NULL ORDER BY created_at DESC`
 
 	rows, err := r.db.Query(query)
 	if err != nil {
@@ -71,7 +76,7 @@ func (r *catBreedRepository) GetAll() ([]models.CatBreed, error) {
 	var breeds []models.CatBreed
 	for rows.Next() {
 		var breed models.CatBreed
-		err := rows.Scan(&breed.ID, &breed.Name, &breed.Description, &breed.UserID, &breed.CreatedAt)
+		err := rows.Scan(&breed.ID, &breed.Name, &breed.Description, &breed.UserID, &breed.OrganizationID, &breed.HiddenAt, &breed.CreatedAt)
 		if err != nil {
 			return nil, err
 		}
@@ -83,7 +88,7 @@ func (r *catBreedRepository) GetAll() ([]models.CatBreed, error) {
 
 // GetByUserID возвращает породы кошек по ID пользователя
 func (r *catBreedRepository) GetByUserID(userID int) ([]models.CatBreed, error) {
-	query := `SELECT id, name, description, user_id, created_at FROM cat_breeds WHERE user_id = ? ORDER BY created_at DESC`
+	query := `SELECT id, name, description, user_id, organization_id, hidden_at, created_at FROM cat_breeds WHERE user_id = ? ORDER BY created_at DESC`
 
 	rows, err := r.db.Query(query, userID)
 	if err != nil {
@@ -94,7 +99,57 @@ func (r *catBreedRepository) GetByUserID(userID int) ([]models.CatBreed, error)
 	var breeds []models.CatBreed
 	for rows.Next() {
 		var breed models.CatBreed
-		err := rows.Scan(&breed.ID, &breed.Name, &breed.Description, &breed.UserID, &breed.CreatedAt)
+		err := rows.Scan(&breed.ID, &breed.Name, &breed.Description, &breed.UserID, &breed.OrganizationID, &breed.HiddenAt, &breed.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		breeds = append(breeds, breed)
+	}
+
+	return breeds, nil
+}
+
+// GetByOrganizationID возвращает породы кошек организации, кроме скрытых модератором
+func (r *catBreedRepository) GetByOrganizationID(organizationID int) ([]models.CatBreed, error) {
+	query := `SELECT id, name, description, user_id, organization_id, hidden_at, created_at FROM cat_breeds WHERE organization_id = ? AND hidden_at IS NULL ORDER BY created_at DESC`
+
+	rows, err := r.db.Query(query, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var breeds []models.CatBreed
+	for rows.Next() {
+		var breed models.CatBreed
+		err := rows.Scan(&breed.ID, &breed.Name, &breed.Description, &breed.UserID, &breed.OrganizationID, &breed.HiddenAt, &breed.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		breeds = append(breeds, breed)
+	}
+
+	return breeds, nil
+}
+
+// GetLikedByUserID возвращает породы кошек, отмеченные пользователем, последние отмеченные первыми
+func (r *catBreedRepository) GetLikedByUserID(userID int) ([]models.CatBreed, error) {
+	query := `SELECT b.id, b.name, b.description, b.user_id, b.organization_id, b.hidden_at, b.created_at
+		FROM cat_breeds b
+		JOIN cat_breed_likes l ON l.breed_id = b.id
+		WHERE l.user_id = ? AND b.hidden_at IS NULL
+		ORDER BY l.created_at DESC, l.id DESC`
+
+	rows, err := r.db.Query(query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var breeds []models.CatBreed
+	for rows.Next() {
+		var breed models.CatBreed
+		err := rows.Scan(&breed.ID, &breed.Name, &breed.Description, &breed.UserID, &breed.OrganizationID, &breed.HiddenAt, &breed.CreatedAt)
 		if err != nil {
 			return nil, err
 		}
@@ -119,6 +174,11 @@ func (r *catBreedRepository) Update(id int, updateReq *models.CatBreedUpdateRequ
 		params = append(params, *updateReq.Description)
 	}
 
+	if updateReq.OrganizationID != nil {
+		query += "organization_id = ?, "
+		params = append(params, nullableID(*updateReq.OrganizationID))
+	}
+
 	// Убираем последнюю запятую и пробел
 	query = query[:len(query)-2]
 	query += " WHERE id = ?"
@@ -128,11 +188,30 @@ func (r *catBreedRepository) Update(id int, updateReq *models.CatBreedUpdateRequ
 	return err
 }
 
-// Delete удаляет породу кошек
+// Delete удаляет породу кошек вместе с комментариями на ее странице в одной транзакции
 func (r *catBreedRepository) Delete(id int) error {
-	query := `DELETE FROM cat_breeds WHERE id = ?`
-	_, err := r.db.Execute(query, id)
-	return err
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// Комментарии ссылаются на породу через target_type и target_id, поэтому внешние ключи их не удаляют
+	queries := []string{
+		`DELETE FROM comments WHERE target_type = ? AND target_id = ?`,
+		`DELETE FROM comment_thread_locks WHERE target_type = ? AND target_id = ?`,
+	}
+	for _, query := range queries {
+		if _, err := tx.Exec(query, models.CommentTargetBreed, id); err != nil {
+			return err
+		}
+	}
+
+	if _, err := tx.Exec(`DELETE FROM cat_breeds WHERE id = ?`, id); err != nil {
+		return err
+	}
+
+	return tx.Commit()
 }
 
 // ExistsByName проверяет существование породы по названию
@@ -159,4 +238,11 @@ func (r *catBreedRepository) IsOwner(breedID int, userID int) (bool, error) {
 	}
 
 	return count > 0, nil
+}
+
+// SetHidden скрывает породу из каталога или возвращает ее, если hiddenAt равен nil
+func (r *catBreedRepository) SetHidden(id int, hiddenAt *time.Time) error {
+	query := `UPDATE cat_breeds SET hidden_at = ? WHERE id = ?`
+	_, err := r.db.Execute(query, hiddenAt, id)
+	return err
 }
\ No newline at end of file
@@ -0,0 +1,146 @@
+package repositories
+
+import (
+	"meawle/internal/models"
+)
+
+// CatMemberRepository определяет интерфейс для работы с участниками котов
+type CatMemberRepository interface {
+	Create(member *models.CatMember) error
+	GetByID(id int) (*models.CatMember, error)
+	GetByCatAndEmail(catID int, email string) (*models.CatMember, error)
+	GetByCatID(catID int) ([]models.CatMember, error)
+	GetPendingByUserID(userID int) ([]models.CatMember, error)
+	Accept(id int, userID int) error
+	UpdateRole(id int, role string) error
+	Delete(id int) error
+}
+
+type catMemberRepository struct {
+	db Database
+}
+
+// catMemberSelect содержит запрос участников с именем кота и email пользователя.
+// У приглашения по email без аккаунта user_id равен 0, а email берется из приглашения
+const catMemberSelect = `SELECT m.id, m.cat_id, c.name, COALESCE(m.user_id, 0), COALESCE(u.email, m.invite_email),
+	m.role, m.status, m.invited_by, m.created_at, m.accepted_at
+	FROM cat_members m
+	JOIN cats c ON c.id = m.cat_id
+	LEFT JOIN users u ON u.id = m.user_id`
+
+// NewCatMemberRepository создает новый экземпляр репозитория участников котов
+func NewCatMemberRepository(db Database) CatMemberRepository {
+	return &catMemberRepository{db: db}
+}
+
+// scanCatMember сканирует строку результата в модель участника
+func scanCatMember(row rowScanner) (*models.CatMember, error) {
+	var m models.CatMember
+	err := row.Scan(
+		&m.ID, &m.CatID, &m.CatName, &m.UserID, &m.Email, &m.Role, &m.Status, &m.InvitedBy,
+		&m.CreatedAt, &m.AcceptedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+// Create создает приглашение участника. Если UserID равен 0, приглашение сохраняется по email
+func (r *catMemberRepository) Create(member *models.CatMember) error {
+	query := `INSERT INTO cat_members (cat_id, user_id, invite_email, role, status, invited_by) VALUES (?, ?, ?, ?, ?, ?)`
+
+	var inviteEmail interface{}
+	if member.UserID == 0 {
+		inviteEmail = member.Email
+	}
+
+	result, err := r.db.Execute(query,
+		member.CatID, nullableID(member.UserID), inviteEmail, member.Role, member.Status, member.InvitedBy,
+	)
+	if err != nil {
+		return err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	member.ID = int(id)
+	return nil
+}
+
+// GetByID возвращает участника по ID
+func (r *catMemberRepository) GetByID(id int) (*models.CatMember, error) {
+	query := catMemberSelect + ` WHERE m.id = ?`
+
+	return scanCatMember(r.db.QueryRow(query, id))
+}
+
+// GetByCatAndEmail возвращает участие или приглашение пользователя с указанным email в коте
+func (r *catMemberRepository) GetByCatAndEmail(catID int, email string) (*models.CatMember, error) {
+	query := catMemberSelect + ` WHERE m.cat_id = ? AND COALESCE(u.email, m.invite_email) = ?`
+
+	return scanCatMember(r.db.QueryRow(query, catID, email))
+}
+
+// GetByCatID возвращает участников кота, включая неподтвержденные приглашения
+func (r *catMemberRepository) GetByCatID(catID int) ([]models.CatMember, error) {
+	query := catMemberSelect + ` WHERE m.cat_id = ? ORDER BY m.created_at, m.id`
+
+	return r.queryCatMembers(query, catID)
+}
+
+// GetPendingByUserID возвращает приглашения, ожидающие ответа пользователя,
+// включая приглашения по email, отправленные до его регистрации
+func (r *catMemberRepository) GetPendingByUserID(userID int) ([]models.CatMember, error) {
+	query := catMemberSelect + ` WHERE m.status = ?
+		AND (m.user_id = ? OR (m.user_id IS NULL AND m.invite_email = (SELECT email FROM users WHERE id = ?)))
+		ORDER BY m.created_at DESC, m.id DESC`
+
+	return r.queryCatMembers(query, models.CatMemberStatusPending, userID, userID)
+}
+
+// Accept подтверждает участие. Приглашение по email закрепляется за принявшим его пользователем
+func (r *catMemberRepository) Accept(id int, userID int) error {
+	query := `UPDATE cat_members SET status = ?, user_id = ?, invite_email = NULL, accepted_at = CURRENT_TIMESTAMP
+		WHERE id = ?`
+	_, err := r.db.Execute(query, models.CatMemberStatusAccepted, userID, id)
+	return err
+}
+
+// UpdateRole изменяет роль участника
+func (r *catMemberRepository) UpdateRole(id int, role string) error {
+	query := `UPDATE cat_members SET role = ? WHERE id = ?`
+	_, err := r.db.Execute(query, role, id)
+	return err
+}
+
+// Delete удаляет участника или отклоненное приглашение
+func (r *catMemberRepository) Delete(id int) error {
+	query := `DELETE FROM cat_members WHERE id = ?`
+	_, err := r.db.Execute(query, id)
+	return err
+}
+
+// queryCatMembers выполняет запрос и возвращает список участников
+func (r *catMemberRepository) queryCatMembers(query string, args ...interface{}) ([]models.CatMember, error) {
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var members []models.CatMember
+	for rows.Next() {
+		member, err := scanCatMember(rows)
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, *member)
+	}
+
+	return members, nil
+}
@@ -1,6 +1,9 @@
 package repositories
 
 import (
+	"strings"
+	"time"
+
 	"meawle/internal/models"
 )
 
@@ -8,27 +11,64 @@ import (
 type CatRepository interface {
 	Create(cat *models.Cat) error
 	GetByID(id int) (*models.Cat, error)
-	GetAll() ([]models.Cat, error)
+	GetAll(filter *models.CatFilter) ([]models.Cat, error)
 	GetByUserID(userID int) ([]models.Cat, error)
+	GetByMicrochipNumber(number string) (*models.Cat, error)
+	GetByLitterID(litterID int) ([]models.Cat, error)
+	GetByMemberID(userID int) ([]models.Cat, error)
 	Update(id int, cat *models.CatUpdateRequest) error
+	UpdateAvailability(id int, availability string) error
 	Delete(id int) error
 	IsOwner(catID int, userID int) (bool, error)
+	GetUserRole(catID int, userID int) (string, error)
+	GetUserRoles(userID int) (map[int]string, error)
+	SetHidden(id int, hiddenAt *time.Time) error
 }
 
 type catRepository struct {
 	db Database
 }
 
+// catColumns содержит список колонок, выбираемых для кота
+const catColumns = `id, name, birth_date, description, sex, color, coat_pattern, eye_color,
+	weight_kg, microchip_number, is_neutered, neutered_at, sire_id, dam_id, sire_external_id, dam_external_id,
+	litter_id, availability, visibility, user_id, organization_id, hidden_at, created_at`
+
+// catInsertQuery содержит запрос на создание кота, общий для репозиториев котов и пометов
+const catInsertQuery = `INSERT INTO cats (name, birth_date, description, sex, color, coat_pattern, eye_color,
+	weight_kg, microchip_number, is_neutered, neutered_at, sire_id, dam_id, sire_external_id, dam_external_id,
+	litter_id, availability, visibility, user_id, organization_id)
+	VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+// rowScanner абстрагирует *sql.Row и *sql.Rows для сканирования одной строки
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
 // NewCatRepository создает новый экземпляр репозитория котов
 func NewCatRepository(db Database) CatRepository {
 	return &catRepository{db: db}
 }
 
+// scanCat сканирует строку результата в модель кота
+func scanCat(row rowScanner) (*models.Cat, error) {
+	var cat models.Cat
+	err := row.Scan(
+		&cat.ID, &cat.Name, &cat.BirthDate, &cat.Description, &cat.Sex, &cat.Color, &cat.CoatPattern,
+		&cat.EyeColor, &cat.WeightKg, &cat.MicrochipNumber, &cat.IsNeutered, &cat.NeuteredAt,
+		&cat.SireID, &cat.DamID, &cat.SireExternalID, &cat.DamExternalID, &cat.LitterID, &cat.Availability,
+		&cat.Visibility, &cat.UserID, &cat.OrganizationID, &cat.HiddenAt, &cat.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &cat, nil
+}
+
 // Create создает нового кота
 func (r *catRepository) Create(cat *models.Cat) error {
-	query := `INSERT INTO cats (name, age, description, user_id) VALUES (?, ?, ?, ?)`
-
-	result, err := r.db.Execute(query, cat.Name, cat.Age, cat.Description, cat.UserID)
+	result, err := r.db.Execute(catInsertQuery, catInsertArgs(cat)...)
 	if err != nil {
 		return err
 	}
@@ -42,65 +82,121 @@ func (r *catRepository) Create(cat *models.Cat) error {
 	return nil
 }
 
+// catInsertArgs возвращает параметры запроса catInsertQuery для кота
+func catInsertArgs(cat *models.Cat) []interface{} {
+	return []interface{}{
+		cat.Name, cat.BirthDate, cat.Description, cat.Sex, cat.Color, cat.CoatPattern, cat.EyeColor,
+		cat.WeightKg, cat.MicrochipNumber, cat.IsNeutered, cat.NeuteredAt,
+		cat.SireID, cat.DamID, cat.SireExternalID, cat.DamExternalID,
+		cat.LitterID, cat.Availability, cat.Visibility, cat.UserID, cat.OrganizationID,
+	}
+}
+
 // GetByID возвращает кота по ID
 func (r *catRepository) GetByID(id int) (*models.Cat, error) {
-	query := `SELECT id, name, age, description, user_id, created_at FROM cats WHERE id = ?`
+	query := `SELECT ` + catColumns + ` FROM cats WHERE id = ?`
 
-	row := r.db.QueryRow(query, id)
+	return scanCat(r.db.QueryRow(query, id))
+}
 
-	var cat models.Cat
-	err := row.Scan(&cat.ID, &cat.Name, &cat.Age, &cat.Description, &cat.UserID, &cat.CreatedAt)
-	if err != nil {
-		return nil, err
-	}
+// GetAll возвращает всех котов, удовлетворяющих фильтру
+func (r *catRepository) GetAll(filter *models.CatFilter) ([]models.Cat, error) {
+	query := `SELECT ` + catColumns + ` FROM cats`
+	conditions := []string{}
+	params := []interface{}{}
+	orderBy := "created_at DESC"
 
-	return &cat, nil
-}
+	if filter != nil {
+		if filter.Sex != nil {
+			conditions = append(conditions, "sex = ?")
+			params = append(params, *filter.Sex)
+		}
 
-// GetAll возвращает всех котов
-func (r *catRepository) GetAll() ([]models.Cat, error) {
-	query := `SELECT id, name, age, description, user_id, created_at FROM cats ORDER BY created_at DESC`
+		if filter.Color != nil {
+			conditions = append(conditions, "color = ? COLLATE NOCASE")
+			params = append(params, *filter.Color)
+		}
 
-	rows, err := r.db.Query(query)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
+		if filter.CoatPattern != nil {
+			conditions = append(conditions, "coat_pattern = ?")
+			params = append(params, *filter.CoatPattern)
+		}
 
-	var cats []models.Cat
-	for rows.Next() {
-		var cat models.Cat
-		err := rows.Scan(&cat.ID, &cat.Name, &cat.Age, &cat.Description, &cat.UserID, &cat.CreatedAt)
-		if err != nil {
-			return nil, err
+		if filter.EyeColor != nil {
+			conditions = append(conditions, "eye_color = ? COLLATE NOCASE")
+			params = append(params, *filter.EyeColor)
+		}
+
+		if filter.IsNeutered != nil {
+			conditions = append(conditions, "is_neutered = ?")
+			params = append(params, *filter.IsNeutered)
+		}
+
+		if filter.OrganizationID != nil {
+			conditions = append(conditions, "organization_id = ?")
+			params = append(params, *filter.OrganizationID)
+		}
+
+		if filter.LikedByID != 0 {
+			conditions = append(conditions, "id IN (SELECT cat_id FROM cat_likes WHERE user_id = ?)")
+			params = append(params, filter.LikedByID)
+		}
+
+		// В каталог попадают публичные коты, не скрытые модератором, а также собственные коты, коты,
+		// где пользователь участник, и коты организаций, где он сотрудник. В избранное попадают и коты, доступные по ссылке
+		if !filter.IncludeHidden {
+			visibilityCondition, visibility := "visibility = ?", models.CatVisibilityPublic
+			if filter.LikedByID != 0 {
+				visibilityCondition, visibility = "visibility != ?", models.CatVisibilityPrivate
+			}
+			conditions = append(conditions, `((`+visibilityCondition+` AND hidden_at IS NULL) OR user_id = ?
+				OR id IN (SELECT cat_id FROM cat_members WHERE user_id = ? AND status = ?)
+				OR organization_id IN (SELECT organization_id FROM organization_members WHERE user_id = ?))`)
+			params = append(params, visibility, filter.ViewerID, filter.ViewerID,
+				models.CatMemberStatusAccepted, filter.ViewerID)
+		}
+
+		if filter.Sort == models.CatSortPopular {
+			orderBy = "(SELECT COUNT(*) FROM cat_likes WHERE cat_likes.cat_id = cats.id) DESC, " + orderBy
 		}
-		cats = append(cats, cat)
 	}
 
-	return cats, nil
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	query += " ORDER BY " + orderBy
+
+	return r.queryCats(query, params...)
 }
 
 // GetByUserID возвращает котов по ID пользователя
 func (r *catRepository) GetByUserID(userID int) ([]models.Cat, error) {
-	query := `SELECT id, name, age, description, user_id, created_at FROM cats WHERE user_id = ? ORDER BY created_at DESC`
+	query := `SELECT ` + catColumns + ` FROM cats WHERE user_id = ? ORDER BY created_at DESC`
 
-	rows, err := r.db.Query(query, userID)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
+	return r.queryCats(query, userID)
+}
 
-	var cats []models.Cat
-	for rows.Next() {
-		var cat models.Cat
-		err := rows.Scan(&cat.ID, &cat.Name, &cat.Age, &cat.Description, &cat.UserID, &cat.CreatedAt)
-		if err != nil {
-			return nil, err
-		}
-		cats = append(cats, cat)
-	}
+// GetByMicrochipNumber возвращает кота по номеру микрочипа
+func (r *catRepository) GetByMicrochipNumber(number string) (*models.Cat, error) {
+	query := `SELECT ` + catColumns + ` FROM cats WHERE microchip_number = ?`
 
-	return cats, nil
+	return scanCat(r.db.QueryRow(query, number))
+}
+
+// GetByLitterID возвращает котят помета
+func (r *catRepository) GetByLitterID(litterID int) ([]models.Cat, error) {
+	query := `SELECT ` + catColumns + ` FROM cats WHERE litter_id = ? ORDER BY id`
+
+	return r.queryCats(query, litterID)
+}
+
+// GetByMemberID возвращает котов, в которых пользователь участвует по подтвержденному приглашению
+func (r *catRepository) GetByMemberID(userID int) ([]models.Cat, error) {
+	query := `SELECT ` + catColumns + ` FROM cats
+		WHERE id IN (SELECT cat_id FROM cat_members WHERE user_id = ? AND status = ?)
+		ORDER BY created_at DESC`
+
+	return r.queryCats(query, userID, models.CatMemberStatusAccepted)
 }
 
 // Update обновляет данные кота
@@ -113,9 +209,9 @@ func (r *catRepository) Update(id int, updateReq *models.CatUpdateRequest) error
 		params = append(params, *updateReq.Name)
 	}
 
-	if updateReq.Age != nil {
-		query += "age = ?, "
-		params = append(params, *updateReq.Age)
+	if updateReq.BirthDate != nil {
+		query += "birth_date = ?, "
+		params = append(params, *updateReq.BirthDate)
 	}
 
 	if updateReq.Description != nil {
@@ -123,6 +219,95 @@ func (r *catRepository) Update(id int, updateReq *models.CatUpdateRequest) error
 		params = append(params, *updateReq.Description)
 	}
 
+	if updateReq.Sex != nil {
+		query += "sex = ?, "
+		params = append(params, *updateReq.Sex)
+	}
+
+	if updateReq.Color != nil {
+		query += "color = ?, "
+		params = append(params, *updateReq.Color)
+	}
+
+	if updateReq.CoatPattern != nil {
+		query += "coat_pattern = ?, "
+		params = append(params, *updateReq.CoatPattern)
+	}
+
+	if updateReq.EyeColor != nil {
+		query += "eye_color = ?, "
+		params = append(params, *updateReq.EyeColor)
+	}
+
+	if updateReq.WeightKg != nil {
+		query += "weight_kg = ?, "
+		params = append(params, *updateReq.WeightKg)
+	}
+
+	if updateReq.MicrochipNumber != nil {
+		query += "microchip_number = ?, "
+		params = append(params, *updateReq.MicrochipNumber)
+	}
+
+	if updateReq.IsNeutered != nil {
+		query += "is_neutered = ?, "
+		params = append(params, *updateReq.IsNeutered)
+
+		// Снятие отметки о стерилизации сбрасывает и ее дату
+		if !*updateReq.IsNeutered {
+			query += "neutered_at = NULL, "
+		}
+	}
+
+	if updateReq.NeuteredAt != nil {
+		query += "neutered_at = ?, "
+		params = append(params, *updateReq.NeuteredAt)
+	}
+
+	// Родитель задается либо зарегистрированным котом, либо внешним предком, поэтому
+	// установка одной ссылки сбрасывает другую, а значение 0 удаляет ссылку
+	if updateReq.SireID != nil {
+		query += "sire_id = ?, "
+		params = append(params, nullableID(*updateReq.SireID))
+		if *updateReq.SireID != 0 {
+			query += "sire_external_id = NULL, "
+		}
+	}
+
+	if updateReq.DamID != nil {
+		query += "dam_id = ?, "
+		params = append(params, nullableID(*updateReq.DamID))
+		if *updateReq.DamID != 0 {
+			query += "dam_external_id = NULL, "
+		}
+	}
+
+	if updateReq.SireExternalID != nil {
+		query += "sire_external_id = ?, "
+		params = append(params, nullableID(*updateReq.SireExternalID))
+		if *updateReq.SireExternalID != 0 {
+			query += "sire_id = NULL, "
+		}
+	}
+
+	if updateReq.DamExternalID != nil {
+		query += "dam_external_id = ?, "
+		params = append(params, nullableID(*updateReq.DamExternalID))
+		if *updateReq.DamExternalID != 0 {
+			query += "dam_id = NULL, "
+		}
+	}
+
+	if updateReq.Visibility != nil {
+		query += "visibility = ?, "
+		params = append(params, *updateReq.Visibility)
+	}
+
+	if updateReq.OrganizationID != nil {
+		query += "organization_id = ?, "
+		params = append(params, nullableID(*updateReq.OrganizationID))
+	}
+
 	// Убираем последнюю запятую и пробел
 	query = query[:len(query)-2]
 	query += " WHERE id = ?"
@@ -132,10 +317,44 @@ func (r *catRepository) Update(id int, updateReq *models.CatUpdateRequest) error
 	return err
 }
 
-// Delete удаляет кота
+// UpdateAvailability обновляет статус доступности котенка
+func (r *catRepository) UpdateAvailability(id int, availability string) error {
+	query := `UPDATE cats SET availability = ? WHERE id = ?`
+	_, err := r.db.Execute(query, availability, id)
+	return err
+}
+
+// Delete удаляет кота вместе с комментариями на его странице в одной транзакции.
+// Комментарии ссылаются на кота через target_type и target_id, поэтому внешние ключи их не удаляют
 func (r *catRepository) Delete(id int) error {
-	query := `DELETE FROM cats WHERE id = ?`
-	_, err := r.db.Execute(query, id)
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// Ответы удаляются каскадно вместе с комментариями верхнего уровня
+	queries := []string{
+		`DELETE FROM comments WHERE target_type = ? AND target_id = ?`,
+		`DELETE FROM comment_thread_locks WHERE target_type = ? AND target_id = ?`,
+	}
+	for _, query := range queries {
+		if _, err := tx.Exec(query, models.CommentTargetCat, id); err != nil {
+			return err
+		}
+	}
+
+	if _, err := tx.Exec(`DELETE FROM cats WHERE id = ?`, id); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// SetHidden скрывает кота из каталога или возвращает его, если hiddenAt равен nil
+func (r *catRepository) SetHidden(id int, hiddenAt *time.Time) error {
+	query := `UPDATE cats SET hidden_at = ? WHERE id = ?`
+	_, err := r.db.Execute(query, hiddenAt, id)
 	return err
 }
 
@@ -150,4 +369,96 @@ func (r *catRepository) IsOwner(catID int, userID int) (bool, error) {
 	}
 
 	return count > 0, nil
-}
\ No newline at end of file
+}
+
+// nullableID преобразует нулевой ID в NULL для удаления ссылки
+func nullableID(id int) interface{} {
+	if id == 0 {
+		return nil
+	}
+	return id
+}
+
+// catRolesSelect содержит запрос составляющих роли пользователя в котах: признак основного владельца,
+// роль подтвержденного участника и роль сотрудника организации кота
+const catRolesSelect = `SELECT c.id, c.user_id = ?, COALESCE(m.role, ''), COALESCE(om.role, '')
+	FROM cats c
+	LEFT JOIN cat_members m ON m.cat_id = c.id AND m.user_id = ? AND m.status = ?
+	LEFT JOIN organization_members om ON om.organization_id = c.organization_id AND om.user_id = ?`
+
+// GetUserRole возвращает старшую роль пользователя для кота: owner для основного владельца,
+// роль из подтвержденного участия или из роли сотрудника организации кота, либо пустую строку.
+// Для несуществующего кота возвращает sql.ErrNoRows
+func (r *catRepository) GetUserRole(catID int, userID int) (string, error) {
+	query := catRolesSelect + ` WHERE c.id = ?`
+
+	var id int
+	var isOwner bool
+	var memberRole, organizationRole string
+	err := r.db.QueryRow(query, userID, userID, models.CatMemberStatusAccepted, userID, catID).
+		Scan(&id, &isOwner, &memberRole, &organizationRole)
+	if err != nil {
+		return "", err
+	}
+
+	return resolveCatRole(isOwner, memberRole, organizationRole), nil
+}
+
+// GetUserRoles возвращает роли пользователя во всех его котах, котах, где он участник,
+// и котах его организаций по ID кота
+func (r *catRepository) GetUserRoles(userID int) (map[int]string, error) {
+	query := catRolesSelect + ` WHERE c.user_id = ? OR m.id IS NOT NULL OR om.id IS NOT NULL`
+
+	rows, err := r.db.Query(query, userID, userID, models.CatMemberStatusAccepted, userID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	roles := make(map[int]string)
+	for rows.Next() {
+		var catID int
+		var isOwner bool
+		var memberRole, organizationRole string
+		if err := rows.Scan(&catID, &isOwner, &memberRole, &organizationRole); err != nil {
+			return nil, err
+		}
+		roles[catID] = resolveCatRole(isOwner, memberRole, organizationRole)
+	}
+
+	return roles, nil
+}
+
+// resolveCatRole выбирает старшую из ролей пользователя в коте
+func resolveCatRole(isOwner bool, memberRole string, organizationRole string) string {
+	if isOwner {
+		return models.CatRoleOwner
+	}
+
+	role := memberRole
+	if orgCatRole := models.OrganizationCatRoles[organizationRole]; models.CatRoleRanks[orgCatRole] > models.CatRoleRanks[role] {
+		role = orgCatRole
+	}
+
+	return role
+}
+
+// queryCats выполняет запрос и возвращает список котов
+func (r *catRepository) queryCats(query string, args ...interface{}) ([]models.Cat, error) {
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var cats []models.Cat
+	for rows.Next() {
+		cat, err := scanCat(rows)
+		if err != nil {
+			return nil, err
+		}
+		cats = append(cats, *cat)
+	}
+
+	return cats, nil
+}
@@ -0,0 +1,65 @@
+package repositories
+
+import (
+	"meawle/internal/models"
+)
+
+// ChipContactRepository определяет интерфейс для работы с запросами на связь по микрочипу
+type ChipContactRepository interface {
+	Create(request *models.ChipContactRequest) error
+	GetByOwnerID(userID int) ([]models.ChipContactRequest, error)
+}
+
+type chipContactRepository struct {
+	db Database
+}
+
+// NewChipContactRepository создает новый экземпляр репозитория запросов на связь
+func NewChipContactRepository(db Database) ChipContactRepository {
+	return &chipContactRepository{db: db}
+}
+
+// Create сохраняет новый запрос на связь
+func (r *chipContactRepository) Create(request *models.ChipContactRequest) error {
+	query := `INSERT INTO chip_contact_requests (cat_id, finder_name, finder_contact, message) VALUES (?, ?, ?, ?)`
+
+	result, err := r.db.Execute(query, request.CatID, request.FinderName, request.FinderContact, request.Message)
+	if err != nil {
+		return err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	request.ID = int(id)
+	return nil
+}
+
+// GetByOwnerID возвращает запросы на связь по всем котам пользователя
+func (r *chipContactRepository) GetByOwnerID(userID int) ([]models.ChipContactRequest, error) {
+	query := `SELECT r.id, r.cat_id, r.finder_name, r.finder_contact, r.message, r.created_at
+		FROM chip_contact_requests r
+		JOIN cats c ON c.id = r.cat_id
+		WHERE c.user_id = ?
+		ORDER BY r.created_at DESC`
+
+	rows, err := r.db.Query(query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var requests []models.ChipContactRequest
+	for rows.Next() {
+		var request models.ChipContactRequest
+		err := rows.Scan(&request.ID, &request.CatID, &request.FinderName, &request.FinderContact, &request.Message, &request.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		requests = append(requests, request)
+	}
+
+	return requests, nil
+}
@@ -0,0 +1,198 @@
+package repositories
+
+import (
+	"strings"
+	"time"
+
+	"meawle/internal/models"
+)
+
+// CommentRepository определяет интерфейс для работы с комментариями
+type CommentRepository interface {
+	Create(comment *models.Comment) error
+	GetByID(id int) (*models.Comment, error)
+	GetRoots(targetType string, targetID int, limit int, offset int) ([]models.Comment, error)
+	CountRoots(targetType string, targetID int) (int, error)
+	GetReplies(rootIDs []int) ([]models.Comment, error)
+	UpdateBody(id int, body string, editedAt time.Time) error
+	SoftDelete(id int, deletedAt time.Time) error
+	SetHidden(id int, hidden bool, moderatorID int) error
+	CountByUserSince(userID int, since time.Time) (int, error)
+	IsLocked(targetType string, targetID int) (bool, error)
+	Lock(targetType string, targetID int, userID int) error
+	Unlock(targetType string, targetID int) error
+}
+
+type commentRepository struct {
+	db Database
+}
+
+// commentColumns содержит список колонок, выбираемых для комментария
+const commentColumns = `id, target_type, target_id, user_id, parent_id, root_id, body, is_hidden, hidden_by,
+	edited_at, deleted_at, created_at`
+
+// NewCommentRepository создает новый экземпляр репозитория комментариев
+func NewCommentRepository(db Database) CommentRepository {
+	return &commentRepository{db: db}
+}
+
+// scanComment сканирует строку результата в модель комментария
+func scanComment(row rowScanner) (*models.Comment, error) {
+	var c models.Comment
+	err := row.Scan(
+		&c.ID, &c.TargetType, &c.TargetID, &c.UserID, &c.ParentID, &c.RootID, &c.Body, &c.IsHidden, &c.HiddenBy,
+		&c.EditedAt, &c.DeletedAt, &c.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &c, nil
+}
+
+// Create создает новый комментарий
+func (r *commentRepository) Create(comment *models.Comment) error {
+	query := `INSERT INTO comments (target_type, target_id, user_id, parent_id, root_id, body, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?)`
+
+	result, err := r.db.Execute(query,
+		comment.TargetType, comment.TargetID, comment.UserID, comment.ParentID, comment.RootID, comment.Body, comment.CreatedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	comment.ID = int(id)
+	return nil
+}
+
+// GetByID возвращает комментарий по ID
+func (r *commentRepository) GetByID(id int) (*models.Comment, error) {
+	query := `SELECT ` + commentColumns + ` FROM comments WHERE id = ?`
+
+	return scanComment(r.db.QueryRow(query, id))
+}
+
+// GetRoots возвращает страницу комментариев верхнего уровня, новые первыми
+func (r *commentRepository) GetRoots(targetType string, targetID int, limit int, offset int) ([]models.Comment, error) {
+	query := `SELECT ` + commentColumns + ` FROM comments
+		WHERE target_type = ? AND target_id = ? AND root_id IS NULL
+		ORDER BY created_at DESC, id DESC LIMIT ? OFFSET ?`
+
+	return r.queryComments(query, targetType, targetID, limit, offset)
+}
+
+// CountRoots возвращает число комментариев верхнего уровня
+func (r *commentRepository) CountRoots(targetType string, targetID int) (int, error) {
+	query := `SELECT COUNT(*) FROM comments WHERE target_type = ? AND target_id = ? AND root_id IS NULL`
+
+	var count int
+	err := r.db.QueryRow(query, targetType, targetID).Scan(&count)
+	return count, err
+}
+
+// GetReplies возвращает все ответы в ветках указанных комментариев верхнего уровня в порядке написания
+func (r *commentRepository) GetReplies(rootIDs []int) ([]models.Comment, error) {
+	if len(rootIDs) == 0 {
+		return nil, nil
+	}
+
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(rootIDs)), ", ")
+	query := `SELECT ` + commentColumns + ` FROM comments
+		WHERE root_id IN (` + placeholders + `)
+		ORDER BY created_at, id`
+
+	params := make([]interface{}, 0, len(rootIDs))
+	for _, id := range rootIDs {
+		params = append(params, id)
+	}
+
+	return r.queryComments(query, params...)
+}
+
+// UpdateBody обновляет текст комментария и отмечает время редактирования
+func (r *commentRepository) UpdateBody(id int, body string, editedAt time.Time) error {
+	query := `UPDATE comments SET body = ?, edited_at = ? WHERE id = ?`
+	_, err := r.db.Execute(query, body, editedAt, id)
+	return err
+}
+
+// SoftDelete помечает комментарий удаленным и стирает его текст, ответы на него остаются
+func (r *commentRepository) SoftDelete(id int, deletedAt time.Time) error {
+	query := `UPDATE comments SET body = '', deleted_at = ? WHERE id = ?`
+	_, err := r.db.Execute(query, deletedAt, id)
+	return err
+}
+
+// SetHidden скрывает комментарий или возвращает его в ветку
+func (r *commentRepository) SetHidden(id int, hidden bool, moderatorID int) error {
+	query := `UPDATE comments SET is_hidden = ?, hidden_by = ? WHERE id = ?`
+
+	var hiddenBy interface{}
+	if hidden {
+		hiddenBy = moderatorID
+	}
+
+	_, err := r.db.Execute(query, hidden, hiddenBy, id)
+	return err
+}
+
+// CountByUserSince возвращает число комментариев пользователя, написанных начиная с указанного времени
+func (r *commentRepository) CountByUserSince(userID int, since time.Time) (int, error) {
+	query := `SELECT COUNT(*) FROM comments WHERE user_id = ? AND created_at >= ?`
+
+	var count int
+	err := r.db.QueryRow(query, userID, since).Scan(&count)
+	return count, err
+}
+
+// IsLocked проверяет, закрыта ли ветка комментариев
+func (r *commentRepository) IsLocked(targetType string, targetID int) (bool, error) {
+	query := `SELECT COUNT(*) FROM comment_thread_locks WHERE target_type = ? AND target_id = ?`
+
+	var count int
+	if err := r.db.QueryRow(query, targetType, targetID).Scan(&count); err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// Lock закрывает ветку комментариев. Повторное закрытие ничего не меняет
+func (r *commentRepository) Lock(targetType string, targetID int, userID int) error {
+	query := `INSERT OR IGNORE INTO comment_thread_locks (target_type, target_id, locked_by) VALUES (?, ?, ?)`
+	_, err := r.db.Execute(query, targetType, targetID, userID)
+	return err
+}
+
+// Unlock открывает ветку комментариев
+func (r *commentRepository) Unlock(targetType string, targetID int) error {
+	query := `DELETE FROM comment_thread_locks WHERE target_type = ? AND target_id = ?`
+	_, err := r.db.Execute(query, targetType, targetID)
+	return err
+}
+
+// queryComments выполняет запрос и возвращает список комментариев
+func (r *commentRepository) queryComments(query string, args ...interface{}) ([]models.Comment, error) {
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var comments []models.Comment
+	for rows.Next() {
+		comment, err := scanComment(rows)
+		if err != nil {
+			return nil, err
+		}
+		comments = append(comments, *comment)
+	}
+
+	return comments, nil
+}
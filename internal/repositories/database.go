@@ -7,4 +7,5 @@ type Database interface {
 	Execute(query string, args ...interface{}) (sql.Result, error)
 	Query(query string, args ...interface{}) (*sql.Rows, error)
 	QueryRow(query string, args ...interface{}) *sql.Row
-}
\ No newline at end of file
+	Begin() (*sql.Tx, error)
+}
@@ -0,0 +1,91 @@
+package repositories
+
+import (
+	"meawle/internal/models"
+)
+
+// FollowRepository определяет интерфейс для работы с подписками пользователей
+type FollowRepository interface {
+	Follow(followerID int, followeeID int) error
+	Unfollow(followerID int, followeeID int) error
+	IsFollowing(followerID int, followeeID int) (bool, error)
+	GetFollowers(userID int) ([]models.Follow, error)
+	GetFollowing(userID int) ([]models.Follow, error)
+}
+
+type followRepository struct {
+	db Database
+}
+
+// NewFollowRepository создает новый экземпляр репозитория подписок
+func NewFollowRepository(db Database) FollowRepository {
+	return &followRepository{db: db}
+}
+
+// Follow подписывает пользователя на автора. Повторная подписка ничего не меняет
+func (r *followRepository) Follow(followerID int, followeeID int) error {
+	query := `INSERT OR IGNORE INTO follows (follower_id, followee_id) VALUES (?, ?)`
+	_, err := r.db.Execute(query, followerID, followeeID)
+	return err
+}
+
+// Unfollow отменяет подписку
+func (r *followRepository) Unfollow(followerID int, followeeID int) error {
+	query := `DELETE FROM follows WHERE follower_id = ? AND followee_id = ?`
+	_, err := r.db.Execute(query, followerID, followeeID)
+	return err
+}
+
+// IsFollowing проверяет, подписан ли пользователь на автора
+func (r *followRepository) IsFollowing(followerID int, followeeID int) (bool, error) {
+	query := `SELECT COUNT(*) FROM follows WHERE follower_id = ? AND followee_id = ?`
+
+	var count int
+	if err := r.db.QueryRow(query, followerID, followeeID).Scan(&count); err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// GetFollowers возвращает подписчиков пользователя, последние первыми
+func (r *followRepository) GetFollowers(userID int) ([]models.Follow, error) {
+	query := `SELECT u.id, u.email, f.created_at
+		FROM follows f
+		JOIN users u ON u.id = f.follower_id
+		WHERE f.followee_id = ?
+		ORDER BY f.created_at DESC, u.id`
+
+	return r.queryFollows(query, userID)
+}
+
+// GetFollowing возвращает пользователей, на которых подписан пользователь, последние первыми
+func (r *followRepository) GetFollowing(userID int) ([]models.Follow, error) {
+	query := `SELECT u.id, u.email, f.created_at
+		FROM follows f
+		JOIN users u ON u.id = f.followee_id
+		WHERE f.follower_id = ?
+		ORDER BY f.created_at DESC, u.id`
+
+	return r.queryFollows(query, userID)
+}
+
+// queryFollows выполняет запрос и возвращает список подписок
+func (r *followRepository) queryFollows(query string, args ...interface{}) ([]models.Follow, error) {
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var follows []models.Follow
+	for rows.Next() {
+		var follow models.Follow
+		if err := rows.Scan(&follow.UserID, &follow.Email, &follow.CreatedAt); err != nil {
+			return nil, err
+		}
+		follows = append(follows, follow)
+	}
+
+	return follows, nil
+}
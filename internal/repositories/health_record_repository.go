@@ -0,0 +1,159 @@
+package repositories
+
+import (
+	"meawle/internal/models"
+)
+
+// HealthRecordRepository определяет интерфейс для работы с медицинскими записями котов
+type HealthRecordRepository interface {
+	Create(record *models.HealthRecord) error
+	GetByID(id int) (*models.HealthRecord, error)
+	GetByCatID(catID int, recordType *string, publicOnly bool) ([]models.HealthRecord, error)
+	Update(id int, record *models.HealthRecordUpdateRequest) error
+	Delete(id int) error
+}
+
+type healthRecordRepository struct {
+	db Database
+}
+
+// healthRecordColumns содержит список колонок, выбираемых для медицинской записи
+const healthRecordColumns = `id, cat_id, record_type, title, notes, record_date, due_date, vet_name, dosage, is_public, created_at`
+
+// NewHealthRecordRepository создает новый экземпляр репозитория медицинских записей
+func NewHealthRecordRepository(db Database) HealthRecordRepository {
+	return &healthRecordRepository{db: db}
+}
+
+// scanHealthRecord сканирует строку результата в модель медицинской записи
+func scanHealthRecord(row rowScanner) (*models.HealthRecord, error) {
+	var record models.HealthRecord
+	err := row.Scan(
+		&record.ID, &record.CatID, &record.RecordType, &record.Title, &record.Notes, &record.RecordDate,
+		&record.DueDate, &record.VetName, &record.Dosage, &record.IsPublic, &record.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &record, nil
+}
+
+// Create создает новую медицинскую запись
+func (r *healthRecordRepository) Create(record *models.HealthRecord) error {
+	query := `INSERT INTO cat_health_records (cat_id, record_type, title, notes, record_date, due_date, vet_name, dosage, is_public)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	result, err := r.db.Execute(query,
+		record.CatID, record.RecordType, record.Title, record.Notes, record.RecordDate,
+		record.DueDate, record.VetName, record.Dosage, record.IsPublic,
+	)
+	if err != nil {
+		return err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	record.ID = int(id)
+	return nil
+}
+
+// GetByID возвращает медицинскую запись по ID
+func (r *healthRecordRepository) GetByID(id int) (*models.HealthRecord, error) {
+	query := `SELECT ` + healthRecordColumns + ` FROM cat_health_records WHERE id = ?`
+
+	return scanHealthRecord(r.db.QueryRow(query, id))
+}
+
+// GetByCatID возвращает медицинские записи кота, при необходимости только определенного типа или публичные
+func (r *healthRecordRepository) GetByCatID(catID int, recordType *string, publicOnly bool) ([]models.HealthRecord, error) {
+	query := `SELECT ` + healthRecordColumns + ` FROM cat_health_records WHERE cat_id = ?`
+	params := []interface{}{catID}
+
+	if recordType != nil {
+		query += " AND record_type = ?"
+		params = append(params, *recordType)
+	}
+
+	if publicOnly {
+		query += " AND is_public = 1"
+	}
+
+	query += " ORDER BY record_date DESC, id DESC"
+
+	rows, err := r.db.Query(query, params...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var records []models.HealthRecord
+	for rows.Next() {
+		record, err := scanHealthRecord(rows)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, *record)
+	}
+
+	return records, nil
+}
+
+// Update обновляет медицинскую запись
+func (r *healthRecordRepository) Update(id int, updateReq *models.HealthRecordUpdateRequest) error {
+	query := `UPDATE cat_health_records SET `
+	params := []interface{}{}
+
+	if updateReq.Title != nil {
+		query += "title = ?, "
+		params = append(params, *updateReq.Title)
+	}
+
+	if updateReq.Notes != nil {
+		query += "notes = ?, "
+		params = append(params, *updateReq.Notes)
+	}
+
+	if updateReq.RecordDate != nil {
+		query += "record_date = ?, "
+		params = append(params, *updateReq.RecordDate)
+	}
+
+	if updateReq.DueDate != nil {
+		query += "due_date = ?, "
+		params = append(params, *updateReq.DueDate)
+	}
+
+	if updateReq.VetName != nil {
+		query += "vet_name = ?, "
+		params = append(params, *updateReq.VetName)
+	}
+
+	if updateReq.Dosage != nil {
+		query += "dosage = ?, "
+		params = append(params, *updateReq.Dosage)
+	}
+
+	if updateReq.IsPublic != nil {
+		query += "is_public = ?, "
+		params = append(params, *updateReq.IsPublic)
+	}
+
+	// Убираем последнюю запятую и пробел
+	query = query[:len(query)-2]
+	query += " WHERE id = ?"
+	params = append(params, id)
+
+	_, err := r.db.Execute(query, params...)
+	return err
+}
+
+// Delete удаляет медицинскую запись
+func (r *healthRecordRepository) Delete(id int) error {
+	query := `DELETE FROM cat_health_records WHERE id = ?`
+	_, err := r.db.Execute(query, id)
+	return err
+}
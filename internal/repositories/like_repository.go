@@ -0,0 +1,99 @@
+package repositories
+
+import (
+	"strings"
+
+	"meawle/internal/models"
+)
+
+// LikeRepository определяет интерфейс для работы с отметками "нравится" котов и пород
+type LikeRepository interface {
+	LikeCat(catID int, userID int) error
+	UnlikeCat(catID int, userID int) error
+	GetCatLikes(catIDs []int, userID int) (map[int]models.LikeSummary, error)
+	LikeBreed(breedID int, userID int) error
+	UnlikeBreed(breedID int, userID int) error
+	GetBreedLikes(breedIDs []int, userID int) (map[int]models.LikeSummary, error)
+}
+
+type likeRepository struct {
+	db Database
+}
+
+// NewLikeRepository создает новый экземпляр репозитория отметок "нравится"
+func NewLikeRepository(db Database) LikeRepository {
+	return &likeRepository{db: db}
+}
+
+// LikeCat отмечает кота. Повторная отметка ничего не меняет
+func (r *likeRepository) LikeCat(catID int, userID int) error {
+	query := `INSERT OR IGNORE INTO cat_likes (cat_id, user_id) VALUES (?, ?)`
+	_, err := r.db.Execute(query, catID, userID)
+	return err
+}
+
+// UnlikeCat снимает отметку с кота
+func (r *likeRepository) UnlikeCat(catID int, userID int) error {
+	query := `DELETE FROM cat_likes WHERE cat_id = ? AND user_id = ?`
+	_, err := r.db.Execute(query, catID, userID)
+	return err
+}
+
+// GetCatLikes возвращает отметки котов по ID кота. Коты без отметок в результат не попадают
+func (r *likeRepository) GetCatLikes(catIDs []int, userID int) (map[int]models.LikeSummary, error) {
+	return r.getLikes("cat_likes", "cat_id", catIDs, userID)
+}
+
+// LikeBreed отмечает породу. Повторная отметка ничего не меняет
+func (r *likeRepository) LikeBreed(breedID int, userID int) error {
+	query := `INSERT OR IGNORE INTO cat_breed_likes (breed_id, user_id) VALUES (?, ?)`
+	_, err := r.db.Execute(query, breedID, userID)
+	return err
+}
+
+// UnlikeBreed снимает отметку с породы
+func (r *likeRepository) UnlikeBreed(breedID int, userID int) error {
+	query := `DELETE FROM cat_breed_likes WHERE breed_id = ? AND user_id = ?`
+	_, err := r.db.Execute(query, breedID, userID)
+	return err
+}
+
+// GetBreedLikes возвращает отметки пород по ID породы. Породы без отметок в результат не попадают
+func (r *likeRepository) GetBreedLikes(breedIDs []int, userID int) (map[int]models.LikeSummary, error) {
+	return r.getLikes("cat_breed_likes", "breed_id", breedIDs, userID)
+}
+
+// getLikes подсчитывает отметки одним запросом для списка объектов.
+// userID равен 0 для анонимного запроса, тогда LikedByMe всегда false
+func (r *likeRepository) getLikes(table string, column string, ids []int, userID int) (map[int]models.LikeSummary, error) {
+	likes := make(map[int]models.LikeSummary)
+	if len(ids) == 0 {
+		return likes, nil
+	}
+
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(ids)), ", ")
+	query := `SELECT ` + column + `, COUNT(*), MAX(user_id = ?) FROM ` + table + `
+		WHERE ` + column + ` IN (` + placeholders + `) GROUP BY ` + column
+
+	params := []interface{}{userID}
+	for _, id := range ids {
+		params = append(params, id)
+	}
+
+	rows, err := r.db.Query(query, params...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id int
+		var summary models.LikeSummary
+		if err := rows.Scan(&id, &summary.LikeCount, &summary.LikedByMe); err != nil {
+			return nil, err
+		}
+		likes[id] = summary
+	}
+
+	return likes, nil
+}
@@ -0,0 +1,215 @@
+package repositories
+
+import (
+	"strings"
+
+	"meawle/internal/models"
+)
+
+// ListingRepository определяет интерфейс для работы с объявлениями о пристройстве
+type ListingRepository interface {
+	Create(listing *models.Listing) error
+	GetByID(id int) (*models.Listing, error)
+	GetActiveByCatID(catID int) (*models.Listing, error)
+	Search(filter *models.ListingFilter) ([]models.Listing, error)
+	GetByUserID(userID int) ([]models.Listing, error)
+	Update(id int, updateReq *models.ListingUpdateRequest) error
+	Delete(id int) error
+}
+
+type listingRepository struct {
+	db Database
+}
+
+// listingSelect содержит запрос объявлений с основными данными кота
+const listingSelect = `SELECT l.id, l.cat_id, c.name, c.sex, c.birth_date, l.user_id, l.title, l.description,
+	l.status, l.location, l.fee, l.requirements, l.created_at, l.updated_at, l.closed_at
+	FROM listings l
+	JOIN cats c ON c.id = l.cat_id`
+
+// NewListingRepository создает новый экземпляр репозитория объявлений
+func NewListingRepository(db Database) ListingRepository {
+	return &listingRepository{db: db}
+}
+
+// scanListing сканирует строку результата в модель объявления
+func scanListing(row rowScanner) (*models.Listing, error) {
+	var l models.Listing
+	err := row.Scan(
+		&l.ID, &l.CatID, &l.CatName, &l.CatSex, &l.CatBirthDate, &l.UserID, &l.Title, &l.Description,
+		&l.Status, &l.Location, &l.Fee, &l.Requirements, &l.CreatedAt, &l.UpdatedAt, &l.ClosedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &l, nil
+}
+
+// Create создает новое объявление
+func (r *listingRepository) Create(listing *models.Listing) error {
+	query := `INSERT INTO listings (cat_id, user_id, title, description, status, location, fee, requirements)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)`
+
+	result, err := r.db.Execute(query,
+		listing.CatID, listing.UserID, listing.Title, listing.Description, listing.Status,
+		listing.Location, listing.Fee, listing.Requirements,
+	)
+	if err != nil {
+		return err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	listing.ID = int(id)
+	return nil
+}
+
+// GetByID возвращает объявление по ID
+func (r *listingRepository) GetByID(id int) (*models.Listing, error) {
+	query := listingSelect + ` WHERE l.id = ?`
+
+	return scanListing(r.db.QueryRow(query, id))
+}
+
+// GetActiveByCatID возвращает незакрытое объявление кота
+func (r *listingRepository) GetActiveByCatID(catID int) (*models.Listing, error) {
+	query := listingSelect + ` WHERE l.cat_id = ? AND l.status != ?`
+
+	return scanListing(r.db.QueryRow(query, catID, models.ListingStatusAdopted))
+}
+
+// Search возвращает опубликованные объявления, удовлетворяющие фильтру.
+// Черновики и объявления о приватных котах в поиск не попадают. Объявления о котах, скрытых модератором,
+// видят только их авторы и админ
+func (r *listingRepository) Search(filter *models.ListingFilter) ([]models.Listing, error) {
+	conditions := []string{"l.status != ?", "c.visibility != ?"}
+	params := []interface{}{models.ListingStatusDraft, models.CatVisibilityPrivate}
+
+	if filter == nil || !filter.IncludeHidden {
+		viewerID := 0
+		if filter != nil {
+			viewerID = filter.ViewerID
+		}
+		conditions = append(conditions, "(c.hidden_at IS NULL OR l.user_id = ?)")
+		params = append(params, viewerID)
+	}
+
+	if filter != nil {
+		if filter.Status != nil {
+			conditions = append(conditions, "l.status = ?")
+			params = append(params, *filter.Status)
+		}
+
+		if filter.Location != nil {
+			conditions = append(conditions, "l.location LIKE ?")
+			params = append(params, "%"+*filter.Location+"%")
+		}
+
+		if filter.Query != nil {
+			conditions = append(conditions, "(l.title LIKE ? OR l.description LIKE ?)")
+			params = append(params, "%"+*filter.Query+"%", "%"+*filter.Query+"%")
+		}
+
+		if filter.CatSex != nil {
+			conditions = append(conditions, "c.sex = ?")
+			params = append(params, *filter.CatSex)
+		}
+
+		if filter.MaxFee != nil {
+			conditions = append(conditions, "(l.fee IS NULL OR l.fee <= ?)")
+			params = append(params, *filter.MaxFee)
+		}
+
+		if filter.UserID != nil {
+			conditions = append(conditions, "l.user_id = ?")
+			params = append(params, *filter.UserID)
+		}
+	}
+
+	query := listingSelect + " WHERE " + strings.Join(conditions, " AND ") + " ORDER BY l.created_at DESC, l.id DESC"
+
+	return r.queryListings(query, params...)
+}
+
+// GetByUserID возвращает все объявления пользователя, включая черновики
+func (r *listingRepository) GetByUserID(userID int) ([]models.Listing, error) {
+	query := listingSelect + ` WHERE l.user_id = ? ORDER BY l.created_at DESC, l.id DESC`
+
+	return r.queryListings(query, userID)
+}
+
+// Update обновляет объявление. Переход в статус adopted закрывает объявление
+func (r *listingRepository) Update(id int, updateReq *models.ListingUpdateRequest) error {
+	query := `UPDATE listings SET `
+	params := []interface{}{}
+
+	if updateReq.Title != nil {
+		query += "title = ?, "
+		params = append(params, *updateReq.Title)
+	}
+
+	if updateReq.Description != nil {
+		query += "description = ?, "
+		params = append(params, *updateReq.Description)
+	}
+
+	if updateReq.Status != nil {
+		query += "status = ?, "
+		params = append(params, *updateReq.Status)
+		if *updateReq.Status == models.ListingStatusAdopted {
+			query += "closed_at = CURRENT_TIMESTAMP, "
+		}
+	}
+
+	if updateReq.Location != nil {
+		query += "location = ?, "
+		params = append(params, *updateReq.Location)
+	}
+
+	if updateReq.Fee != nil {
+		query += "fee = ?, "
+		params = append(params, *updateReq.Fee)
+	}
+
+	if updateReq.Requirements != nil {
+		query += "requirements = ?, "
+		params = append(params, *updateReq.Requirements)
+	}
+
+	query += "updated_at = CURRENT_TIMESTAMP WHERE id = ?"
+	params = append(params, id)
+
+	_, err := r.db.Execute(query, params...)
+	return err
+}
+
+// Delete удаляет объявление
+func (r *listingRepository) Delete(id int) error {
+	query := `DELETE FROM listings WHERE id = ?`
+	_, err := r.db.Execute(query, id)
+	return err
+}
+
+// queryListings выполняет запрос и возвращает список объявлений
+func (r *listingRepository) queryListings(query string, args ...interface{}) ([]models.Listing, error) {
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var listings []models.Listing
+	for rows.Next() {
+		listing, err := scanListing(rows)
+		if err != nil {
+			return nil, err
+		}
+		listings = append(listings, *listing)
+	}
+
+	return listings, nil
+}
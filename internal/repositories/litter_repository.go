@@ -0,0 +1,176 @@
+package repositories
+
+import (
+	"strings"
+
+	"meawle/internal/models"
+)
+
+// LitterRepository определяет интерфейс для работы с пометами
+type LitterRepository interface {
+	CreateWithKittens(litter *models.Litter, kittens []*models.Cat) error
+	GetByID(id int) (*models.Litter, error)
+	GetAll(filter *models.LitterFilter) ([]models.Litter, error)
+	Update(id int, litter *models.LitterUpdateRequest) error
+	Delete(id int) error
+}
+
+type litterRepository struct {
+	db Database
+}
+
+// litterColumns содержит список колонок, выбираемых для помета
+const litterColumns = `id, name, dam_id, sire_id, sire_external_id, birth_date, notes, user_id, created_at`
+
+// NewLitterRepository создает новый экземпляр репозитория пометов
+func NewLitterRepository(db Database) LitterRepository {
+	return &litterRepository{db: db}
+}
+
+// scanLitter сканирует строку результата в модель помета
+func scanLitter(row rowScanner) (*models.Litter, error) {
+	var l models.Litter
+	err := row.Scan(&l.ID, &l.Name, &l.DamID, &l.SireID, &l.SireExternalID, &l.BirthDate, &l.Notes, &l.UserID, &l.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &l, nil
+}
+
+// CreateWithKittens создает помет и всех его котят в одной транзакции
+func (r *litterRepository) CreateWithKittens(litter *models.Litter, kittens []*models.Cat) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	// После успешного Commit откат ничего не делает
+	defer tx.Rollback()
+
+	query := `INSERT INTO litters (name, dam_id, sire_id, sire_external_id, birth_date, notes, user_id) VALUES (?, ?, ?, ?, ?, ?, ?)`
+
+	result, err := tx.Exec(query,
+		litter.Name, litter.DamID, litter.SireID, litter.SireExternalID, litter.BirthDate, litter.Notes, litter.UserID,
+	)
+	if err != nil {
+		return err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	litter.ID = int(id)
+
+	for _, kitten := range kittens {
+		kitten.LitterID = &litter.ID
+
+		result, err := tx.Exec(catInsertQuery, catInsertArgs(kitten)...)
+		if err != nil {
+			return err
+		}
+
+		kittenID, err := result.LastInsertId()
+		if err != nil {
+			return err
+		}
+		kitten.ID = int(kittenID)
+	}
+
+	return tx.Commit()
+}
+
+// GetByID возвращает помет по ID
+func (r *litterRepository) GetByID(id int) (*models.Litter, error) {
+	query := `SELECT ` + litterColumns + ` FROM litters WHERE id = ?`
+
+	return scanLitter(r.db.QueryRow(query, id))
+}
+
+// GetAll возвращает пометы, удовлетворяющие фильтру
+func (r *litterRepository) GetAll(filter *models.LitterFilter) ([]models.Litter, error) {
+	query := `SELECT ` + litterColumns + ` FROM litters`
+	conditions := []string{}
+	params := []interface{}{}
+
+	if filter != nil {
+		if filter.ParentID != nil {
+			conditions = append(conditions, "(dam_id = ? OR sire_id = ?)")
+			params = append(params, *filter.ParentID, *filter.ParentID)
+		}
+
+		if filter.Available != nil {
+			exists := "EXISTS (SELECT 1 FROM cats WHERE cats.litter_id = litters.id AND cats.availability = ?)"
+			if !*filter.Available {
+				exists = "NOT " + exists
+			}
+			conditions = append(conditions, exists)
+			params = append(params, models.KittenAvailable)
+		}
+	}
+
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	query += " ORDER BY birth_date DESC, id DESC"
+
+	rows, err := r.db.Query(query, params...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var litters []models.Litter
+	for rows.Next() {
+		litter, err := scanLitter(rows)
+		if err != nil {
+			return nil, err
+		}
+		litters = append(litters, *litter)
+	}
+
+	return litters, nil
+}
+
+// Update обновляет данные помета
+func (r *litterRepository) Update(id int, updateReq *models.LitterUpdateRequest) error {
+	query := `UPDATE litters SET `
+	params := []interface{}{}
+
+	if updateReq.Name != nil {
+		query += "name = ?, "
+		params = append(params, *updateReq.Name)
+	}
+
+	if updateReq.Notes != nil {
+		query += "notes = ?, "
+		params = append(params, *updateReq.Notes)
+	}
+
+	// Убираем последнюю запятую и пробел
+	query = query[:len(query)-2]
+	query += " WHERE id = ?"
+	params = append(params, id)
+
+	_, err := r.db.Execute(query, params...)
+	return err
+}
+
+// Delete удаляет помет. Котята остаются, но теряют ссылку на помет и статус доступности
+func (r *litterRepository) Delete(id int) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(`UPDATE cats SET litter_id = NULL, availability = NULL WHERE litter_id = ?`, id); err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(`DELETE FROM litters WHERE id = ?`, id); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,189 @@
+package repositories
+
+import (
+	"math"
+	"strings"
+	"time"
+
+	"meawle/internal/models"
+)
+
+// LostAlertRepository определяет интерфейс для работы с подписками на оповещения о потерявшихся котах
+type LostAlertRepository interface {
+	Upsert(subscription *models.LostAlertSubscription) error
+	GetByUserID(userID int) (*models.LostAlertSubscription, error)
+	Delete(userID int) error
+	GetPendingReports(now time.Time, limit int) ([]models.LostFoundReport, error)
+	FindRecipients(report *models.LostFoundReport, maxRadiusKm float64) ([]models.LostAlertSubscription, error)
+	RecordDelivery(reportID int, userID int, sentAt time.Time) error
+	MarkDispatched(reportID int, dispatchedAt time.Time) error
+	RecordFailedAttempt(reportID int) (int, error)
+	ScheduleRetry(reportID int, retryAt time.Time) error
+}
+
+type lostAlertRepository struct {
+	db Database
+}
+
+// lostAlertColumns содержит список колонок, выбираемых для подписки
+const lostAlertColumns = `s.id, s.user_id, u.email, s.latitude, s.longitude, s.radius_km, s.is_active, s.created_at, s.updated_at`
+
+// NewLostAlertRepository создает новый экземпляр репозитория подписок на оповещения о потерявшихся котах
+func NewLostAlertRepository(db Database) LostAlertRepository {
+	return &lostAlertRepository{db: db}
+}
+
+// scanLostAlertSubscription сканирует строку результата в модель подписки
+func scanLostAlertSubscription(row rowScanner, extra ...interface{}) (*models.LostAlertSubscription, error) {
+	var s models.LostAlertSubscription
+	dest := []interface{}{
+		&s.ID, &s.UserID, &s.Email, &s.Latitude, &s.Longitude, &s.RadiusKm, &s.IsActive, &s.CreatedAt, &s.UpdatedAt,
+	}
+	if err := row.Scan(append(dest, extra...)...); err != nil {
+		return nil, err
+	}
+
+	return &s, nil
+}
+
+// Upsert создает подписку пользователя или заменяет существующую
+func (r *lostAlertRepository) Upsert(subscription *models.LostAlertSubscription) error {
+	query := `INSERT INTO lost_alert_subscriptions (user_id, latitude, longitude, radius_km, is_active)
+		VALUES (?, ?, ?, ?, ?)
+		ON CONFLICT(user_id) DO UPDATE SET
+			latitude = excluded.latitude,
+			longitude = excluded.longitude,
+			radius_km = excluded.radius_km,
+			is_active = excluded.is_active,
+			updated_at = CURRENT_TIMESTAMP`
+
+	_, err := r.db.Execute(query,
+		subscription.UserID, subscription.Latitude, subscription.Longitude, subscription.RadiusKm, subscription.IsActive,
+	)
+	return err
+}
+
+// GetByUserID возвращает подписку пользователя
+func (r *lostAlertRepository) GetByUserID(userID int) (*models.LostAlertSubscription, error) {
+	query := `SELECT ` + lostAlertColumns + `
+		FROM lost_alert_subscriptions s
+		JOIN users u ON u.id = s.user_id
+		WHERE s.user_id = ?`
+
+	return scanLostAlertSubscription(r.db.QueryRow(query, userID))
+}
+
+// Delete удаляет подписку пользователя
+func (r *lostAlertRepository) Delete(userID int) error {
+	query := `DELETE FROM lost_alert_subscriptions WHERE user_id = ?`
+	_, err := r.db.Execute(query, userID)
+	return err
+}
+
+// GetPendingReports возвращает активные объявления о пропаже, по которым еще не разосланы оповещения.
+// Объявления, повторная попытка рассылки которых еще не наступила, пропускаются
+func (r *lostAlertRepository) GetPendingReports(now time.Time, limit int) ([]models.LostFoundReport, error) {
+	query := `SELECT ` + lostFoundColumns + ` FROM lost_found_reports
+		WHERE report_type = ? AND status = ? AND alerts_dispatched_at IS NULL
+			AND (alert_retry_at IS NULL OR alert_retry_at <= ?)
+		ORDER BY created_at, id LIMIT ?`
+
+	rows, err := r.db.Query(query, models.LostFoundTypeLost, models.LostFoundStatusActive, now, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var reports []models.LostFoundReport
+	for rows.Next() {
+		report, err := scanLostFoundReport(rows)
+		if err != nil {
+			return nil, err
+		}
+		reports = append(reports, *report)
+	}
+
+	return reports, nil
+}
+
+// FindRecipients возвращает активные подписки, в радиус которых попадает место пропажи, ближайшие первыми.
+// Автор объявления и уже оповещенные о нем пользователи исключаются.
+// Кандидаты отбираются по прямоугольнику максимального радиуса подписки, затем по радиусу каждой подписки
+func (r *lostAlertRepository) FindRecipients(report *models.LostFoundReport, maxRadiusKm float64) ([]models.LostAlertSubscription, error) {
+	conditions := []string{
+		"s.is_active = 1",
+		"s.user_id != ?",
+		"s.latitude BETWEEN ? AND ?",
+		"NOT EXISTS (SELECT 1 FROM lost_alert_deliveries d WHERE d.report_id = ? AND d.user_id = s.user_id)",
+	}
+	minLat, maxLat, minLon, maxLon, wrapsLon := geoBoundingBox(report.Latitude, report.Longitude, maxRadiusKm)
+	params := []interface{}{report.UserID, minLat, maxLat, report.ID}
+
+	// У полюсов и у линии перемены дат прямоугольник по долготе не строится, остается только фильтр по широте
+	if !wrapsLon {
+		conditions = append(conditions, "s.longitude BETWEEN ? AND ?")
+		params = append(params, minLon, maxLon)
+	}
+
+	query := `SELECT * FROM (
+		SELECT ` + lostAlertColumns + `, haversine_km(?, ?, s.latitude, s.longitude) AS distance_km
+		FROM lost_alert_subscriptions s
+		JOIN users u ON u.id = s.user_id
+		WHERE ` + strings.Join(conditions, " AND ") + `
+	) WHERE distance_km <= radius_km ORDER BY distance_km, id`
+	params = append([]interface{}{report.Latitude, report.Longitude}, params...)
+
+	rows, err := r.db.Query(query, params...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var subscriptions []models.LostAlertSubscription
+	for rows.Next() {
+		var distance float64
+		subscription, err := scanLostAlertSubscription(rows, &distance)
+		if err != nil {
+			return nil, err
+		}
+		// Округляем до метров
+		distance = math.Round(distance*1000) / 1000
+		subscription.DistanceKm = &distance
+		subscriptions = append(subscriptions, *subscription)
+	}
+
+	return subscriptions, nil
+}
+
+// RecordDelivery фиксирует отправку оповещения об объявлении пользователю
+func (r *lostAlertRepository) RecordDelivery(reportID int, userID int, sentAt time.Time) error {
+	query := `INSERT OR IGNORE INTO lost_alert_deliveries (report_id, user_id, sent_at) VALUES (?, ?, ?)`
+	_, err := r.db.Execute(query, reportID, userID, sentAt)
+	return err
+}
+
+// MarkDispatched отмечает, что рассылка оповещений по объявлению завершена
+func (r *lostAlertRepository) MarkDispatched(reportID int, dispatchedAt time.Time) error {
+	query := `UPDATE lost_found_reports SET alerts_dispatched_at = ?, alert_retry_at = NULL WHERE id = ?`
+	_, err := r.db.Execute(query, dispatchedAt, reportID)
+	return err
+}
+
+// RecordFailedAttempt увеличивает счетчик неудачных попыток рассылки и возвращает его новое значение
+func (r *lostAlertRepository) RecordFailedAttempt(reportID int) (int, error) {
+	query := `UPDATE lost_found_reports SET alert_attempts = alert_attempts + 1 WHERE id = ? RETURNING alert_attempts`
+
+	var attempts int
+	if err := r.db.QueryRow(query, reportID).Scan(&attempts); err != nil {
+		return 0, err
+	}
+
+	return attempts, nil
+}
+
+// ScheduleRetry откладывает следующую попытку рассылки по объявлению
+func (r *lostAlertRepository) ScheduleRetry(reportID int, retryAt time.Time) error {
+	query := `UPDATE lost_found_reports SET alert_retry_at = ? WHERE id = ?`
+	_, err := r.db.Execute(query, retryAt, reportID)
+	return err
+}
@@ -0,0 +1,307 @@
+package repositories
+
+import (
+	"encoding/json"
+	"math"
+	"strings"
+
+	"meawle/internal/models"
+)
+
+// LostFoundRepository определяет интерфейс для работы с объявлениями о потерянных и найденных котах
+type LostFoundRepository interface {
+	Create(report *models.LostFoundReport) error
+	GetByID(id int) (*models.LostFoundReport, error)
+	GetByUserID(userID int) ([]models.LostFoundReport, error)
+	Search(filter *models.LostFoundFilter) ([]models.LostFoundReport, error)
+	Update(id int, report *models.LostFoundUpdateRequest) error
+	Delete(id int) error
+}
+
+type lostFoundRepository struct {
+	db Database
+}
+
+// lostFoundColumns содержит список колонок, выбираемых для объявления
+const lostFoundColumns = `id, report_type, status, user_id, cat_id, breed_id, color, sex, description,
+	latitude, longitude, address, last_seen_at, photo_urls, created_at, updated_at, resolved_at`
+
+// kmPerDegree - длина одного градуса широты в километрах
+const kmPerDegree = 111.195
+
+// NewLostFoundRepository создает новый экземпляр репозитория объявлений о потерянных и найденных котах
+func NewLostFoundRepository(db Database) LostFoundRepository {
+	return &lostFoundRepository{db: db}
+}
+
+// scanLostFoundReport сканирует строку результата в модель объявления.
+// extra принимает дополнительные вычисляемые колонки, следующие за lostFoundColumns
+func scanLostFoundReport(row rowScanner, extra ...interface{}) (*models.LostFoundReport, error) {
+	var r models.LostFoundReport
+	var photoURLs string
+	dest := []interface{}{
+		&r.ID, &r.ReportType, &r.Status, &r.UserID, &r.CatID, &r.BreedID, &r.Color, &r.Sex, &r.Description,
+		&r.Latitude, &r.Longitude, &r.Address, &r.LastSeenAt, &photoURLs, &r.CreatedAt, &r.UpdatedAt, &r.ResolvedAt,
+	}
+	if err := row.Scan(append(dest, extra...)...); err != nil {
+		return nil, err
+	}
+
+	// Ссылки на фотографии хранятся в JSON
+	if err := json.Unmarshal([]byte(photoURLs), &r.PhotoURLs); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+// Create создает новое объявление
+func (r *lostFoundRepository) Create(report *models.LostFoundReport) error {
+	photoURLs, err := marshalPhotoURLs(report.PhotoURLs)
+	if err != nil {
+		return err
+	}
+
+	query := `INSERT INTO lost_found_reports (report_type, status, user_id, cat_id, breed_id, color, sex, description,
+		latitude, longitude, address, last_seen_at, photo_urls)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	result, err := r.db.Execute(query,
+		report.ReportType, report.Status, report.UserID, report.CatID, report.BreedID, report.Color, report.Sex,
+		report.Description, report.Latitude, report.Longitude, report.Address, report.LastSeenAt, photoURLs,
+	)
+	if err != nil {
+		return err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	report.ID = int(id)
+	return nil
+}
+
+// GetByID возвращает объявление по ID
+func (r *lostFoundRepository) GetByID(id int) (*models.LostFoundReport, error) {
+	query := `SELECT ` + lostFoundColumns + ` FROM lost_found_reports WHERE id = ?`
+
+	return scanLostFoundReport(r.db.QueryRow(query, id))
+}
+
+// GetByUserID возвращает все объявления пользователя, включая закрытые
+func (r *lostFoundRepository) GetByUserID(userID int) ([]models.LostFoundReport, error) {
+	query := `SELECT ` + lostFoundColumns + ` FROM lost_found_reports WHERE user_id = ? ORDER BY created_at DESC, id DESC`
+
+	rows, err := r.db.Query(query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var reports []models.LostFoundReport
+	for rows.Next() {
+		report, err := scanLostFoundReport(rows)
+		if err != nil {
+			return nil, err
+		}
+		reports = append(reports, *report)
+	}
+
+	return reports, nil
+}
+
+// Search возвращает активные объявления в радиусе от точки, ближайшие первыми.
+// Кандидаты отбираются по индексу в ограничивающем прямоугольнике, точное расстояние считается по формуле гаверсинусов.
+// Неуказанные у объявления порода, цвет и пол не исключают его из совпадений
+func (r *lostFoundRepository) Search(filter *models.LostFoundFilter) ([]models.LostFoundReport, error) {
+	conditions := []string{"status = ?", "latitude BETWEEN ? AND ?"}
+	minLat, maxLat, minLon, maxLon, wrapsLon := geoBoundingBox(filter.Latitude, filter.Longitude, filter.RadiusKm)
+	params := []interface{}{models.LostFoundStatusActive, minLat, maxLat}
+
+	// У полюсов и у линии перемены дат прямоугольник по долготе не строится, остается только фильтр по широте
+	if !wrapsLon {
+		conditions = append(conditions, "longitude BETWEEN ? AND ?")
+		params = append(params, minLon, maxLon)
+	}
+
+	if filter.ReportType != nil {
+		conditions = append(conditions, "report_type = ?")
+		params = append(params, *filter.ReportType)
+	}
+
+	if filter.BreedID != nil {
+		conditions = append(conditions, "(breed_id IS NULL OR breed_id = ?)")
+		params = append(params, *filter.BreedID)
+	}
+
+	if filter.Color != nil {
+		conditions = append(conditions, "(color IS NULL OR color = ? COLLATE NOCASE)")
+		params = append(params, *filter.Color)
+	}
+
+	if filter.Sex != nil {
+		conditions = append(conditions, "(sex IS NULL OR sex = ?)")
+		params = append(params, *filter.Sex)
+	}
+
+	if filter.SeenAfter != nil {
+		conditions = append(conditions, "last_seen_at >= ?")
+		params = append(params, *filter.SeenAfter)
+	}
+
+	if filter.SeenBefore != nil {
+		conditions = append(conditions, "last_seen_at <= ?")
+		params = append(params, *filter.SeenBefore)
+	}
+
+	if filter.ExcludeID != 0 {
+		conditions = append(conditions, "id != ?")
+		params = append(params, filter.ExcludeID)
+	}
+
+	if filter.ExcludeUser != 0 {
+		conditions = append(conditions, "user_id != ?")
+		params = append(params, filter.ExcludeUser)
+	}
+
+	query := `SELECT * FROM (
+		SELECT ` + lostFoundColumns + `, haversine_km(?, ?, latitude, longitude) AS distance_km
+		FROM lost_found_reports WHERE ` + strings.Join(conditions, " AND ") + `
+	) WHERE distance_km <= ? ORDER BY distance_km, id`
+	params = append([]interface{}{filter.Latitude, filter.Longitude}, params...)
+	params = append(params, filter.RadiusKm)
+
+	rows, err := r.db.Query(query, params...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var reports []models.LostFoundReport
+	for rows.Next() {
+		var distance float64
+		report, err := scanLostFoundReport(rows, &distance)
+		if err != nil {
+			return nil, err
+		}
+		// Округляем до метров
+		distance = math.Round(distance*1000) / 1000
+		report.DistanceKm = &distance
+		reports = append(reports, *report)
+	}
+
+	return reports, nil
+}
+
+// Update обновляет объявление. Переход в статус resolved закрывает объявление
+func (r *lostFoundRepository) Update(id int, updateReq *models.LostFoundUpdateRequest) error {
+	query := `UPDATE lost_found_reports SET `
+	params := []interface{}{}
+
+	if updateReq.Status != nil {
+		query += "status = ?, "
+		params = append(params, *updateReq.Status)
+		if *updateReq.Status == models.LostFoundStatusResolved {
+			query += "resolved_at = CURRENT_TIMESTAMP, "
+		} else {
+			query += "resolved_at = NULL, "
+		}
+	}
+
+	if updateReq.BreedID != nil {
+		query += "breed_id = ?, "
+		params = append(params, nullableID(*updateReq.BreedID))
+	}
+
+	if updateReq.Color != nil {
+		query += "color = ?, "
+		params = append(params, *updateReq.Color)
+	}
+
+	if updateReq.Sex != nil {
+		query += "sex = ?, "
+		params = append(params, *updateReq.Sex)
+	}
+
+	if updateReq.Description != nil {
+		query += "description = ?, "
+		params = append(params, *updateReq.Description)
+	}
+
+	if updateReq.Latitude != nil {
+		query += "latitude = ?, "
+		params = append(params, *updateReq.Latitude)
+	}
+
+	if updateReq.Longitude != nil {
+		query += "longitude = ?, "
+		params = append(params, *updateReq.Longitude)
+	}
+
+	if updateReq.Address != nil {
+		query += "address = ?, "
+		params = append(params, *updateReq.Address)
+	}
+
+	if updateReq.LastSeenAt != nil {
+		query += "last_seen_at = ?, "
+		params = append(params, *updateReq.LastSeenAt)
+	}
+
+	if updateReq.PhotoURLs != nil {
+		photoURLs, err := marshalPhotoURLs(updateReq.PhotoURLs)
+		if err != nil {
+			return err
+		}
+		query += "photo_urls = ?, "
+		params = append(params, photoURLs)
+	}
+
+	query += "updated_at = CURRENT_TIMESTAMP WHERE id = ?"
+	params = append(params, id)
+
+	_, err := r.db.Execute(query, params...)
+	return err
+}
+
+// Delete удаляет объявление
+func (r *lostFoundRepository) Delete(id int) error {
+	query := `DELETE FROM lost_found_reports WHERE id = ?`
+	_, err := r.db.Execute(query, id)
+	return err
+}
+
+// marshalPhotoURLs кодирует ссылки на фотографии в JSON, пустой список хранится как []
+func marshalPhotoURLs(urls []string) (string, error) {
+	if urls == nil {
+		urls = []string{}
+	}
+
+	data, err := json.Marshal(urls)
+	if err != nil {
+		return "", err
+	}
+
+	return string(data), nil
+}
+
+// geoBoundingBox возвращает прямоугольник, содержащий круг радиусом radiusKm вокруг точки.
+// wrapsLon равен true, если прямоугольник по долготе не строится: круг захватывает полюс или линию перемены дат
+func geoBoundingBox(lat, lon, radiusKm float64) (minLat, maxLat, minLon, maxLon float64, wrapsLon bool) {
+	dLat := radiusKm / kmPerDegree
+	minLat, maxLat = lat-dLat, lat+dLat
+
+	if minLat <= -90 || maxLat >= 90 {
+		return math.Max(minLat, -90), math.Min(maxLat, 90), -180, 180, true
+	}
+
+	dLon := dLat / math.Cos(lat*math.Pi/180)
+	minLon, maxLon = lon-dLon, lon+dLon
+	if minLon < -180 || maxLon > 180 {
+		return minLat, maxLat, -180, 180, true
+	}
+
+	return minLat, maxLat, minLon, maxLon, false
+}
@@ -0,0 +1,148 @@
+package repositories
+
+import (
+	"meawle/internal/models"
+)
+
+// MeasurementRepository определяет интерфейс для работы с измерениями котов
+type MeasurementRepository interface {
+	Create(measurement *models.Measurement) error
+	GetByID(id int) (*models.Measurement, error)
+	GetByCatID(catID int, from, to *string) ([]models.Measurement, error)
+	GetWeeklyAverages(catID int, from, to *string) ([]models.WeeklyWeight, error)
+	GetLatest(catID int) (*models.Measurement, error)
+	Delete(id int) error
+}
+
+type measurementRepository struct {
+	db Database
+}
+
+// NewMeasurementRepository создает новый экземпляр репозитория измерений
+func NewMeasurementRepository(db Database) MeasurementRepository {
+	return &measurementRepository{db: db}
+}
+
+// Create добавляет новое измерение
+func (r *measurementRepository) Create(measurement *models.Measurement) error {
+	query := `INSERT INTO cat_measurements (cat_id, measured_at, weight_kg, length_cm, notes) VALUES (?, ?, ?, ?, ?)`
+
+	result, err := r.db.Execute(query, measurement.CatID, measurement.MeasuredAt, measurement.WeightKg, measurement.LengthCm, measurement.Notes)
+	if err != nil {
+		return err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	measurement.ID = int(id)
+	return nil
+}
+
+// GetByID возвращает измерение по ID
+func (r *measurementRepository) GetByID(id int) (*models.Measurement, error) {
+	query := `SELECT id, cat_id, measured_at, weight_kg, length_cm, notes, created_at FROM cat_measurements WHERE id = ?`
+
+	var m models.Measurement
+	err := r.db.QueryRow(query, id).Scan(&m.ID, &m.CatID, &m.MeasuredAt, &m.WeightKg, &m.LengthCm, &m.Notes, &m.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+// GetByCatID возвращает измерения кота в хронологическом порядке за указанный период
+func (r *measurementRepository) GetByCatID(catID int, from, to *string) ([]models.Measurement, error) {
+	query := `SELECT id, cat_id, measured_at, weight_kg, length_cm, notes, created_at FROM cat_measurements WHERE cat_id = ?`
+	params := []interface{}{catID}
+
+	if from != nil {
+		query += " AND measured_at >= ?"
+		params = append(params, *from)
+	}
+
+	if to != nil {
+		query += " AND measured_at <= ?"
+		params = append(params, *to)
+	}
+
+	query += " ORDER BY measured_at, id"
+
+	rows, err := r.db.Query(query, params...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var measurements []models.Measurement
+	for rows.Next() {
+		var m models.Measurement
+		err := rows.Scan(&m.ID, &m.CatID, &m.MeasuredAt, &m.WeightKg, &m.LengthCm, &m.Notes, &m.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		measurements = append(measurements, m)
+	}
+
+	return measurements, nil
+}
+
+// GetWeeklyAverages возвращает средний вес кота по неделям (с понедельника) за указанный период
+func (r *measurementRepository) GetWeeklyAverages(catID int, from, to *string) ([]models.WeeklyWeight, error) {
+	query := `SELECT date(measured_at, '-6 days', 'weekday 1') AS week_start, AVG(weight_kg), COUNT(*)
+		FROM cat_measurements WHERE cat_id = ?`
+	params := []interface{}{catID}
+
+	if from != nil {
+		query += " AND measured_at >= ?"
+		params = append(params, *from)
+	}
+
+	if to != nil {
+		query += " AND measured_at <= ?"
+		params = append(params, *to)
+	}
+
+	query += " GROUP BY week_start ORDER BY week_start"
+
+	rows, err := r.db.Query(query, params...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var weeks []models.WeeklyWeight
+	for rows.Next() {
+		var week models.WeeklyWeight
+		if err := rows.Scan(&week.WeekStart, &week.AverageWeightKg, &week.Count); err != nil {
+			return nil, err
+		}
+		weeks = append(weeks, week)
+	}
+
+	return weeks, nil
+}
+
+// GetLatest возвращает последнее по дате измерение кота
+func (r *measurementRepository) GetLatest(catID int) (*models.Measurement, error) {
+	query := `SELECT id, cat_id, measured_at, weight_kg, length_cm, notes, created_at FROM cat_measurements
+		WHERE cat_id = ? ORDER BY measured_at DESC, id DESC LIMIT 1`
+
+	var m models.Measurement
+	err := r.db.QueryRow(query, catID).Scan(&m.ID, &m.CatID, &m.MeasuredAt, &m.WeightKg, &m.LengthCm, &m.Notes, &m.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+// Delete удаляет измерение
+func (r *measurementRepository) Delete(id int) error {
+	query := `DELETE FROM cat_measurements WHERE id = ?`
+	_, err := r.db.Execute(query, id)
+	return err
+}
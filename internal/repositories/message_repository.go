@@ -0,0 +1,315 @@
+package repositories
+
+import (
+	"time"
+
+	"meawle/internal/models"
+)
+
+// MessageRepository определяет интерфейс для работы с переписками и сообщениями
+type MessageRepository interface {
+	CreateConversation(conversation *models.Conversation, participantIDs []int) error
+	FindConversation(userID int, interlocutorID int, catID int, listingID *int) (*models.Conversation, error)
+	GetConversationByID(id int) (*models.Conversation, error)
+	GetParticipantIDs(conversationID int) ([]int, error)
+	GetUserConversation(conversationID int, userID int) (*models.ConversationResponse, error)
+	GetUserConversations(userID int) ([]models.ConversationResponse, error)
+	CreateMessage(message *models.Message) error
+	GetMessages(filter *models.MessageFilter) ([]models.Message, error)
+	MarkRead(conversationID int, userID int) (bool, error)
+	CountUnread(userID int) (int, error)
+}
+
+type messageRepository struct {
+	db Database
+}
+
+// conversationColumns содержит список колонок, выбираемых для переписки
+const conversationColumns = `id, cat_id, listing_id, created_by, created_at, last_message_at`
+
+// messageColumns содержит список колонок, выбираемых для сообщения
+const messageColumns = `id, conversation_id, sender_id, body, created_at`
+
+// userConversationSelect выбирает переписки пользователя вместе с собеседником, последним сообщением
+// и числом непрочитанных. Собственные сообщения пользователя непрочитанными не считаются
+const userConversationSelect = `SELECT c.id, c.cat_id, c.listing_id, o.user_id, COALESCE(u.email, ''),
+		m.id, m.sender_id, m.body, m.created_at,
+		(SELECT COUNT(*) FROM messages um
+			WHERE um.conversation_id = c.id AND um.id > p.last_read_message_id AND um.sender_id != p.user_id),
+		c.created_at, c.last_message_at
+	FROM conversation_participants p
+	JOIN conversations c ON c.id = p.conversation_id
+	JOIN conversation_participants o ON o.conversation_id = c.id AND o.user_id != p.user_id
+	LEFT JOIN users u ON u.id = o.user_id
+	LEFT JOIN messages m ON m.id = (SELECT MAX(id) FROM messages WHERE conversation_id = c.id)
+	WHERE p.user_id = ?`
+
+// NewMessageRepository создает новый экземпляр репозитория переписок
+func NewMessageRepository(db Database) MessageRepository {
+	return &messageRepository{db: db}
+}
+
+// scanConversation сканирует строку результата в модель переписки
+func scanConversation(row rowScanner) (*models.Conversation, error) {
+	var c models.Conversation
+	err := row.Scan(&c.ID, &c.CatID, &c.ListingID, &c.CreatedBy, &c.CreatedAt, &c.LastMessageAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &c, nil
+}
+
+// scanMessage сканирует строку результата в модель сообщения
+func scanMessage(row rowScanner) (*models.Message, error) {
+	var m models.Message
+	err := row.Scan(&m.ID, &m.ConversationID, &m.SenderID, &m.Body, &m.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+// scanUserConversation сканирует строку userConversationSelect в переписку пользователя
+func scanUserConversation(row rowScanner) (*models.ConversationResponse, error) {
+	var c models.ConversationResponse
+	var messageID, senderID *int
+	var body *string
+	var sentAt *time.Time
+
+	err := row.Scan(
+		&c.ID, &c.CatID, &c.ListingID, &c.InterlocutorID, &c.Email,
+		&messageID, &senderID, &body, &sentAt,
+		&c.UnreadCount, &c.CreatedAt, &c.LastMessageAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if messageID != nil {
+		c.LastMessage = &models.Message{
+			ID:             *messageID,
+			ConversationID: c.ID,
+			SenderID:       *senderID,
+			Body:           *body,
+			CreatedAt:      *sentAt,
+		}
+	}
+
+	return &c, nil
+}
+
+// CreateConversation создает переписку и добавляет ее участников в одной транзакции
+func (r *messageRepository) CreateConversation(conversation *models.Conversation, participantIDs []int) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.Exec(
+		`INSERT INTO conversations (cat_id, listing_id, created_by) VALUES (?, ?, ?)`,
+		conversation.CatID, conversation.ListingID, conversation.CreatedBy,
+	)
+	if err != nil {
+		return err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	for _, userID := range participantIDs {
+		_, err = tx.Exec(`INSERT INTO conversation_participants (conversation_id, user_id) VALUES (?, ?)`, id, userID)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	conversation.ID = int(id)
+	return nil
+}
+
+// FindConversation возвращает переписку двух пользователей о коте или объявлении
+func (r *messageRepository) FindConversation(userID int, interlocutorID int, catID int, listingID *int) (*models.Conversation, error) {
+	query := `SELECT ` + conversationColumns + ` FROM conversations
+		WHERE cat_id = ? AND listing_id IS ?
+			AND id IN (SELECT conversation_id FROM conversation_participants WHERE user_id = ?)
+			AND id IN (SELECT conversation_id FROM conversation_participants WHERE user_id = ?)
+		ORDER BY id LIMIT 1`
+
+	return scanConversation(r.db.QueryRow(query, catID, listingID, userID, interlocutorID))
+}
+
+// GetConversationByID возвращает переписку по ID
+func (r *messageRepository) GetConversationByID(id int) (*models.Conversation, error) {
+	query := `SELECT ` + conversationColumns + ` FROM conversations WHERE id = ?`
+
+	return scanConversation(r.db.QueryRow(query, id))
+}
+
+// GetParticipantIDs возвращает ID участников переписки
+func (r *messageRepository) GetParticipantIDs(conversationID int) ([]int, error) {
+	query := `SELECT user_id FROM conversation_participants WHERE conversation_id = ? ORDER BY user_id`
+
+	rows, err := r.db.Query(query, conversationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var userIDs []int
+	for rows.Next() {
+		var userID int
+		if err := rows.Scan(&userID); err != nil {
+			return nil, err
+		}
+		userIDs = append(userIDs, userID)
+	}
+
+	return userIDs, nil
+}
+
+// GetUserConversation возвращает переписку, если пользователь в ней участвует
+func (r *messageRepository) GetUserConversation(conversationID int, userID int) (*models.ConversationResponse, error) {
+	query := userConversationSelect + ` AND c.id = ?`
+
+	return scanUserConversation(r.db.QueryRow(query, userID, conversationID))
+}
+
+// GetUserConversations возвращает переписки пользователя, с последними сообщениями первыми
+func (r *messageRepository) GetUserConversations(userID int) ([]models.ConversationResponse, error) {
+	query := userConversationSelect + ` ORDER BY c.last_message_at DESC, c.id DESC`
+
+	rows, err := r.db.Query(query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var conversations []models.ConversationResponse
+	for rows.Next() {
+		conversation, err := scanUserConversation(rows)
+		if err != nil {
+			return nil, err
+		}
+		conversations = append(conversations, *conversation)
+	}
+
+	return conversations, nil
+}
+
+// CreateMessage сохраняет сообщение, поднимает переписку в списке и отмечает ее прочитанной отправителем
+func (r *messageRepository) CreateMessage(message *models.Message) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.Exec(
+		`INSERT INTO messages (conversation_id, sender_id, body, created_at) VALUES (?, ?, ?, ?)`,
+		message.ConversationID, message.SenderID, message.Body, message.CreatedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`UPDATE conversations SET last_message_at = ? WHERE id = ?`, message.CreatedAt, message.ConversationID)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(
+		`UPDATE conversation_participants SET last_read_message_id = ? WHERE conversation_id = ? AND user_id = ?`,
+		id, message.ConversationID, message.SenderID,
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	message.ID = int(id)
+	return nil
+}
+
+// GetMessages возвращает сообщения переписки, новые первыми
+func (r *messageRepository) GetMessages(filter *models.MessageFilter) ([]models.Message, error) {
+	query := `SELECT ` + messageColumns + ` FROM messages WHERE conversation_id = ?`
+	params := []interface{}{filter.ConversationID}
+
+	// Курсор - ID последнего сообщения предыдущей страницы
+	if filter.BeforeID != 0 {
+		query += " AND id < ?"
+		params = append(params, filter.BeforeID)
+	}
+
+	query += " ORDER BY id DESC LIMIT ?"
+	params = append(params, filter.Limit)
+
+	rows, err := r.db.Query(query, params...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var messages []models.Message
+	for rows.Next() {
+		message, err := scanMessage(rows)
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, *message)
+	}
+
+	return messages, nil
+}
+
+// MarkRead отмечает все сообщения переписки прочитанными пользователем.
+// Возвращает true, если были непрочитанные сообщения
+func (r *messageRepository) MarkRead(conversationID int, userID int) (bool, error) {
+	query := `UPDATE conversation_participants
+		SET last_read_message_id = (SELECT COALESCE(MAX(id), 0) FROM messages WHERE conversation_id = ?)
+		WHERE conversation_id = ? AND user_id = ?
+			AND last_read_message_id < (SELECT COALESCE(MAX(id), 0) FROM messages WHERE conversation_id = ?)`
+
+	result, err := r.db.Execute(query, conversationID, conversationID, userID, conversationID)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+// CountUnread возвращает число непрочитанных сообщений во всех переписках пользователя
+func (r *messageRepository) CountUnread(userID int) (int, error) {
+	query := `SELECT COUNT(*) FROM conversation_participants p
+		JOIN messages m ON m.conversation_id = p.conversation_id
+		WHERE p.user_id = ? AND m.id > p.last_read_message_id AND m.sender_id != p.user_id`
+
+	var count int
+	if err := r.db.QueryRow(query, userID).Scan(&count); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
@@ -0,0 +1,175 @@
+package repositories
+
+import (
+	"time"
+
+	"meawle/internal/models"
+)
+
+// ModerationRepository определяет интерфейс для работы с жалобами и предупреждениями пользователям
+type ModerationRepository interface {
+	CreateReport(report *models.ContentReport) error
+	GetReportByID(id int) (*models.ContentReport, error)
+	HasOpenReport(targetType string, targetID int, reporterID *int) (bool, error)
+	GetReports(filter *models.ContentReportFilter) ([]models.ContentReport, error)
+	ResolveTarget(targetType string, targetID int, action string, note *string, moderatorID int, resolvedAt time.Time) error
+	CreateWarning(warning *models.UserWarning) error
+	GetWarningsByUserID(userID int) ([]models.UserWarning, error)
+}
+
+type moderationRepository struct {
+	db Database
+}
+
+// contentReportColumns содержит список колонок, выбираемых для жалобы, вместе с числом открытых жалоб на объект
+const contentReportColumns = `r.id, r.target_type, r.target_id, r.reporter_id, r.reason, r.details, r.status, r.action,
+	r.resolution_note, r.resolved_by, r.resolved_at, r.created_at,
+	(SELECT COUNT(*) FROM content_reports o
+		WHERE o.target_type = r.target_type AND o.target_id = r.target_id AND o.status = 'open') AS target_reports`
+
+// NewModerationRepository создает новый экземпляр репозитория модерации
+func NewModerationRepository(db Database) ModerationRepository {
+	return &moderationRepository{db: db}
+}
+
+// scanContentReport сканирует строку результата в модель жалобы
+func scanContentReport(row rowScanner) (*models.ContentReport, error) {
+	var r models.ContentReport
+	err := row.Scan(
+		&r.ID, &r.TargetType, &r.TargetID, &r.ReporterID, &r.Reason, &r.Details, &r.Status, &r.Action,
+		&r.ResolutionNote, &r.ResolvedBy, &r.ResolvedAt, &r.CreatedAt, &r.TargetReports,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+// CreateReport создает новую жалобу
+func (r *moderationRepository) CreateReport(report *models.ContentReport) error {
+	query := `INSERT INTO content_reports (target_type, target_id, reporter_id, reason, details, status)
+		VALUES (?, ?, ?, ?, ?, ?)`
+
+	result, err := r.db.Execute(query,
+		report.TargetType, report.TargetID, report.ReporterID, report.Reason, report.Details, report.Status,
+	)
+	if err != nil {
+		return err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	report.ID = int(id)
+	return nil
+}
+
+// GetReportByID возвращает жалобу по ID
+func (r *moderationRepository) GetReportByID(id int) (*models.ContentReport, error) {
+	query := `SELECT ` + contentReportColumns + ` FROM content_reports r WHERE r.id = ?`
+
+	return scanContentReport(r.db.QueryRow(query, id))
+}
+
+// HasOpenReport проверяет, есть ли у пользователя открытая жалоба на объект.
+// reporterID равен nil для жалоб фильтра текста
+func (r *moderationRepository) HasOpenReport(targetType string, targetID int, reporterID *int) (bool, error) {
+	query := `SELECT COUNT(*) FROM content_reports
+		WHERE target_type = ? AND target_id = ? AND reporter_id IS ? AND status = ?`
+
+	var count int
+	err := r.db.QueryRow(query, targetType, targetID, reporterID, models.ReportStatusOpen).Scan(&count)
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// GetReports возвращает жалобы с указанным статусом, старые первыми
+func (r *moderationRepository) GetReports(filter *models.ContentReportFilter) ([]models.ContentReport, error) {
+	query := `SELECT ` + contentReportColumns + ` FROM content_reports r WHERE r.status = ?`
+	params := []interface{}{filter.Status}
+
+	if filter.TargetType != nil {
+		query += " AND r.target_type = ?"
+		params = append(params, *filter.TargetType)
+	}
+
+	query += " ORDER BY r.created_at, r.id LIMIT ? OFFSET ?"
+	params = append(params, filter.Limit, filter.Offset)
+
+	rows, err := r.db.Query(query, params...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var reports []models.ContentReport
+	for rows.Next() {
+		report, err := scanContentReport(rows)
+		if err != nil {
+			return nil, err
+		}
+		reports = append(reports, *report)
+	}
+
+	return reports, nil
+}
+
+// ResolveTarget закрывает все открытые жалобы на объект одним решением модератора
+func (r *moderationRepository) ResolveTarget(targetType string, targetID int, action string, note *string, moderatorID int, resolvedAt time.Time) error {
+	query := `UPDATE content_reports SET status = ?, action = ?, resolution_note = ?, resolved_by = ?, resolved_at = ?
+		WHERE target_type = ? AND target_id = ? AND status = ?`
+
+	_, err := r.db.Execute(query,
+		models.ReportStatusResolved, action, note, moderatorID, resolvedAt,
+		targetType, targetID, models.ReportStatusOpen,
+	)
+	return err
+}
+
+// CreateWarning создает предупреждение пользователю
+func (r *moderationRepository) CreateWarning(warning *models.UserWarning) error {
+	query := `INSERT INTO user_warnings (user_id, report_id, reason, issued_by) VALUES (?, ?, ?, ?)`
+
+	result, err := r.db.Execute(query, warning.UserID, warning.ReportID, warning.Reason, warning.IssuedBy)
+	if err != nil {
+		return err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	warning.ID = int(id)
+	return nil
+}
+
+// GetWarningsByUserID возвращает предупреждения пользователя, новые первыми
+func (r *moderationRepository) GetWarningsByUserID(userID int) ([]models.UserWarning, error) {
+	query := `SELECT id, user_id, report_id, reason, issued_by, created_at FROM user_warnings
+		WHERE user_id = ? ORDER BY created_at DESC, id DESC`
+
+	rows, err := r.db.Query(query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var warnings []models.UserWarning
+	for rows.Next() {
+		var w models.UserWarning
+		err := rows.Scan(&w.ID, &w.UserID, &w.ReportID, &w.Reason, &w.IssuedBy, &w.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		warnings = append(warnings, w)
+	}
+
+	return warnings, nil
+}
@@ -0,0 +1,335 @@
+package repositories
+
+import (
+	"database/sql"
+
+	"meawle/internal/models"
+)
+
+// OrganizationRepository определяет интерфейс для работы с организациями и их сотрудниками
+type OrganizationRepository interface {
+	Create(org *models.Organization) error
+	GetByID(id int) (*models.Organization, error)
+	GetAll() ([]models.Organization, error)
+	GetByMemberID(userID int) ([]models.Organization, error)
+	Update(id int, org *models.OrganizationUpdateRequest) error
+	Delete(id int) error
+	ExistsByName(name string) (bool, error)
+	AddMember(member *models.OrganizationMember) error
+	GetMemberByID(id int) (*models.OrganizationMember, error)
+	GetMembers(organizationID int) ([]models.OrganizationMember, error)
+	GetMemberRole(organizationID int, userID int) (string, error)
+	GetUserRoles(userID int) (map[int]string, error)
+	CountAdmins(organizationID int) (int, error)
+	UpdateMemberRole(id int, role string) error
+	RemoveMember(id int) error
+}
+
+type organizationRepository struct {
+	db Database
+}
+
+// organizationSelect содержит запрос организаций с количеством сотрудников и котов
+const organizationSelect = `SELECT o.id, o.name, o.description, o.contact_email, o.created_by,
+	(SELECT COUNT(*) FROM organization_members om WHERE om.organization_id = o.id),
+	(SELECT COUNT(*) FROM cats c WHERE c.organization_id = o.id),
+	o.created_at
+	FROM organizations o`
+
+// organizationMemberSelect содержит запрос сотрудников с email пользователя
+const organizationMemberSelect = `SELECT m.id, m.organization_id, m.user_id, u.email, m.role, m.created_at
+	FROM organization_members m
+	JOIN users u ON u.id = m.user_id`
+
+// NewOrganizationRepository создает новый экземпляр репозитория организаций
+func NewOrganizationRepository(db Database) OrganizationRepository {
+	return &organizationRepository{db: db}
+}
+
+// scanOrganization сканирует строку результата в модель организации
+func scanOrganization(row rowScanner) (*models.Organization, error) {
+	var org models.Organization
+	err := row.Scan(
+		&org.ID, &org.Name, &org.Description, &org.ContactEmail, &org.CreatedBy,
+		&org.MemberCount, &org.CatCount, &org.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &org, nil
+}
+
+// scanOrganizationMember сканирует строку результата в модель сотрудника
+func scanOrganizationMember(row rowScanner) (*models.OrganizationMember, error) {
+	var m models.OrganizationMember
+	err := row.Scan(&m.ID, &m.OrganizationID, &m.UserID, &m.Email, &m.Role, &m.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+// Create создает организацию и добавляет ее создателя администратором в одной транзакции
+func (r *organizationRepository) Create(org *models.Organization) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.Exec(
+		`INSERT INTO organizations (name, description, contact_email, created_by) VALUES (?, ?, ?, ?)`,
+		org.Name, org.Description, org.ContactEmail, org.CreatedBy,
+	)
+	if err != nil {
+		return err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(
+		`INSERT INTO organization_members (organization_id, user_id, role) VALUES (?, ?, ?)`,
+		id, org.CreatedBy, models.OrganizationRoleAdmin,
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	org.ID = int(id)
+	org.MemberCount = 1
+	return nil
+}
+
+// GetByID возвращает организацию по ID
+func (r *organizationRepository) GetByID(id int) (*models.Organization, error) {
+	query := organizationSelect + ` WHERE o.id = ?`
+
+	return scanOrganization(r.db.QueryRow(query, id))
+}
+
+// GetAll возвращает все организации
+func (r *organizationRepository) GetAll() ([]models.Organization, error) {
+	query := organizationSelect + ` ORDER BY o.name`
+
+	return r.queryOrganizations(query)
+}
+
+// GetByMemberID возвращает организации, в которых пользователь является сотрудником
+func (r *organizationRepository) GetByMemberID(userID int) ([]models.Organization, error) {
+	query := organizationSelect + `
+		WHERE o.id IN (SELECT organization_id FROM organization_members WHERE user_id = ?)
+		ORDER BY o.name`
+
+	return r.queryOrganizations(query, userID)
+}
+
+// Update обновляет данные организации
+func (r *organizationRepository) Update(id int, updateReq *models.OrganizationUpdateRequest) error {
+	query := `UPDATE organizations SET `
+	params := []interface{}{}
+
+	if updateReq.Name != nil {
+		query += "name = ?, "
+		params = append(params, *updateReq.Name)
+	}
+
+	if updateReq.Description != nil {
+		query += "description = ?, "
+		params = append(params, *updateReq.Description)
+	}
+
+	if updateReq.ContactEmail != nil {
+		query += "contact_email = ?, "
+		params = append(params, *updateReq.ContactEmail)
+	}
+
+	if len(params) == 0 {
+		return nil
+	}
+
+	// Убираем последнюю запятую и пробел
+	query = query[:len(query)-2]
+	query += " WHERE id = ?"
+	params = append(params, id)
+
+	_, err := r.db.Execute(query, params...)
+	return err
+}
+
+// Delete удаляет организацию и ее сотрудников. Коты и породы организации остаются у создавших их пользователей
+func (r *organizationRepository) Delete(id int) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	queries := []string{
+		`UPDATE cats SET organization_id = NULL WHERE organization_id = ?`,
+		`UPDATE cat_breeds SET organization_id = NULL WHERE organization_id = ?`,
+		`DELETE FROM organization_members WHERE organization_id = ?`,
+		`DELETE FROM organizations WHERE id = ?`,
+	}
+	for _, query := range queries {
+		if _, err := tx.Exec(query, id); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// ExistsByName проверяет существование организации по названию
+func (r *organizationRepository) ExistsByName(name string) (bool, error) {
+	query := `SELECT COUNT(*) FROM organizations WHERE name = ?`
+
+	var count int
+	err := r.db.QueryRow(query, name).Scan(&count)
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// AddMember добавляет сотрудника в организацию
+func (r *organizationRepository) AddMember(member *models.OrganizationMember) error {
+	query := `INSERT INTO organization_members (organization_id, user_id, role) VALUES (?, ?, ?)`
+
+	result, err := r.db.Execute(query, member.OrganizationID, member.UserID, member.Role)
+	if err != nil {
+		return err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	member.ID = int(id)
+	return nil
+}
+
+// GetMemberByID возвращает сотрудника по ID
+func (r *organizationRepository) GetMemberByID(id int) (*models.OrganizationMember, error) {
+	query := organizationMemberSelect + ` WHERE m.id = ?`
+
+	return scanOrganizationMember(r.db.QueryRow(query, id))
+}
+
+// GetMembers возвращает сотрудников организации
+func (r *organizationRepository) GetMembers(organizationID int) ([]models.OrganizationMember, error) {
+	query := organizationMemberSelect + ` WHERE m.organization_id = ? ORDER BY m.created_at, m.id`
+
+	rows, err := r.db.Query(query, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var members []models.OrganizationMember
+	for rows.Next() {
+		member, err := scanOrganizationMember(rows)
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, *member)
+	}
+
+	return members, nil
+}
+
+// GetMemberRole возвращает роль пользователя в организации или пустую строку, если он не сотрудник
+func (r *organizationRepository) GetMemberRole(organizationID int, userID int) (string, error) {
+	query := `SELECT role FROM organization_members WHERE organization_id = ? AND user_id = ?`
+
+	var role string
+	err := r.db.QueryRow(query, organizationID, userID).Scan(&role)
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return role, nil
+}
+
+// GetUserRoles возвращает роли пользователя во всех его организациях по ID организации
+func (r *organizationRepository) GetUserRoles(userID int) (map[int]string, error) {
+	query := `SELECT organization_id, role FROM organization_members WHERE user_id = ?`
+
+	rows, err := r.db.Query(query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	roles := make(map[int]string)
+	for rows.Next() {
+		var organizationID int
+		var role string
+		if err := rows.Scan(&organizationID, &role); err != nil {
+			return nil, err
+		}
+		roles[organizationID] = role
+	}
+
+	return roles, nil
+}
+
+// CountAdmins возвращает количество администраторов организации
+func (r *organizationRepository) CountAdmins(organizationID int) (int, error) {
+	query := `SELECT COUNT(*) FROM organization_members WHERE organization_id = ? AND role = ?`
+
+	var count int
+	err := r.db.QueryRow(query, organizationID, models.OrganizationRoleAdmin).Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// UpdateMemberRole изменяет роль сотрудника
+func (r *organizationRepository) UpdateMemberRole(id int, role string) error {
+	query := `UPDATE organization_members SET role = ? WHERE id = ?`
+	_, err := r.db.Execute(query, role, id)
+	return err
+}
+
+// RemoveMember удаляет сотрудника из организации
+func (r *organizationRepository) RemoveMember(id int) error {
+	query := `DELETE FROM organization_members WHERE id = ?`
+	_, err := r.db.Execute(query, id)
+	return err
+}
+
+// queryOrganizations выполняет запрос и возвращает список организаций
+func (r *organizationRepository) queryOrganizations(query string, args ...interface{}) ([]models.Organization, error) {
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var orgs []models.Organization
+	for rows.Next() {
+		org, err := scanOrganization(rows)
+		if err != nil {
+			return nil, err
+		}
+		orgs = append(orgs, *org)
+	}
+
+	return orgs, nil
+}
@@ -0,0 +1,156 @@
+package repositories
+
+import (
+	"meawle/internal/models"
+)
+
+// PedigreeRepository определяет интерфейс для работы с родословными котов
+type PedigreeRepository interface {
+	CreateExternalAncestor(ancestor *models.ExternalAncestor) error
+	GetExternalAncestorByID(id int) (*models.ExternalAncestor, error)
+	GetAncestorLinks(catID int, generations int) ([]models.PedigreeLink, error)
+	GetDescendants(catID int, generations int) ([]models.CatDescendant, error)
+	IsCatAncestor(ancestorID int, catID int) (bool, error)
+}
+
+type pedigreeRepository struct {
+	db Database
+}
+
+// NewPedigreeRepository создает новый экземпляр репозитория родословных
+func NewPedigreeRepository(db Database) PedigreeRepository {
+	return &pedigreeRepository{db: db}
+}
+
+// CreateExternalAncestor создает внешнего предка
+func (r *pedigreeRepository) CreateExternalAncestor(ancestor *models.ExternalAncestor) error {
+	query := `INSERT INTO external_ancestors (name, sex, registration_number, sire_id, dam_id, user_id) VALUES (?, ?, ?, ?, ?, ?)`
+
+	result, err := r.db.Execute(query,
+		ancestor.Name, ancestor.Sex, ancestor.RegistrationNumber, ancestor.SireID, ancestor.DamID, ancestor.UserID,
+	)
+	if err != nil {
+		return err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	ancestor.ID = int(id)
+	return nil
+}
+
+// GetExternalAncestorByID возвращает внешнего предка по ID
+func (r *pedigreeRepository) GetExternalAncestorByID(id int) (*models.ExternalAncestor, error) {
+	query := `SELECT id, name, sex, registration_number, sire_id, dam_id, user_id, created_at
+		FROM external_ancestors WHERE id = ?`
+
+	var a models.ExternalAncestor
+	err := r.db.QueryRow(query, id).Scan(
+		&a.ID, &a.Name, &a.Sex, &a.RegistrationNumber, &a.SireID, &a.DamID, &a.UserID, &a.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &a, nil
+}
+
+// GetAncestorLinks возвращает связи "потомок - родитель" предков кота на указанное число поколений.
+// Рекурсивный запрос идет по представлению pedigree_links, объединяющему зарегистрированных котов и внешних предков
+func (r *pedigreeRepository) GetAncestorLinks(catID int, generations int) ([]models.PedigreeLink, error) {
+	query := `WITH RECURSIVE ancestors(child_kind, child_id, parent_kind, parent_id, role, generation) AS (
+			SELECT child_kind, child_id, parent_kind, parent_id, role, 1
+			FROM pedigree_links WHERE child_kind = 'cat' AND child_id = ?
+			UNION
+			SELECT l.child_kind, l.child_id, l.parent_kind, l.parent_id, l.role, a.generation + 1
+			FROM pedigree_links l
+			JOIN ancestors a ON l.child_kind = a.parent_kind AND l.child_id = a.parent_id
+			WHERE a.generation < ?
+		)
+		SELECT DISTINCT a.child_kind, a.child_id, a.parent_kind, a.parent_id, a.role,
+			COALESCE(c.name, e.name, ''), COALESCE(c.sex, e.sex)
+		FROM ancestors a
+		LEFT JOIN cats c ON a.parent_kind = 'cat' AND c.id = a.parent_id
+		LEFT JOIN external_ancestors e ON a.parent_kind = 'external' AND e.id = a.parent_id`
+
+	rows, err := r.db.Query(query, catID, generations)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var links []models.PedigreeLink
+	for rows.Next() {
+		var l models.PedigreeLink
+		err := rows.Scan(&l.ChildKind, &l.ChildID, &l.ParentKind, &l.ParentID, &l.Role, &l.ParentName, &l.ParentSex)
+		if err != nil {
+			return nil, err
+		}
+		links = append(links, l)
+	}
+
+	return links, nil
+}
+
+// GetDescendants возвращает зарегистрированных потомков кота на указанное число поколений.
+// Для каждого потомка указывается ближайшее поколение, в котором он встречается
+func (r *pedigreeRepository) GetDescendants(catID int, generations int) ([]models.CatDescendant, error) {
+	query := `WITH RECURSIVE descendants(id, generation) AS (
+			SELECT id, 1 FROM cats WHERE sire_id = ? OR dam_id = ?
+			UNION
+			SELECT c.id, d.generation + 1
+			FROM cats c
+			JOIN descendants d ON c.sire_id = d.id OR c.dam_id = d.id
+			WHERE d.generation < ?
+		)
+		SELECT c.id, c.name, c.sex, c.birth_date, MIN(d.generation) AS generation
+		FROM descendants d
+		JOIN cats c ON c.id = d.id
+		GROUP BY c.id
+		ORDER BY generation, c.birth_date, c.id`
+
+	rows, err := r.db.Query(query, catID, catID, generations)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var descendants []models.CatDescendant
+	for rows.Next() {
+		var d models.CatDescendant
+		if err := rows.Scan(&d.ID, &d.Name, &d.Sex, &d.BirthDate, &d.Generation); err != nil {
+			return nil, err
+		}
+		descendants = append(descendants, d)
+	}
+
+	return descendants, nil
+}
+
+// IsCatAncestor проверяет, является ли кот ancestorID предком кота catID среди зарегистрированных котов
+func (r *pedigreeRepository) IsCatAncestor(ancestorID int, catID int) (bool, error) {
+	// UNION отбрасывает повторы, поэтому запрос завершается даже при уже существующем цикле
+	query := `WITH RECURSIVE ancestors(id) AS (
+			SELECT ?
+			UNION
+			SELECT p.parent_id
+			FROM (
+				SELECT id AS child_id, sire_id AS parent_id FROM cats WHERE sire_id IS NOT NULL
+				UNION ALL
+				SELECT id, dam_id FROM cats WHERE dam_id IS NOT NULL
+			) p
+			JOIN ancestors a ON p.child_id = a.id
+		)
+		SELECT COUNT(*) FROM ancestors WHERE id = ? AND id != ?`
+
+	var count int
+	err := r.db.QueryRow(query, catID, ancestorID, catID).Scan(&count)
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
@@ -0,0 +1,175 @@
+package repositories
+
+import (
+	"time"
+
+	"meawle/internal/models"
+)
+
+// ReminderRepository определяет интерфейс для работы с напоминаниями
+type ReminderRepository interface {
+	Create(reminder *models.Reminder) error
+	GetByID(id int) (*models.Reminder, error)
+	GetByCatID(catID int) ([]models.Reminder, error)
+	GetDue(now time.Time, limit int) ([]models.Reminder, error)
+	Update(id int, reminder *models.ReminderUpdateRequest) error
+	SetSchedule(id int, nextRunAt *time.Time, isActive bool) error
+	MarkSent(id int, sentAt time.Time, nextRunAt *time.Time) error
+	Delete(id int) error
+}
+
+type reminderRepository struct {
+	db Database
+}
+
+// reminderColumns содержит список колонок, выбираемых для напоминания
+const reminderColumns = `id, cat_id, user_id, reminder_type, title, notes, starts_at, rrule, next_run_at, last_sent_at, is_active, created_at`
+
+// NewReminderRepository создает новый экземпляр репозитория напоминаний
+func NewReminderRepository(db Database) ReminderRepository {
+	return &reminderRepository{db: db}
+}
+
+// scanReminder сканирует строку результата в модель напоминания
+func scanReminder(row rowScanner) (*models.Reminder, error) {
+	var reminder models.Reminder
+	err := row.Scan(
+		&reminder.ID, &reminder.CatID, &reminder.UserID, &reminder.ReminderType, &reminder.Title, &reminder.Notes,
+		&reminder.StartsAt, &reminder.RRule, &reminder.NextRunAt, &reminder.LastSentAt, &reminder.IsActive, &reminder.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &reminder, nil
+}
+
+// Create создает новое напоминание
+func (r *reminderRepository) Create(reminder *models.Reminder) error {
+	query := `INSERT INTO cat_reminders (cat_id, user_id, reminder_type, title, notes, starts_at, rrule, next_run_at, is_active)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	result, err := r.db.Execute(query,
+		reminder.CatID, reminder.UserID, reminder.ReminderType, reminder.Title, reminder.Notes,
+		reminder.StartsAt, reminder.RRule, reminder.NextRunAt, reminder.IsActive,
+	)
+	if err != nil {
+		return err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	reminder.ID = int(id)
+	return nil
+}
+
+// GetByID возвращает напоминание по ID
+func (r *reminderRepository) GetByID(id int) (*models.Reminder, error) {
+	query := `SELECT ` + reminderColumns + ` FROM cat_reminders WHERE id = ?`
+
+	return scanReminder(r.db.QueryRow(query, id))
+}
+
+// GetByCatID возвращает напоминания кота
+func (r *reminderRepository) GetByCatID(catID int) ([]models.Reminder, error) {
+	query := `SELECT ` + reminderColumns + ` FROM cat_reminders WHERE cat_id = ? ORDER BY next_run_at IS NULL, next_run_at, id`
+
+	return r.queryReminders(query, catID)
+}
+
+// GetDue возвращает активные напоминания, время отправки которых наступило
+func (r *reminderRepository) GetDue(now time.Time, limit int) ([]models.Reminder, error) {
+	query := `SELECT ` + reminderColumns + ` FROM cat_reminders
+		WHERE is_active = 1 AND next_run_at IS NOT NULL AND next_run_at <= ?
+		ORDER BY next_run_at LIMIT ?`
+
+	return r.queryReminders(query, now, limit)
+}
+
+// Update обновляет данные напоминания
+func (r *reminderRepository) Update(id int, updateReq *models.ReminderUpdateRequest) error {
+	query := `UPDATE cat_reminders SET `
+	params := []interface{}{}
+
+	if updateReq.Title != nil {
+		query += "title = ?, "
+		params = append(params, *updateReq.Title)
+	}
+
+	if updateReq.Notes != nil {
+		query += "notes = ?, "
+		params = append(params, *updateReq.Notes)
+	}
+
+	if updateReq.StartsAt != nil {
+		query += "starts_at = ?, "
+		params = append(params, *updateReq.StartsAt)
+	}
+
+	if updateReq.RRule != nil {
+		// Пустая строка отключает повторение
+		if *updateReq.RRule == "" {
+			query += "rrule = NULL, "
+		} else {
+			query += "rrule = ?, "
+			params = append(params, *updateReq.RRule)
+		}
+	}
+
+	if updateReq.IsActive != nil {
+		query += "is_active = ?, "
+		params = append(params, *updateReq.IsActive)
+	}
+
+	// Убираем последнюю запятую и пробел
+	query = query[:len(query)-2]
+	query += " WHERE id = ?"
+	params = append(params, id)
+
+	_, err := r.db.Execute(query, params...)
+	return err
+}
+
+// SetSchedule обновляет время следующей отправки и активность напоминания
+func (r *reminderRepository) SetSchedule(id int, nextRunAt *time.Time, isActive bool) error {
+	query := `UPDATE cat_reminders SET next_run_at = ?, is_active = ? WHERE id = ?`
+	_, err := r.db.Execute(query, nextRunAt, isActive, id)
+	return err
+}
+
+// MarkSent фиксирует отправку напоминания и планирует следующую. Без следующей отправки напоминание деактивируется
+func (r *reminderRepository) MarkSent(id int, sentAt time.Time, nextRunAt *time.Time) error {
+	query := `UPDATE cat_reminders SET last_sent_at = ?, next_run_at = ?, is_active = ? WHERE id = ?`
+	_, err := r.db.Execute(query, sentAt, nextRunAt, nextRunAt != nil, id)
+	return err
+}
+
+// Delete удаляет напоминание
+func (r *reminderRepository) Delete(id int) error {
+	query := `DELETE FROM cat_reminders WHERE id = ?`
+	_, err := r.db.Execute(query, id)
+	return err
+}
+
+// queryReminders выполняет запрос и возвращает список напоминаний
+func (r *reminderRepository) queryReminders(query string, args ...interface{}) ([]models.Reminder, error) {
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var reminders []models.Reminder
+	for rows.Next() {
+		reminder, err := scanReminder(rows)
+		if err != nil {
+			return nil, err
+		}
+		reminders = append(reminders, *reminder)
+	}
+
+	return reminders, nil
+}
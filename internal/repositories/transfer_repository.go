@@ -0,0 +1,313 @@
+package repositories
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"meawle/internal/models"
+)
+
+// TransferRepository определяет интерфейс для работы с передачами котов и историей владельцев
+type TransferRepository interface {
+	Create(transfer *models.CatTransfer) error
+	GetByID(id int) (*models.CatTransfer, error)
+	GetPendingByCatID(catID int) (*models.CatTransfer, error)
+	GetIncoming(userID int) ([]models.CatTransfer, error)
+	GetOutgoing(userID int) ([]models.CatTransfer, error)
+	Resolve(id int, status string) error
+	Accept(transfer *models.CatTransfer) error
+	ForceTransfer(record *models.OwnershipRecord) error
+	GetHistory(catID int) ([]models.OwnershipRecord, error)
+}
+
+// ErrCatOwnerChanged возвращается, если владелец кота сменился после того, как смена владельца была запрошена
+var ErrCatOwnerChanged = errors.New("cat owner has changed")
+
+type transferRepository struct {
+	db Database
+}
+
+// transferSelect содержит запрос передач с именем кота и email участников
+const transferSelect = `SELECT t.id, t.cat_id, c.name, t.from_user_id, fu.email, t.to_user_id, tu.email,
+	t.status, t.message, t.created_at, t.resolved_at
+	FROM cat_transfers t
+	JOIN cats c ON c.id = t.cat_id
+	JOIN users fu ON fu.id = t.from_user_id
+	JOIN users tu ON tu.id = t.to_user_id`
+
+// NewTransferRepository создает новый экземпляр репозитория передач котов
+func NewTransferRepository(db Database) TransferRepository {
+	return &transferRepository{db: db}
+}
+
+// scanTransfer сканирует строку результата в модель передачи
+func scanTransfer(row rowScanner) (*models.CatTransfer, error) {
+	var t models.CatTransfer
+	err := row.Scan(
+		&t.ID, &t.CatID, &t.CatName, &t.FromUserID, &t.FromEmail, &t.ToUserID, &t.ToEmail,
+		&t.Status, &t.Message, &t.CreatedAt, &t.ResolvedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &t, nil
+}
+
+// Create создает новую передачу кота
+func (r *transferRepository) Create(transfer *models.CatTransfer) error {
+	query := `INSERT INTO cat_transfers (cat_id, from_user_id, to_user_id, status, message) VALUES (?, ?, ?, ?, ?)`
+
+	result, err := r.db.Execute(query, transfer.CatID, transfer.FromUserID, transfer.ToUserID, transfer.Status, transfer.Message)
+	if err != nil {
+		return err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	transfer.ID = int(id)
+	return nil
+}
+
+// GetByID возвращает передачу по ID
+func (r *transferRepository) GetByID(id int) (*models.CatTransfer, error) {
+	query := transferSelect + ` WHERE t.id = ?`
+
+	return scanTransfer(r.db.QueryRow(query, id))
+}
+
+// GetPendingByCatID возвращает незавершенную передачу кота
+func (r *transferRepository) GetPendingByCatID(catID int) (*models.CatTransfer, error) {
+	query := transferSelect + ` WHERE t.cat_id = ? AND t.status = ?`
+
+	return scanTransfer(r.db.QueryRow(query, catID, models.TransferStatusPending))
+}
+
+// GetIncoming возвращает передачи, адресованные пользователю
+func (r *transferRepository) GetIncoming(userID int) ([]models.CatTransfer, error) {
+	query := transferSelect + ` WHERE t.to_user_id = ? ORDER BY t.created_at DESC, t.id DESC`
+
+	return r.queryTransfers(query, userID)
+}
+
+// GetOutgoing возвращает передачи, начатые пользователем
+func (r *transferRepository) GetOutgoing(userID int) ([]models.CatTransfer, error) {
+	query := transferSelect + ` WHERE t.from_user_id = ? ORDER BY t.created_at DESC, t.id DESC`
+
+	return r.queryTransfers(query, userID)
+}
+
+// Resolve завершает передачу без смены владельца (отклонение или отмена)
+func (r *transferRepository) Resolve(id int, status string) error {
+	query := `UPDATE cat_transfers SET status = ?, resolved_at = CURRENT_TIMESTAMP WHERE id = ? AND status = ?`
+	_, err := r.db.Execute(query, status, id, models.TransferStatusPending)
+	return err
+}
+
+// Accept принимает передачу: меняет владельца кота и записывает историю в одной транзакции.
+// Если кот уже не принадлежит отправителю, передача отменяется и возвращается ErrCatOwnerChanged
+func (r *transferRepository) Accept(transfer *models.CatTransfer) error {
+	err := r.accept(transfer)
+	if errors.Is(err, ErrCatOwnerChanged) {
+		if cancelErr := r.Resolve(transfer.ID, models.TransferStatusCancelled); cancelErr != nil {
+			return cancelErr
+		}
+	}
+
+	return err
+}
+
+// accept меняет владельца кота по передаче в одной транзакции
+func (r *transferRepository) accept(transfer *models.CatTransfer) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	// После успешного Commit откат ничего не делает
+	defer tx.Rollback()
+
+	query := `UPDATE cat_transfers SET status = ?, resolved_at = CURRENT_TIMESTAMP WHERE id = ? AND status = ?`
+	result, err := tx.Exec(query, models.TransferStatusAccepted, transfer.ID, models.TransferStatusPending)
+	if err != nil {
+		return err
+	}
+
+	// Передача могла быть завершена параллельным запросом
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	record := &models.OwnershipRecord{
+		CatID:      transfer.CatID,
+		FromUserID: &transfer.FromUserID,
+		ToUserID:   transfer.ToUserID,
+		TransferID: &transfer.ID,
+		Reason:     models.OwnershipReasonTransfer,
+		ChangedBy:  transfer.ToUserID,
+		CreatedAt:  time.Now().UTC().Truncate(time.Second),
+	}
+
+	if err := changeCatOwner(tx, record); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// ForceTransfer меняет владельца кота без согласия сторон, отменяя незавершенные передачи
+func (r *transferRepository) ForceTransfer(record *models.OwnershipRecord) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := changeCatOwner(tx, record); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// GetHistory возвращает историю владельцев кота в хронологическом порядке
+func (r *transferRepository) GetHistory(catID int) ([]models.OwnershipRecord, error) {
+	query := `SELECT id, cat_id, from_user_id, to_user_id, transfer_id, reason, changed_by, note, created_at
+		FROM cat_ownership_history WHERE cat_id = ? ORDER BY created_at, id`
+
+	rows, err := r.db.Query(query, catID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var records []models.OwnershipRecord
+	for rows.Next() {
+		var rec models.OwnershipRecord
+		err := rows.Scan(
+			&rec.ID, &rec.CatID, &rec.FromUserID, &rec.ToUserID, &rec.TransferID,
+			&rec.Reason, &rec.ChangedBy, &rec.Note, &rec.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, rec)
+	}
+
+	return records, nil
+}
+
+// changeCatOwner меняет владельца кота и добавляет запись в историю в рамках транзакции.
+// Незавершенные передачи кота отменяются, чтобы их принятие не отобрало кота у нового владельца.
+// Котенок из помета при передаче получает статус placed, участники прежнего владельца теряют доступ,
+// кот выходит из организации, чтобы ее сотрудники не сохранили права на чужого кота,
+// опубликованные объявления о пристройстве закрываются, а незавершенные заявки по ним отклоняются,
+// черновики объявлений прежнего владельца удаляются, а напоминания по уходу переходят новому владельцу.
+// Новый владелец-сотрудник может снова добавить кота в организацию при обновлении кота.
+// Если указан прежний владелец, а кот ему уже не принадлежит, возвращается ErrCatOwnerChanged
+func changeCatOwner(tx *sql.Tx, record *models.OwnershipRecord) error {
+	query := `UPDATE cats SET user_id = ?, organization_id = NULL,
+		availability = CASE WHEN availability IS NULL THEN NULL ELSE ? END
+		WHERE id = ?`
+	params := []interface{}{record.ToUserID, models.KittenPlaced, record.CatID}
+	if record.FromUserID != nil {
+		query += " AND user_id = ?"
+		params = append(params, *record.FromUserID)
+	}
+
+	result, err := tx.Exec(query, params...)
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrCatOwnerChanged
+	}
+
+	query = `UPDATE cat_transfers SET status = ?, resolved_at = CURRENT_TIMESTAMP WHERE cat_id = ? AND status = ?`
+	if _, err := tx.Exec(query, models.TransferStatusCancelled, record.CatID, models.TransferStatusPending); err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(`DELETE FROM cat_members WHERE cat_id = ?`, record.CatID); err != nil {
+		return err
+	}
+
+	query = `UPDATE adoption_applications SET status = ?, status_note = ?, updated_at = CURRENT_TIMESTAMP
+		WHERE status IN (?, ?, ?)
+		AND listing_id IN (SELECT id FROM listings WHERE cat_id = ? AND status IN (?, ?))`
+	_, err = tx.Exec(query,
+		models.ApplicationStatusRejected, models.ApplicationNoteListingClosed,
+		models.ApplicationStatusSubmitted, models.ApplicationStatusReviewing, models.ApplicationStatusApproved,
+		record.CatID, models.ListingStatusOpen, models.ListingStatusReserved,
+	)
+	if err != nil {
+		return err
+	}
+
+	query = `UPDATE listings SET status = ?, closed_at = CURRENT_TIMESTAMP, updated_at = CURRENT_TIMESTAMP
+		WHERE cat_id = ? AND status IN (?, ?)`
+	_, err = tx.Exec(query, models.ListingStatusAdopted, record.CatID, models.ListingStatusOpen, models.ListingStatusReserved)
+	if err != nil {
+		return err
+	}
+
+	// Черновик не был опубликован, поэтому пристройством его не считаем
+	query = `DELETE FROM listings WHERE cat_id = ? AND status = ?`
+	if _, err := tx.Exec(query, record.CatID, models.ListingStatusDraft); err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(`UPDATE cat_reminders SET user_id = ? WHERE cat_id = ?`, record.ToUserID, record.CatID); err != nil {
+		return err
+	}
+
+	query = `INSERT INTO cat_ownership_history (cat_id, from_user_id, to_user_id, transfer_id, reason, changed_by, note, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)`
+	result, err = tx.Exec(query,
+		record.CatID, record.FromUserID, record.ToUserID, record.TransferID, record.Reason, record.ChangedBy, record.Note,
+		record.CreatedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	record.ID = int(id)
+	return nil
+}
+
+// queryTransfers выполняет запрос и возвращает список передач
+func (r *transferRepository) queryTransfers(query string, args ...interface{}) ([]models.CatTransfer, error) {
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var transfers []models.CatTransfer
+	for rows.Next() {
+		transfer, err := scanTransfer(rows)
+		if err != nil {
+			return nil, err
+		}
+		transfers = append(transfers, *transfer)
+	}
+
+	return transfers, nil
+}
@@ -1,6 +1,8 @@
 package repositories
 
 import (
+	"time"
+
 	"meawle/internal/models"
 )
 
@@ -13,17 +15,34 @@ type UserRepository interface {
 	Update(id int, user *models.UserUpdateRequest) error
 	Delete(id int) error
 	ExistsByEmail(email string) (bool, error)
+	GetByCalendarToken(token string) (*models.User, error)
+	SetCalendarToken(id int, token string) error
+	SetBanned(id int, bannedAt *time.Time) error
 }
 
 type userRepository struct {
 	db Database
 }
 
+// userColumns содержит список колонок, выбираемых для пользователя
+const userColumns = `id, email, password, is_admin, is_moderator, banned_at`
+
 // NewUserRepository создает новый экземпляр репозитория пользователей
 func NewUserRepository(db Database) UserRepository {
 	return &userRepository{db: db}
 }
 
+// scanUser сканирует строку результата в модель пользователя
+func scanUser(row rowScanner) (*models.User, error) {
+	var user models.User
+	err := row.Scan(&user.ID, &user.Email, &user.Password, &user.IsAdmin, &user.IsModerator, &user.BannedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &user, nil
+}
+
 // Create создает нового пользователя
 func (r *userRepository) Create(user *models.User) error {
 	query := `INSERT INTO users (email, password, is_admin) VALUES (?, ?, ?)`
@@ -44,37 +63,21 @@ func (r *userRepository) Create(user *models.User) error {
 
 // GetByID возвращает пользователя по ID
 func (r *userRepository) GetByID(id int) (*models.User, error) {
-	query := `SELECT id, email, password, is_admin FROM users WHERE id = ?`
-
-	row := r.db.QueryRow(query, id)
+	query := `SELECT ` + userColumns + ` FROM users WHERE id = ?`
 
-	var user models.User
-	err := row.Scan(&user.ID, &user.Email, &user.Password, &user.IsAdmin)
-	if err != nil {
-		return nil, err
-	}
-
-	return &user, nil
+	return scanUser(r.db.QueryRow(query, id))
 }
 
 // GetByEmail возвращает пользователя по email
 func (r *userRepository) GetByEmail(email string) (*models.User, error) {
-	query := `SELECT id, email, password, is_admin FROM users WHERE email = ?`
-
-	row := r.db.QueryRow(query, email)
+	query := `SELECT ` + userColumns + ` FROM users WHERE email = ?`
 
-	var user models.User
-	err := row.Scan(&user.ID, &user.Email, &user.Password, &user.IsAdmin)
-	if err != nil {
-		return nil, err
-	}
-
-	return &user, nil
+	return scanUser(r.db.QueryRow(query, email))
 }
 
 // GetAll возвращает всех пользователей
 func (r *userRepository) GetAll() ([]models.User, error) {
-	query := `SELECT id, email, password, is_admin FROM users ORDER BY id`
+	query := `SELECT ` + userColumns + ` FROM users ORDER BY id`
 
 	rows, err := r.db.Query(query)
 	if err != nil {
@@ -84,12 +87,11 @@ func (r *userRepository) GetAll() ([]models.User, error) {
 
 	var users []models.User
 	for rows.Next() {
-		var user models.User
-		err := rows.Scan(&user.ID, &user.Email, &user.Password, &user.IsAdmin)
+		user, err := scanUser(rows)
 		if err != nil {
 			return nil, err
 		}
-		users = append(users, user)
+		users = append(users, *user)
 	}
 
 	return users, nil
@@ -115,6 +117,11 @@ func (r *userRepository) Update(id int, updateReq *models.UserUpdateRequest) err
 		params = append(params, *updateReq.IsAdmin)
 	}
 
+	if updateReq.IsModerator != nil {
+		query += "is_moderator = ?, "
+		params = append(params, *updateReq.IsModerator)
+	}
+
 	// Убираем последнюю запятую и пробел
 	query = query[:len(query)-2]
 	query += " WHERE id = ?"
@@ -143,3 +150,24 @@ func (r *userRepository) ExistsByEmail(email string) (bool, error) {
 
 	return count > 0, nil
 }
+
+// GetByCalendarToken возвращает пользователя по секретному токену календаря
+func (r *userRepository) GetByCalendarToken(token string) (*models.User, error) {
+	query := `SELECT ` + userColumns + ` FROM users WHERE calendar_token = ?`
+
+	return scanUser(r.db.QueryRow(query, token))
+}
+
+// SetCalendarToken сохраняет секретный токен календаря пользователя
+func (r *userRepository) SetCalendarToken(id int, token string) error {
+	query := `UPDATE users SET calendar_token = ? WHERE id = ?`
+	_, err := r.db.Execute(query, token, id)
+	return err
+}
+
+// SetBanned блокирует пользователя или снимает блокировку, если bannedAt равен nil
+func (r *userRepository) SetBanned(id int, bannedAt *time.Time) error {
+	query := `UPDATE users SET banned_at = ? WHERE id = ?`
+	_, err := r.db.Execute(query, bannedAt, id)
+	return err
+}
@@ -0,0 +1,81 @@
+package scheduler
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+)
+
+// Job представляет периодическую фоновую задачу
+type Job func(ctx context.Context, now time.Time) error
+
+// Scheduler периодически запускает зарегистрированные фоновые задачи
+type Scheduler struct {
+	interval time.Duration
+	logger   *log.Logger
+	jobs     map[string]Job
+	cancel   context.CancelFunc
+	wg       sync.WaitGroup
+}
+
+// New создает новый планировщик с указанным интервалом запуска задач
+func New(interval time.Duration, logger *log.Logger) *Scheduler {
+	return &Scheduler{
+		interval: interval,
+		logger:   logger,
+		jobs:     make(map[string]Job),
+	}
+}
+
+// AddJob регистрирует задачу. Задачи должны быть добавлены до вызова Start
+func (s *Scheduler) AddJob(name string, job Job) {
+	s.jobs[name] = job
+}
+
+// Start запускает планировщик в горутине
+func (s *Scheduler) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+
+		s.logger.Printf("Scheduler started with interval %s", s.interval)
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case now := <-ticker.C:
+				s.runJobs(ctx, now)
+			}
+		}
+	}()
+}
+
+// Stop останавливает планировщик и дожидается завершения выполняющихся задач
+func (s *Scheduler) Stop() {
+	if s.cancel == nil {
+		return
+	}
+
+	s.cancel()
+	s.wg.Wait()
+	s.logger.Println("Scheduler stopped")
+}
+
+// runJobs последовательно выполняет все задачи, записывая ошибки в лог
+func (s *Scheduler) runJobs(ctx context.Context, now time.Time) {
+	for name, job := range s.jobs {
+		if ctx.Err() != nil {
+			return
+		}
+		if err := job(ctx, now); err != nil {
+			s.logger.Printf("Scheduler job %s failed: %v", name, err)
+		}
+	}
+}
@@ -0,0 +1,350 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"meawle/internal/models"
+	"meawle/internal/notifier"
+	"meawle/internal/repositories"
+)
+
+var (
+	ErrApplicationNotFound      = errors.New("application not found")
+	ErrApplicationExists        = errors.New("application for this listing already exists")
+	ErrInvalidApplicationData   = errors.New("invalid application data")
+	ErrInvalidApplication       = errors.New("cannot apply to own listing")
+	ErrListingNotOpen           = errors.New("listing is not accepting applications")
+	ErrInvalidStatusTransition  = errors.New("application status transition is not allowed")
+	ErrApplicationStatusChanged = errors.New("application status has been changed")
+)
+
+// applicationTransitions задает допустимые переходы статусов заявки:
+// submitted → reviewing → approved → completed, отклонить можно любую незавершенную заявку
+var applicationTransitions = map[string][]string{
+	models.ApplicationStatusSubmitted: {models.ApplicationStatusReviewing, models.ApplicationStatusRejected},
+	models.ApplicationStatusReviewing: {models.ApplicationStatusApproved, models.ApplicationStatusRejected},
+	models.ApplicationStatusApproved:  {models.ApplicationStatusCompleted, models.ApplicationStatusRejected},
+}
+
+// AdoptionApplicationService представляет сервис заявок на пристройство котов
+type AdoptionApplicationService struct {
+	repo        repositories.AdoptionApplicationRepository
+	listingRepo repositories.ListingRepository
+	catRepo     repositories.CatRepository
+	userRepo    repositories.UserRepository
+	notifier    notifier.Notifier
+}
+
+// NewAdoptionApplicationService создает новый экземпляр сервиса заявок на пристройство
+func NewAdoptionApplicationService(
+	repo repositories.AdoptionApplicationRepository,
+	listingRepo repositories.ListingRepository,
+	catRepo repositories.CatRepository,
+	userRepo repositories.UserRepository,
+	notifier notifier.Notifier,
+) *AdoptionApplicationService {
+	return &AdoptionApplicationService{
+		repo:        repo,
+		listingRepo: listingRepo,
+		catRepo:     catRepo,
+		userRepo:    userRepo,
+		notifier:    notifier,
+	}
+}
+
+// Submit подает заявку на открытое объявление
+func (s *AdoptionApplicationService) Submit(listingID int, req *models.AdoptionApplicationCreateRequest, userID int) (*models.AdoptionApplicationResponse, error) {
+	listing, err := s.listingRepo.GetByID(listingID)
+	if err != nil || listing.Status == models.ListingStatusDraft {
+		return nil, ErrListingNotFound
+	}
+	if listing.Status != models.ListingStatusOpen {
+		return nil, ErrListingNotOpen
+	}
+
+	// Автор объявления и участники кота не могут подавать заявки на него
+	role, err := s.catRepo.GetUserRole(listing.CatID, userID)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, err
+	}
+	if listing.UserID == userID || role != "" {
+		return nil, ErrInvalidApplication
+	}
+
+	if len(req.Answers) == 0 {
+		return nil, ErrInvalidApplicationData
+	}
+	for question, answer := range req.Answers {
+		if strings.TrimSpace(question) == "" || strings.TrimSpace(answer) == "" {
+			return nil, ErrInvalidApplicationData
+		}
+	}
+	if req.Message != nil && strings.TrimSpace(*req.Message) == "" {
+		return nil, ErrInvalidApplicationData
+	}
+
+	if _, err := s.repo.GetByListingAndUser(listingID, userID); err == nil {
+		return nil, ErrApplicationExists
+	} else if err != sql.ErrNoRows {
+		return nil, err
+	}
+
+	application := &models.AdoptionApplication{
+		ListingID: listingID,
+		UserID:    userID,
+		Answers:   req.Answers,
+		Message:   req.Message,
+		Status:    models.ApplicationStatusSubmitted,
+	}
+
+	if err := s.repo.Create(application); err != nil {
+		return nil, err
+	}
+
+	created, err := s.repo.GetByID(application.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Уведомляем автора объявления о новой заявке
+	if lister, err := s.userRepo.GetByID(listing.UserID); err == nil {
+		s.notify(lister.ID, lister.Email, fmt.Sprintf("New application from %s for %s", created.Email, created.ListingTitle), created)
+	}
+
+	response := created.ToResponse()
+	return &response, nil
+}
+
+// GetApplication возвращает заявку соискателю, автору объявления или админу
+func (s *AdoptionApplicationService) GetApplication(id int, userID int, isAdmin bool) (*models.AdoptionApplicationResponse, error) {
+	application, err := s.repo.GetByID(id)
+	if err != nil {
+		return nil, ErrApplicationNotFound
+	}
+
+	// Чужие заявки не раскрываем, как будто их нет
+	if !isAdmin && application.UserID != userID && application.ListerID != userID {
+		return nil, ErrApplicationNotFound
+	}
+
+	response := application.ToResponse()
+	return &response, nil
+}
+
+// GetListingApplications возвращает заявки на объявление его автору или админу
+func (s *AdoptionApplicationService) GetListingApplications(listingID int, userID int, isAdmin bool) ([]models.AdoptionApplicationResponse, error) {
+	listing, err := s.listingRepo.GetByID(listingID)
+	if err != nil {
+		return nil, ErrListingNotFound
+	}
+
+	if !isAdmin && listing.UserID != userID {
+		return nil, ErrAccessDenied
+	}
+
+	applications, err := s.repo.GetByListingID(listingID)
+	if err != nil {
+		return nil, err
+	}
+
+	return applicationResponses(applications), nil
+}
+
+// GetUserApplications возвращает заявки, поданные пользователем
+func (s *AdoptionApplicationService) GetUserApplications(userID int) ([]models.AdoptionApplicationResponse, error) {
+	applications, err := s.repo.GetByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return applicationResponses(applications), nil
+}
+
+// ChangeStatus переводит заявку в новый статус по решению автора объявления или админа.
+// Одобрение бронирует кота, завершение передает кота соискателю и отклоняет остальные заявки
+func (s *AdoptionApplicationService) ChangeStatus(id int, req *models.AdoptionApplicationStatusRequest, userID int, isAdmin bool) (*models.AdoptionApplicationResponse, error) {
+	application, err := s.repo.GetByID(id)
+	if err != nil {
+		return nil, ErrApplicationNotFound
+	}
+
+	if !isAdmin && application.ListerID != userID {
+		if application.UserID == userID {
+			return nil, ErrAccessDenied
+		}
+		return nil, ErrApplicationNotFound
+	}
+
+	if !canTransitionApplication(application.Status, req.Status) {
+		return nil, ErrInvalidStatusTransition
+	}
+	if req.Note != nil && strings.TrimSpace(*req.Note) == "" {
+		return nil, ErrInvalidApplicationData
+	}
+
+	listing, err := s.listingRepo.GetByID(application.ListingID)
+	if err != nil {
+		return nil, ErrListingNotFound
+	}
+	if listing.Status == models.ListingStatusAdopted {
+		return nil, ErrListingClosed
+	}
+
+	var autoRejected []models.AdoptionApplication
+	if req.Status == models.ApplicationStatusCompleted {
+		autoRejected, err = s.complete(application, listing, req.Note, userID)
+	} else {
+		err = s.repo.UpdateStatus(id, application.Status, req.Status, req.Note)
+	}
+	if err == sql.ErrNoRows {
+		return nil, ErrApplicationStatusChanged
+	}
+	if errors.Is(err, repositories.ErrCatOwnerChanged) {
+		return nil, ErrCatOwnerChanged
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.syncListingStatus(listing, application.Status, req.Status); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.repo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	s.notify(updated.UserID, updated.Email, fmt.Sprintf("Your application for %s is %s", updated.ListingTitle, updated.Status), updated)
+	closedNote := models.ApplicationNoteListingClosed
+	for i := range autoRejected {
+		rejected := &autoRejected[i]
+		rejected.Status = models.ApplicationStatusRejected
+		rejected.StatusNote = &closedNote
+		s.notify(rejected.UserID, rejected.Email, fmt.Sprintf("Your application for %s is %s", rejected.ListingTitle, rejected.Status), rejected)
+	}
+
+	response := updated.ToResponse()
+	return &response, nil
+}
+
+// complete передает кота соискателю и возвращает заявки, отклоненные автоматически
+func (s *AdoptionApplicationService) complete(
+	application *models.AdoptionApplication,
+	listing *models.Listing,
+	note *string,
+	userID int,
+) ([]models.AdoptionApplication, error) {
+	cat, err := s.catRepo.GetByID(listing.CatID)
+	if err != nil {
+		return nil, ErrCatNotFound
+	}
+
+	// Запоминаем незавершенные заявки до транзакции, чтобы уведомить их авторов об отклонении
+	applications, err := s.repo.GetByListingID(listing.ID)
+	if err != nil {
+		return nil, err
+	}
+	var others []models.AdoptionApplication
+	for _, other := range applications {
+		if other.ID != application.ID && isActiveApplication(other.Status) {
+			others = append(others, other)
+		}
+	}
+
+	record := &models.OwnershipRecord{
+		CatID:      cat.ID,
+		FromUserID: &cat.UserID,
+		ToUserID:   application.UserID,
+		Reason:     models.OwnershipReasonAdoption,
+		ChangedBy:  userID,
+		Note:       note,
+		CreatedAt:  time.Now().UTC().Truncate(time.Second),
+	}
+
+	if err := s.repo.Complete(application, record, note); err != nil {
+		return nil, err
+	}
+
+	return others, nil
+}
+
+// syncListingStatus бронирует кота при одобрении заявки и снимает бронь,
+// если одобренная заявка отклонена и других одобренных заявок нет
+func (s *AdoptionApplicationService) syncListingStatus(listing *models.Listing, fromStatus string, toStatus string) error {
+	if toStatus == models.ApplicationStatusApproved && listing.Status == models.ListingStatusOpen {
+		status := models.ListingStatusReserved
+		return s.listingRepo.Update(listing.ID, &models.ListingUpdateRequest{Status: &status})
+	}
+
+	if fromStatus != models.ApplicationStatusApproved || toStatus != models.ApplicationStatusRejected ||
+		listing.Status != models.ListingStatusReserved {
+		return nil
+	}
+
+	applications, err := s.repo.GetByListingID(listing.ID)
+	if err != nil {
+		return err
+	}
+	for _, application := range applications {
+		if application.Status == models.ApplicationStatusApproved {
+			return nil
+		}
+	}
+
+	status := models.ListingStatusOpen
+	return s.listingRepo.Update(listing.ID, &models.ListingUpdateRequest{Status: &status})
+}
+
+// notify отправляет уведомление о заявке. Ошибка доставки не отменяет уже выполненную операцию
+func (s *AdoptionApplicationService) notify(userID int, email string, subject string, application *models.AdoptionApplication) {
+	body := fmt.Sprintf("Application for %s is %s", application.ListingTitle, application.Status)
+	if application.StatusNote != nil {
+		body += ": " + *application.StatusNote
+	}
+
+	notification := notifier.Notification{
+		UserID:  userID,
+		Email:   email,
+		Subject: subject,
+		Body:    body,
+		Data: map[string]string{
+			"application_id": strconv.Itoa(application.ID),
+			"listing_id":     strconv.Itoa(application.ListingID),
+			"status":         application.Status,
+		},
+	}
+
+	_ = s.notifier.Notify(context.Background(), notification)
+}
+
+// canTransitionApplication проверяет, допустим ли переход заявки между статусами
+func canTransitionApplication(from string, to string) bool {
+	for _, allowed := range applicationTransitions[from] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
+
+// isActiveApplication проверяет, что заявка еще не завершена и не отклонена
+func isActiveApplication(status string) bool {
+	_, ok := applicationTransitions[status]
+	return ok
+}
+
+// applicationResponses преобразует список заявок в ответы
+func applicationResponses(applications []models.AdoptionApplication) []models.AdoptionApplicationResponse {
+	var responses []models.AdoptionApplicationResponse
+	for _, application := range applications {
+		responses = append(responses, application.ToResponse())
+	}
+	return responses
+}
@@ -0,0 +1,187 @@
+package services
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"meawle/internal/models"
+	"meawle/internal/repositories"
+)
+
+var ErrInvalidCalendarToken = errors.New("invalid calendar token")
+
+// icsLineLimit - максимальная длина строки iCalendar в октетах (RFC 5545, раздел 3.1)
+const icsLineLimit = 75
+
+// CalendarService представляет сервис календаря ухода за котами в формате iCalendar
+type CalendarService struct {
+	userRepo     repositories.UserRepository
+	catRepo      repositories.CatRepository
+	reminderRepo repositories.ReminderRepository
+	healthRepo   repositories.HealthRecordRepository
+}
+
+// NewCalendarService создает новый экземпляр сервиса календаря
+func NewCalendarService(
+	userRepo repositories.UserRepository,
+	catRepo repositories.CatRepository,
+	reminderRepo repositories.ReminderRepository,
+	healthRepo repositories.HealthRecordRepository,
+) *CalendarService {
+	return &CalendarService{
+		userRepo:     userRepo,
+		catRepo:      catRepo,
+		reminderRepo: reminderRepo,
+		healthRepo:   healthRepo,
+	}
+}
+
+// RotateToken генерирует новый секретный токен календаря пользователя, отзывая предыдущий
+func (s *CalendarService) RotateToken(userID int) (string, error) {
+	if _, err := s.userRepo.GetByID(userID); err != nil {
+		return "", ErrUserNotFound
+	}
+
+	buf := make([]byte, 24)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	token := hex.EncodeToString(buf)
+
+	if err := s.userRepo.SetCalendarToken(userID, token); err != nil {
+		return "", err
+	}
+
+	return token, nil
+}
+
+// BuildFeed формирует iCalendar (RFC 5545) с напоминаниями и сроками процедур по всем котам пользователя
+func (s *CalendarService) BuildFeed(token string) (string, error) {
+	if token == "" {
+		return "", ErrInvalidCalendarToken
+	}
+
+	user, err := s.userRepo.GetByCalendarToken(token)
+	if err != nil {
+		return "", ErrInvalidCalendarToken
+	}
+
+	cats, err := s.catRepo.GetByUserID(user.ID)
+	if err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+	writeICSLine(&b, "BEGIN:VCALENDAR")
+	writeICSLine(&b, "VERSION:2.0")
+	writeICSLine(&b, "PRODID:-//Meawle//Cat Care Calendar//RU")
+	writeICSLine(&b, "CALSCALE:GREGORIAN")
+	writeICSLine(&b, "METHOD:PUBLISH")
+	writeICSLine(&b, "X-WR-CALNAME:"+escapeICSText("Meawle: уход за котами"))
+
+	for _, cat := range cats {
+		reminders, err := s.reminderRepo.GetByCatID(cat.ID)
+		if err != nil {
+			return "", err
+		}
+		for _, reminder := range reminders {
+			if reminder.IsActive {
+				writeReminderEvent(&b, &cat, &reminder)
+			}
+		}
+
+		records, err := s.healthRepo.GetByCatID(cat.ID, nil, false)
+		if err != nil {
+			return "", err
+		}
+		for _, record := range records {
+			if record.DueDate != nil {
+				writeHealthDueEvent(&b, &cat, &record)
+			}
+		}
+	}
+
+	writeICSLine(&b, "END:VCALENDAR")
+	return b.String(), nil
+}
+
+// writeReminderEvent записывает напоминание как событие, повторяющееся по его RRULE
+func writeReminderEvent(b *strings.Builder, cat *models.Cat, reminder *models.Reminder) {
+	writeICSLine(b, "BEGIN:VEVENT")
+	writeICSLine(b, fmt.Sprintf("UID:reminder-%d@meawle", reminder.ID))
+	writeICSLine(b, "DTSTAMP:"+formatICSDateTime(reminder.CreatedAt))
+	writeICSLine(b, "DTSTART:"+formatICSDateTime(reminder.StartsAt))
+	writeICSLine(b, "DURATION:PT30M")
+	if reminder.RRule != nil {
+		writeICSLine(b, "RRULE:"+*reminder.RRule)
+	}
+	writeICSLine(b, "SUMMARY:"+escapeICSText(cat.Name+": "+reminder.Title))
+	if reminder.Notes != nil {
+		writeICSLine(b, "DESCRIPTION:"+escapeICSText(*reminder.Notes))
+	}
+	writeICSLine(b, "CATEGORIES:"+strings.ToUpper(reminder.ReminderType))
+	writeICSLine(b, "BEGIN:VALARM")
+	writeICSLine(b, "ACTION:DISPLAY")
+	writeICSLine(b, "DESCRIPTION:"+escapeICSText(reminder.Title))
+	writeICSLine(b, "TRIGGER:-PT15M")
+	writeICSLine(b, "END:VALARM")
+	writeICSLine(b, "END:VEVENT")
+}
+
+// writeHealthDueEvent записывает срок медицинской процедуры как событие на весь день
+func writeHealthDueEvent(b *strings.Builder, cat *models.Cat, record *models.HealthRecord) {
+	due, err := time.Parse("2006-01-02", *record.DueDate)
+	if err != nil {
+		return
+	}
+
+	writeICSLine(b, "BEGIN:VEVENT")
+	writeICSLine(b, fmt.Sprintf("UID:health-%d@meawle", record.ID))
+	writeICSLine(b, "DTSTAMP:"+formatICSDateTime(record.CreatedAt))
+	writeICSLine(b, "DTSTART;VALUE=DATE:"+due.Format("20060102"))
+	writeICSLine(b, "DTEND;VALUE=DATE:"+due.AddDate(0, 0, 1).Format("20060102"))
+	writeICSLine(b, "SUMMARY:"+escapeICSText(cat.Name+": "+record.Title))
+	if record.Notes != nil {
+		writeICSLine(b, "DESCRIPTION:"+escapeICSText(*record.Notes))
+	}
+	if record.VetName != nil {
+		writeICSLine(b, "LOCATION:"+escapeICSText(*record.VetName))
+	}
+	writeICSLine(b, "CATEGORIES:"+strings.ToUpper(record.RecordType))
+	writeICSLine(b, "TRANSP:TRANSPARENT")
+	writeICSLine(b, "END:VEVENT")
+}
+
+// formatICSDateTime форматирует время в UTC по RFC 5545
+func formatICSDateTime(t time.Time) string {
+	return t.UTC().Format("20060102T150405Z")
+}
+
+// escapeICSText экранирует специальные символы текстового значения iCalendar
+func escapeICSText(value string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`)
+	return replacer.Replace(value)
+}
+
+// writeICSLine записывает строку с CRLF, перенося ее по 75 октетов без разрыва UTF-8 символов
+func writeICSLine(b *strings.Builder, line string) {
+	limit := icsLineLimit
+	for len(line) > limit {
+		cut := limit
+		// Не разрываем многобайтовый символ
+		for cut > 0 && (line[cut]&0xC0) == 0x80 {
+			cut--
+		}
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+		line = line[cut:]
+		// Продолжение строки начинается с пробела, который входит в лимит
+		limit = icsLineLimit - 1
+	}
+	b.WriteString(line)
+	b.WriteString("\r\n")
+}
@@ -17,13 +17,27 @@ var (
 
 // CatBreedService представляет сервис для работы с породами кошек
 type CatBreedService struct {
-	repo repositories.CatBreedRepository
+	repo         repositories.CatBreedRepository
+	orgRepo      repositories.OrganizationRepository
+	likeRepo     repositories.LikeRepository
+	activityRepo repositories.ActivityRepository
+	textFilter   *TextFilterService
 }
 
 // NewCatBreedService создает новый экземпляр сервиса пород кошек
-func NewCatBreedService(repo repositories.CatBreedRepository) *CatBreedService {
+func NewCatBreedService(
+	repo repositories.CatBreedRepository,
+	orgRepo repositories.OrganizationRepository,
+	likeRepo repositories.LikeRepository,
+	activityRepo repositories.ActivityRepository,
+	textFilter *TextFilterService,
+) *CatBreedService {
 	return &CatBreedService{
-		repo: repo,
+		repo:         repo,
+		orgRepo:      orgRepo,
+		likeRepo:     likeRepo,
+		activityRepo: activityRepo,
+		textFilter:   textFilter,
 	}
 }
 
@@ -34,6 +48,12 @@ func (s *CatBreedService) Create(req *models.CatBreedCreateRequest, userID int)
 		return nil, ErrInvalidCatBreedData
 	}
 
+	// Проверяем название и описание фильтром текста до проверки уникальности, так как маскирование меняет название
+	flags, err := s.textFilter.Apply(&req.Name, &req.Description)
+	if err != nil {
+		return nil, err
+	}
+
 	// Проверяем существование названия
 	exists, err := s.repo.ExistsByName(req.Name)
 	if err != nil {
@@ -43,12 +63,20 @@ func (s *CatBreedService) Create(req *models.CatBreedCreateRequest, userID int)
 		return nil, ErrCatBreedNameExists
 	}
 
+	// Добавить породу от имени организации может только ее сотрудник
+	if req.OrganizationID != nil {
+		if err := requireOrganizationRole(s.orgRepo, *req.OrganizationID, userID, false, models.OrganizationRoleStaff); err != nil {
+			return nil, err
+		}
+	}
+
 	// Создаем породу
 	breed := &models.CatBreed{
-		Name:        req.Name,
-		Description: req.Description,
-		UserID:      userID,
-		CreatedAt:   time.Now(),
+		Name:           req.Name,
+		Description:    req.Description,
+		UserID:         userID,
+		OrganizationID: req.OrganizationID,
+		CreatedAt:      time.Now(),
 	}
 
 	// Проверяем дату создания (не должна быть раньше 2000 года)
@@ -61,36 +89,158 @@ func (s *CatBreedService) Create(req *models.CatBreedCreateRequest, userID int)
 		return nil, err
 	}
 
+	s.textFilter.Flag(models.ReportTargetBreed, breed.ID, flags)
+	recordActivity(s.activityRepo, userID, models.ActivityBreedCreated, models.ActivityTargetBreed, breed.ID)
+
 	response := breed.ToResponse()
+	response.IsOwner = true
 	return &response, nil
 }
 
-// GetCatBreedByID возвращает породу кошек по ID
-func (s *CatBreedService) GetCatBreedByID(id int) (*models.CatBreedResponse, error) {
+// GetCatBreedByID возвращает породу кошек по ID. userID равен 0 для анонимного запроса
+func (s *CatBreedService) GetCatBreedByID(id int, userID int) (*models.CatBreedResponse, error) {
 	breed, err := s.repo.GetByID(id)
 	if err != nil {
 		return nil, ErrCatBreedNotFound
 	}
 
 	response := breed.ToResponse()
-	return &response, nil
+	if userID != 0 {
+		response.IsOwner, err = s.canManage(breed, userID, false)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Скрытую модератором породу видят только те, кто ею управляет
+	if breed.HiddenAt != nil && !response.IsOwner {
+		return nil, ErrCatBreedNotFound
+	}
+
+	responses := []models.CatBreedResponse{response}
+	if err := s.applyLikes(responses, userID); err != nil {
+		return nil, err
+	}
+
+	return &responses[0], nil
+}
+
+// GetAllCatBreeds возвращает все породы кошек или только породы организации, если указан organizationID.
+// userID равен 0 для анонимного запроса
+func (s *CatBreedService) GetAllCatBreeds(organizationID *int, userID int) ([]models.CatBreedResponse, error) {
+	var breeds []models.CatBreed
+	var err error
+	if organizationID != nil {
+		breeds, err = s.repo.GetByOrganizationID(*organizationID)
+	} else {
+		breeds, err = s.repo.GetAll()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return s.breedResponses(breeds, userID)
 }
 
-// GetAllCatBreeds возвращает все породы кошек
-func (s *CatBreedService) GetAllCatBreeds() ([]models.CatBreedResponse, error) {
-	breeds, err := s.repo.GetAll()
+// GetFavoriteBreeds возвращает породы кошек, отмеченные пользователем
+func (s *CatBreedService) GetFavoriteBreeds(userID int) ([]models.CatBreedResponse, error) {
+	breeds, err := s.repo.GetLikedByUserID(userID)
 	if err != nil {
 		return nil, err
 	}
 
+	return s.breedResponses(breeds, userID)
+}
+
+// LikeBreed отмечает породу и возвращает обновленное число отметок
+func (s *CatBreedService) LikeBreed(id int, userID int) (*models.LikeSummary, error) {
+	if _, err := s.repo.GetByID(id); err != nil {
+		return nil, ErrCatBreedNotFound
+	}
+
+	if err := s.likeRepo.LikeBreed(id, userID); err != nil {
+		return nil, err
+	}
+
+	return s.getLikeSummary(id, userID)
+}
+
+// UnlikeBreed снимает отметку с породы и возвращает обновленное число отметок
+func (s *CatBreedService) UnlikeBreed(id int, userID int) (*models.LikeSummary, error) {
+	if _, err := s.repo.GetByID(id); err != nil {
+		return nil, ErrCatBreedNotFound
+	}
+
+	if err := s.likeRepo.UnlikeBreed(id, userID); err != nil {
+		return nil, err
+	}
+
+	return s.getLikeSummary(id, userID)
+}
+
+// getLikeSummary возвращает число отметок породы и отметку пользователя
+func (s *CatBreedService) getLikeSummary(id int, userID int) (*models.LikeSummary, error) {
+	likes, err := s.likeRepo.GetBreedLikes([]int{id}, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := likes[id]
+	return &summary, nil
+}
+
+// breedResponses преобразует список пород в ответы с учетом текущего пользователя.
+// userID равен 0 для анонимного запроса
+func (s *CatBreedService) breedResponses(breeds []models.CatBreed, userID int) ([]models.CatBreedResponse, error) {
+	var err error
+
+	// Роли в организациях загружаем одним запросом, а не для каждой породы
+	orgRoles := map[int]string{}
+	if userID != 0 {
+		orgRoles, err = s.orgRepo.GetUserRoles(userID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	var responses []models.CatBreedResponse
 	for _, breed := range breeds {
-		responses = append(responses, breed.ToResponse())
+		response := breed.ToResponse()
+		response.IsOwner = userID != 0 && breed.UserID == userID
+		if breed.OrganizationID != nil && orgRoles[*breed.OrganizationID] != "" {
+			response.IsOwner = true
+		}
+		responses = append(responses, response)
+	}
+
+	if err := s.applyLikes(responses, userID); err != nil {
+		return nil, err
 	}
 
 	return responses, nil
 }
 
+// applyLikes дополняет ответы числом отметок "нравится" и отметкой текущего пользователя
+func (s *CatBreedService) applyLikes(responses []models.CatBreedResponse, userID int) error {
+	ids := make([]int, 0, len(responses))
+	for _, response := range responses {
+		ids = append(ids, response.ID)
+	}
+
+	likes, err := s.likeRepo.GetBreedLikes(ids, userID)
+	if err != nil {
+		return err
+	}
+
+	for i := range responses {
+		summary := likes[responses[i].ID]
+		responses[i].LikeCount = summary.LikeCount
+		responses[i].LikedByMe = summary.LikedByMe
+	}
+
+	return nil
+}
+
 // UpdateCatBreed обновляет данные породы кошек
 func (s *CatBreedService) UpdateCatBreed(id int, req *models.CatBreedUpdateRequest, userID int, isAdmin bool) error {
 	// Проверяем существование породы
@@ -99,11 +249,28 @@ func (s *CatBreedService) UpdateCatBreed(id int, req *models.CatBreedUpdateReque
 		return ErrCatBreedNotFound
 	}
 
-	// Проверяем права доступа: пользователь может обновлять только свои породы, админ - любые
-	if !isAdmin && breed.UserID != userID {
+	// Проверяем права доступа: пользователь может обновлять свои породы и породы своей организации, админ - любые
+	canManage, err := s.canManage(breed, userID, isAdmin)
+	if err != nil {
+		return err
+	}
+	if !canManage {
 		return ErrAccessDenied
 	}
 
+	// Передать породу можно только в организацию, где пользователь сотрудник
+	if req.OrganizationID != nil && *req.OrganizationID != 0 {
+		if err := requireOrganizationRole(s.orgRepo, *req.OrganizationID, userID, isAdmin, models.OrganizationRoleStaff); err != nil {
+			return err
+		}
+	}
+
+	// Проверяем название и описание фильтром текста до проверки уникальности
+	flags, err := s.textFilter.Apply(req.Name, req.Description)
+	if err != nil {
+		return err
+	}
+
 	// Если обновляется название, проверяем его уникальность
 	if req.Name != nil {
 		exists, err := s.repo.ExistsByName(*req.Name)
@@ -115,7 +282,12 @@ func (s *CatBreedService) UpdateCatBreed(id int, req *models.CatBreedUpdateReque
 		}
 	}
 
-	return s.repo.Update(id, req)
+	if err := s.repo.Update(id, req); err != nil {
+		return err
+	}
+
+	s.textFilter.Flag(models.ReportTargetBreed, id, flags)
+	return nil
 }
 
 // DeleteCatBreed удаляет породу кошек
@@ -126,10 +298,36 @@ func (s *CatBreedService) DeleteCatBreed(id int, userID int, isAdmin bool) error
 		return ErrCatBreedNotFound
 	}
 
-	// Проверяем права доступа: пользователь может удалять только свои породы, админ - любые
-	if !isAdmin && breed.UserID != userID {
+	// Проверяем права доступа: пользователь может удалять свои породы и породы своей организации, админ - любые
+	canManage, err := s.canManage(breed, userID, isAdmin)
+	if err != nil {
+		return err
+	}
+	if !canManage {
 		return ErrAccessDenied
 	}
 
 	return s.repo.Delete(id)
 }
+
+// canManage проверяет, может ли пользователь изменять породу
+func (s *CatBreedService) canManage(breed *models.CatBreed, userID int, isAdmin bool) (bool, error) {
+	return canManageBreed(s.orgRepo, breed, userID, isAdmin)
+}
+
+// canManageBreed проверяет, может ли пользователь изменять породу: автор, сотрудник организации породы или админ
+func canManageBreed(orgRepo repositories.OrganizationRepository, breed *models.CatBreed, userID int, isAdmin bool) (bool, error) {
+	if isAdmin || breed.UserID == userID {
+		return true, nil
+	}
+	if breed.OrganizationID == nil {
+		return false, nil
+	}
+
+	role, err := orgRepo.GetMemberRole(*breed.OrganizationID, userID)
+	if err != nil {
+		return false, err
+	}
+
+	return role != "", nil
+}
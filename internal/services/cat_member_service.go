@@ -0,0 +1,261 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"meawle/internal/models"
+	"meawle/internal/notifier"
+	"meawle/internal/repositories"
+)
+
+var (
+	ErrCatMemberNotFound  = errors.New("cat member not found")
+	ErrCatMemberExists    = errors.New("user is already a member of the cat")
+	ErrInvalidCatRole     = errors.New("cat role must be owner, caretaker or viewer")
+	ErrInvitationNotFound = errors.New("invitation not found")
+	ErrInvalidCatMember   = errors.New("cat owner cannot be invited as a member")
+	ErrInviteeNotFound    = errors.New("invitee not found")
+)
+
+// CatMemberService представляет сервис совместного доступа к котам
+type CatMemberService struct {
+	repo     repositories.CatMemberRepository
+	catRepo  repositories.CatRepository
+	userRepo repositories.UserRepository
+	notifier notifier.Notifier
+}
+
+// NewCatMemberService создает новый экземпляр сервиса участников котов
+func NewCatMemberService(
+	repo repositories.CatMemberRepository,
+	catRepo repositories.CatRepository,
+	userRepo repositories.UserRepository,
+	notifier notifier.Notifier,
+) *CatMemberService {
+	return &CatMemberService{
+		repo:     repo,
+		catRepo:  catRepo,
+		userRepo: userRepo,
+		notifier: notifier,
+	}
+}
+
+// Invite приглашает пользователя с указанным email участвовать в уходе за котом.
+// Если аккаунта с этим email нет, приглашение сохраняется по email и ждет регистрации,
+// поэтому ответ не раскрывает, зарегистрирован ли email
+func (s *CatMemberService) Invite(catID int, req *models.CatMemberInviteRequest, userID int, isAdmin bool) (*models.CatMemberResponse, error) {
+	// Приглашать могут основной владелец, совладельцы и админ
+	if err := requireCatRole(s.catRepo, catID, userID, isAdmin, models.CatRoleOwner); err != nil {
+		return nil, err
+	}
+
+	if !isValidCatRole(req.Role) {
+		return nil, ErrInvalidCatRole
+	}
+
+	cat, err := s.catRepo.GetByID(catID)
+	if err != nil {
+		return nil, ErrCatNotFound
+	}
+
+	email := strings.TrimSpace(req.Email)
+	member := &models.CatMember{
+		CatID:     catID,
+		Email:     email,
+		Role:      req.Role,
+		Status:    models.CatMemberStatusPending,
+		InvitedBy: userID,
+	}
+
+	invitee, err := s.userRepo.GetByEmail(email)
+	if err == nil {
+		if invitee.ID == cat.UserID {
+			return nil, ErrInvalidCatMember
+		}
+		member.UserID = invitee.ID
+	} else if err != sql.ErrNoRows {
+		return nil, err
+	}
+
+	if _, err := s.repo.GetByCatAndEmail(catID, email); err == nil {
+		return nil, ErrCatMemberExists
+	} else if err != sql.ErrNoRows {
+		return nil, err
+	}
+
+	if err := s.repo.Create(member); err != nil {
+		return nil, err
+	}
+
+	created, err := s.repo.GetByID(member.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.notify(created, fmt.Sprintf("You are invited to care for %s as %s", created.CatName, created.Role))
+
+	response := created.ToResponse()
+	return &response, nil
+}
+
+// GetMembers возвращает участников кота и неподтвержденные приглашения
+func (s *CatMemberService) GetMembers(catID int, userID int, isAdmin bool) ([]models.CatMemberResponse, error) {
+	if err := requireCatRole(s.catRepo, catID, userID, isAdmin, models.CatRoleViewer); err != nil {
+		return nil, err
+	}
+
+	members, err := s.repo.GetByCatID(catID)
+	if err != nil {
+		return nil, err
+	}
+
+	return catMemberResponses(members), nil
+}
+
+// GetInvitations возвращает приглашения, ожидающие ответа пользователя
+func (s *CatMemberService) GetInvitations(userID int) ([]models.CatMemberResponse, error) {
+	members, err := s.repo.GetPendingByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return catMemberResponses(members), nil
+}
+
+// AcceptInvitation принимает приглашение пользователя
+func (s *CatMemberService) AcceptInvitation(id int, userID int) (*models.CatMemberResponse, error) {
+	member, err := s.getInvitation(id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Accept(member.ID, userID); err != nil {
+		return nil, err
+	}
+
+	accepted, err := s.repo.GetByID(member.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := accepted.ToResponse()
+	return &response, nil
+}
+
+// DeclineInvitation отклоняет приглашение пользователя
+func (s *CatMemberService) DeclineInvitation(id int, userID int) error {
+	member, err := s.getInvitation(id, userID)
+	if err != nil {
+		return err
+	}
+
+	return s.repo.Delete(member.ID)
+}
+
+// UpdateRole изменяет роль участника кота
+func (s *CatMemberService) UpdateRole(catID int, memberID int, req *models.CatMemberUpdateRequest, userID int, isAdmin bool) error {
+	if err := requireCatRole(s.catRepo, catID, userID, isAdmin, models.CatRoleOwner); err != nil {
+		return err
+	}
+
+	if !isValidCatRole(req.Role) {
+		return ErrInvalidCatRole
+	}
+
+	if _, err := s.getCatMember(catID, memberID); err != nil {
+		return err
+	}
+
+	return s.repo.UpdateRole(memberID, req.Role)
+}
+
+// RemoveMember удаляет участника кота. Участник может выйти сам
+func (s *CatMemberService) RemoveMember(catID int, memberID int, userID int, isAdmin bool) error {
+	member, err := s.getCatMember(catID, memberID)
+	if err != nil {
+		return err
+	}
+
+	if member.UserID != userID {
+		if err := requireCatRole(s.catRepo, catID, userID, isAdmin, models.CatRoleOwner); err != nil {
+			return err
+		}
+	}
+
+	return s.repo.Delete(memberID)
+}
+
+// getCatMember возвращает участника, убедившись, что он относится к указанному коту
+func (s *CatMemberService) getCatMember(catID int, memberID int) (*models.CatMember, error) {
+	if _, err := s.catRepo.GetByID(catID); err != nil {
+		return nil, ErrCatNotFound
+	}
+
+	member, err := s.repo.GetByID(memberID)
+	if err != nil || member.CatID != catID {
+		return nil, ErrCatMemberNotFound
+	}
+
+	return member, nil
+}
+
+// getInvitation возвращает неподтвержденное приглашение, адресованное пользователю
+func (s *CatMemberService) getInvitation(id int, userID int) (*models.CatMember, error) {
+	member, err := s.repo.GetByID(id)
+	if err != nil || member.Status != models.CatMemberStatusPending {
+		return nil, ErrInvitationNotFound
+	}
+
+	// Чужие приглашения не раскрываем, как будто их нет
+	if member.UserID == userID {
+		return member, nil
+	}
+	if member.UserID != 0 {
+		return nil, ErrInvitationNotFound
+	}
+
+	// Приглашение по email, отправленное до регистрации пользователя
+	user, err := s.userRepo.GetByID(userID)
+	if err != nil || user.Email != member.Email {
+		return nil, ErrInvitationNotFound
+	}
+
+	return member, nil
+}
+
+// notify отправляет уведомление о приглашении. Ошибка доставки не отменяет приглашение
+func (s *CatMemberService) notify(member *models.CatMember, subject string) {
+	notification := notifier.Notification{
+		UserID:  member.UserID,
+		Email:   member.Email,
+		Subject: subject,
+		Body:    fmt.Sprintf("Accept or decline the invitation to %s in your invitations list", member.CatName),
+		Data: map[string]string{
+			"member_id": strconv.Itoa(member.ID),
+			"cat_id":    strconv.Itoa(member.CatID),
+			"role":      member.Role,
+		},
+	}
+
+	_ = s.notifier.Notify(context.Background(), notification)
+}
+
+// isValidCatRole проверяет роль участника кота
+func isValidCatRole(role string) bool {
+	_, ok := models.CatRoleRanks[role]
+	return ok
+}
+
+// catMemberResponses преобразует список участников в ответы
+func catMemberResponses(members []models.CatMember) []models.CatMemberResponse {
+	var responses []models.CatMemberResponse
+	for _, member := range members {
+		responses = append(responses, member.ToResponse())
+	}
+	return responses
+}
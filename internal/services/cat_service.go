@@ -1,7 +1,9 @@
 package services
 
 import (
+	"database/sql"
 	"errors"
+	"strings"
 	"time"
 
 	"meawle/internal/models"
@@ -9,20 +11,49 @@ import (
 )
 
 var (
-	ErrCatNotFound     = errors.New("cat not found")
-	ErrInvalidCatData  = errors.New("invalid cat data")
-	ErrInvalidCatAge   = errors.New("cat age must be between 0 and 30 years")
+	ErrCatNotFound         = errors.New("cat not found")
+	ErrInvalidCatData      = errors.New("invalid cat data")
+	ErrInvalidCatBirthDate = errors.New("cat birth date must be YYYY, YYYY-MM or YYYY-MM-DD, not in the future and within 30 years")
+	ErrInvalidCatSex       = errors.New("cat sex must be male or female")
+	ErrInvalidCoatPattern  = errors.New("invalid cat coat pattern")
+	ErrInvalidCatWeight    = errors.New("cat weight must be greater than 0 and not more than 30 kg")
+	ErrInvalidMicrochip    = errors.New("microchip number must be 15 digits according to ISO 11784")
+	ErrMicrochipExists     = errors.New("microchip number already registered")
+	ErrInvalidNeuteredData = errors.New("neutered date requires neutered flag and must be YYYY-MM-DD not in the future")
+	ErrParentNotFound      = errors.New("parent not found")
+	ErrInvalidParent       = errors.New("parent must be either a registered cat or an external ancestor")
+	ErrInvalidParentSex    = errors.New("sire must be male and dam must be female")
+	ErrPedigreeCycle       = errors.New("cat cannot be its own ancestor")
+	ErrInvalidVisibility   = errors.New("cat visibility must be public, unlisted or private")
+	ErrInvalidCatSort      = errors.New("cat sort must be newest or popular")
 )
 
 // CatService представляет сервис для работы с котами
 type CatService struct {
-	repo repositories.CatRepository
+	repo         repositories.CatRepository
+	pedigreeRepo repositories.PedigreeRepository
+	orgRepo      repositories.OrganizationRepository
+	likeRepo     repositories.LikeRepository
+	activityRepo repositories.ActivityRepository
+	textFilter   *TextFilterService
 }
 
 // NewCatService создает новый экземпляр сервиса котов
-func NewCatService(repo repositories.CatRepository) *CatService {
+func NewCatService(
+	repo repositories.CatRepository,
+	pedigreeRepo repositories.PedigreeRepository,
+	orgRepo repositories.OrganizationRepository,
+	likeRepo repositories.LikeRepository,
+	activityRepo repositories.ActivityRepository,
+	textFilter *TextFilterService,
+) *CatService {
 	return &CatService{
-		repo: repo,
+		repo:         repo,
+		pedigreeRepo: pedigreeRepo,
+		orgRepo:      orgRepo,
+		likeRepo:     likeRepo,
+		activityRepo: activityRepo,
+		textFilter:   textFilter,
 	}
 }
 
@@ -33,55 +64,201 @@ func (s *CatService) Create(req *models.CatCreateRequest, userID int) (*models.C
 		return nil, ErrInvalidCatData
 	}
 
-	// Проверяем возраст кота
-	if req.Age != nil && (*req.Age < 0 || *req.Age > 30) {
-		return nil, ErrInvalidCatAge
+	// Проверяем дату рождения кота
+	if req.BirthDate != nil && !isValidCatBirthDate(*req.BirthDate) {
+		return nil, ErrInvalidCatBirthDate
+	}
+
+	// Проверяем поля расширенного профиля
+	if err := validateCatProfile(req.Sex, req.Color, req.CoatPattern, req.EyeColor, req.WeightKg); err != nil {
+		return nil, err
+	}
+
+	// Проверяем данные о стерилизации
+	if req.NeuteredAt != nil && (!req.IsNeutered || !isValidNeuteredDate(*req.NeuteredAt)) {
+		return nil, ErrInvalidNeuteredData
+	}
+
+	// Проверяем номер микрочипа и его уникальность
+	if req.MicrochipNumber != nil {
+		if err := s.checkMicrochip(*req.MicrochipNumber, 0); err != nil {
+			return nil, err
+		}
+	}
+
+	// Проверяем ссылки на родителей. У нового кота нет потомков, поэтому цикл невозможен
+	if err := checkParents(s.repo, s.pedigreeRepo, 0, userID, false, req.SireID, req.DamID, req.SireExternalID, req.DamExternalID); err != nil {
+		return nil, err
+	}
+
+	// Добавить кота в организацию может только ее сотрудник
+	if req.OrganizationID != nil {
+		if err := requireOrganizationRole(s.orgRepo, *req.OrganizationID, userID, false, models.OrganizationRoleStaff); err != nil {
+			return nil, err
+		}
+	}
+
+	// Новый кот по умолчанию виден в каталоге
+	visibility := models.CatVisibilityPublic
+	if req.Visibility != nil {
+		if !isValidCatVisibility(*req.Visibility) {
+			return nil, ErrInvalidVisibility
+		}
+		visibility = *req.Visibility
+	}
+
+	// Проверяем имя и описание фильтром текста
+	flags, err := s.textFilter.Apply(&req.Name, req.Description)
+	if err != nil {
+		return nil, err
 	}
 
 	// Создаем кота
 	cat := &models.Cat{
-		Name:        req.Name,
-		Age:         req.Age,
-		Description: req.Description,
-		UserID:      userID,
-		CreatedAt:   time.Now(),
+		Name:            req.Name,
+		BirthDate:       req.BirthDate,
+		Description:     req.Description,
+		Sex:             req.Sex,
+		Color:           req.Color,
+		CoatPattern:     req.CoatPattern,
+		EyeColor:        req.EyeColor,
+		WeightKg:        req.WeightKg,
+		MicrochipNumber: req.MicrochipNumber,
+		IsNeutered:      req.IsNeutered,
+		NeuteredAt:      req.NeuteredAt,
+		SireID:          req.SireID,
+		DamID:           req.DamID,
+		SireExternalID:  req.SireExternalID,
+		DamExternalID:   req.DamExternalID,
+		Visibility:      visibility,
+		UserID:          userID,
+		OrganizationID:  req.OrganizationID,
+		CreatedAt:       time.Now(),
 	}
 
-	err := s.repo.Create(cat)
+	err = s.repo.Create(cat)
 	if err != nil {
 		return nil, err
 	}
 
+	s.textFilter.Flag(models.ReportTargetCat, cat.ID, flags)
+	recordActivity(s.activityRepo, userID, models.ActivityCatCreated, models.ActivityTargetCat, cat.ID)
+
 	response := cat.ToResponse()
+	response.MicrochipNumber = cat.MicrochipNumber
 	return &response, nil
 }
 
-// GetCatByID возвращает кота по ID
-func (s *CatService) GetCatByID(id int) (*models.CatResponse, error) {
+// GetCatByID возвращает кота по ID. Скрытого кота видят только владелец, участники, сотрудники организации и админ,
+// для остальных он не существует. userID равен 0 для анонимного запроса
+func (s *CatService) GetCatByID(id int, userID int, isAdmin bool) (*models.CatResponse, error) {
 	cat, err := s.repo.GetByID(id)
 	if err != nil {
 		return nil, ErrCatNotFound
 	}
 
+	canView, err := canViewCat(s.repo, cat, userID, isAdmin)
+	if err != nil {
+		return nil, err
+	}
+	if !canView {
+		return nil, ErrCatNotFound
+	}
+
 	response := cat.ToResponse()
-	return &response, nil
+
+	// Для авторизованного пользователя указываем его роль
+	if userID != 0 {
+		role, err := s.repo.GetUserRole(id, userID)
+		if err != nil {
+			return nil, err
+		}
+		if role != "" {
+			response.MyRole = &role
+		}
+	}
+	if response.MyRole != nil || isAdmin {
+		response.MicrochipNumber = cat.MicrochipNumber
+	}
+
+	responses := []models.CatResponse{response}
+	if err := s.applyLikes(responses, userID); err != nil {
+		return nil, err
+	}
+
+	return &responses[0], nil
 }
 
-// GetAllCats возвращает всех котов
-func (s *CatService) GetAllCats() ([]models.CatResponse, error) {
-	cats, err := s.repo.GetAll()
+// GetAllCats возвращает котов каталога, удовлетворяющих фильтру.
+// Публичных котов видят все, скрытые - только их владельцы, участники и сотрудники организации кота,
+// админ видит всех
+func (s *CatService) GetAllCats(filter *models.CatFilter, userID int, isAdmin bool) ([]models.CatResponse, error) {
+	if filter == nil {
+		filter = &models.CatFilter{}
+	}
+
+	// Проверяем значения фильтра, чтобы не выполнять заведомо пустой запрос
+	if filter.Sex != nil && !isValidCatSex(*filter.Sex) {
+		return nil, ErrInvalidCatSex
+	}
+	if filter.CoatPattern != nil && !isValidCoatPattern(*filter.CoatPattern) {
+		return nil, ErrInvalidCoatPattern
+	}
+	if filter.Sort != "" && filter.Sort != models.CatSortNewest && filter.Sort != models.CatSortPopular {
+		return nil, ErrInvalidCatSort
+	}
+
+	filter.ViewerID = userID
+	filter.IncludeHidden = isAdmin
+
+	cats, err := s.repo.GetAll(filter)
 	if err != nil {
 		return nil, err
 	}
 
+	// Роли загружаем одним запросом, а не для каждого кота
+	roles := map[int]string{}
+	if userID != 0 {
+		roles, err = s.repo.GetUserRoles(userID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	var responses []models.CatResponse
 	for _, cat := range cats {
-		responses = append(responses, cat.ToResponse())
+		response := cat.ToResponse()
+		if role, ok := roles[cat.ID]; ok {
+			response.MyRole = &role
+		}
+		if response.MyRole != nil || isAdmin {
+			response.MicrochipNumber = cat.MicrochipNumber
+		}
+		responses = append(responses, response)
+	}
+
+	if err := s.applyLikes(responses, userID); err != nil {
+		return nil, err
 	}
 
 	return responses, nil
 }
 
+// GetOrganizationCats возвращает котов организации с учетом фильтра и видимости.
+// Сотрудники организации видят и ее скрытых котов
+func (s *CatService) GetOrganizationCats(organizationID int, filter *models.CatFilter, userID int, isAdmin bool) ([]models.CatResponse, error) {
+	if _, err := s.orgRepo.GetByID(organizationID); err != nil {
+		return nil, ErrOrganizationNotFound
+	}
+
+	if filter == nil {
+		filter = &models.CatFilter{}
+	}
+	filter.OrganizationID = &organizationID
+
+	return s.GetAllCats(filter, userID, isAdmin)
+}
+
 // GetUserCats возвращает котов текущего пользователя
 func (s *CatService) GetUserCats(userID int) ([]models.CatResponse, error) {
 	cats, err := s.repo.GetByUserID(userID)
@@ -91,7 +268,13 @@ func (s *CatService) GetUserCats(userID int) ([]models.CatResponse, error) {
 
 	var responses []models.CatResponse
 	for _, cat := range cats {
-		responses = append(responses, cat.ToResponse())
+		response := cat.ToResponse()
+		response.MicrochipNumber = cat.MicrochipNumber
+		responses = append(responses, response)
+	}
+
+	if err := s.applyLikes(responses, userID); err != nil {
+		return nil, err
 	}
 
 	return responses, nil
@@ -105,17 +288,67 @@ func (s *CatService) UpdateCat(id int, req *models.CatUpdateRequest, userID int,
 		return ErrCatNotFound
 	}
 
-	// Проверяем права доступа: пользователь может обновлять только своих котов, админ - любых
-	if !isAdmin && cat.UserID != userID {
-		return ErrAccessDenied
+	// Проверяем права доступа: профиль обновляют владелец, совладельцы и админ
+	if err := requireCatRole(s.repo, id, userID, isAdmin, models.CatRoleOwner); err != nil {
+		return err
+	}
+
+	// Проверяем дату рождения кота
+	if req.BirthDate != nil && !isValidCatBirthDate(*req.BirthDate) {
+		return ErrInvalidCatBirthDate
 	}
 
-	// Проверяем возраст кота
-	if req.Age != nil && (*req.Age < 0 || *req.Age > 30) {
-		return ErrInvalidCatAge
+	if req.Visibility != nil && !isValidCatVisibility(*req.Visibility) {
+		return ErrInvalidVisibility
 	}
 
-	return s.repo.Update(id, req)
+	// Передать кота можно только в организацию, где пользователь сотрудник
+	if req.OrganizationID != nil && *req.OrganizationID != 0 {
+		if err := requireOrganizationRole(s.orgRepo, *req.OrganizationID, userID, isAdmin, models.OrganizationRoleStaff); err != nil {
+			return err
+		}
+	}
+
+	// Проверяем поля расширенного профиля
+	if err := validateCatProfile(req.Sex, req.Color, req.CoatPattern, req.EyeColor, req.WeightKg); err != nil {
+		return err
+	}
+
+	// Дата стерилизации допустима только для стерилизованного кота с учетом обновляемого флага
+	if req.NeuteredAt != nil {
+		isNeutered := cat.IsNeutered
+		if req.IsNeutered != nil {
+			isNeutered = *req.IsNeutered
+		}
+		if !isNeutered || !isValidNeuteredDate(*req.NeuteredAt) {
+			return ErrInvalidNeuteredData
+		}
+	}
+
+	// Проверяем номер микрочипа и его уникальность среди других котов
+	if req.MicrochipNumber != nil {
+		if err := s.checkMicrochip(*req.MicrochipNumber, id); err != nil {
+			return err
+		}
+	}
+
+	// Проверяем ссылки на родителей и отсутствие циклов в родословной
+	if err := checkParents(s.repo, s.pedigreeRepo, id, userID, isAdmin, req.SireID, req.DamID, req.SireExternalID, req.DamExternalID); err != nil {
+		return err
+	}
+
+	// Проверяем имя и описание фильтром текста
+	flags, err := s.textFilter.Apply(req.Name, req.Description)
+	if err != nil {
+		return err
+	}
+
+	if err := s.repo.Update(id, req); err != nil {
+		return err
+	}
+
+	s.textFilter.Flag(models.ReportTargetCat, id, flags)
+	return nil
 }
 
 // DeleteCat удаляет кота
@@ -126,10 +359,376 @@ func (s *CatService) DeleteCat(id int, userID int, isAdmin bool) error {
 		return ErrCatNotFound
 	}
 
-	// Проверяем права доступа: пользователь может удалять только своих котов, админ - любых
+	// Проверяем права доступа: удалить кота может только основной владелец или админ
 	if !isAdmin && cat.UserID != userID {
 		return ErrAccessDenied
 	}
 
 	return s.repo.Delete(id)
-}
\ No newline at end of file
+}
+
+// GetSharedCats возвращает котов, к которым пользователь получил доступ как участник
+func (s *CatService) GetSharedCats(userID int) ([]models.CatResponse, error) {
+	cats, err := s.repo.GetByMemberID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var responses []models.CatResponse
+	for _, cat := range cats {
+		response := cat.ToResponse()
+		response.MicrochipNumber = cat.MicrochipNumber
+		responses = append(responses, response)
+	}
+
+	if err := s.applyLikes(responses, userID); err != nil {
+		return nil, err
+	}
+
+	return responses, nil
+}
+
+// GetFavoriteCats возвращает котов, отмеченных пользователем, которых он по-прежнему может видеть
+func (s *CatService) GetFavoriteCats(userID int, isAdmin bool) ([]models.CatResponse, error) {
+	return s.GetAllCats(&models.CatFilter{LikedByID: userID}, userID, isAdmin)
+}
+
+// LikeCat отмечает кота, видимого пользователю, и возвращает обновленное число отметок
+func (s *CatService) LikeCat(id int, userID int, isAdmin bool) (*models.LikeSummary, error) {
+	if err := s.checkLikeAccess(id, userID, isAdmin); err != nil {
+		return nil, err
+	}
+
+	if err := s.likeRepo.LikeCat(id, userID); err != nil {
+		return nil, err
+	}
+
+	return s.getLikeSummary(id, userID)
+}
+
+// UnlikeCat снимает отметку с кота и возвращает обновленное число отметок
+func (s *CatService) UnlikeCat(id int, userID int, isAdmin bool) (*models.LikeSummary, error) {
+	if err := s.checkLikeAccess(id, userID, isAdmin); err != nil {
+		return nil, err
+	}
+
+	if err := s.likeRepo.UnlikeCat(id, userID); err != nil {
+		return nil, err
+	}
+
+	return s.getLikeSummary(id, userID)
+}
+
+// checkLikeAccess проверяет, что кот существует и виден пользователю
+func (s *CatService) checkLikeAccess(id int, userID int, isAdmin bool) error {
+	cat, err := s.repo.GetByID(id)
+	if err != nil {
+		return ErrCatNotFound
+	}
+
+	canView, err := canViewCat(s.repo, cat, userID, isAdmin)
+	if err != nil {
+		return err
+	}
+	if !canView {
+		return ErrCatNotFound
+	}
+
+	return nil
+}
+
+// getLikeSummary возвращает число отметок кота и отметку пользователя
+func (s *CatService) getLikeSummary(id int, userID int) (*models.LikeSummary, error) {
+	likes, err := s.likeRepo.GetCatLikes([]int{id}, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := likes[id]
+	return &summary, nil
+}
+
+// applyLikes дополняет ответы числом отметок "нравится" и отметкой текущего пользователя.
+// Отметки загружаются одним запросом для всего списка, userID равен 0 для анонимного запроса
+func (s *CatService) applyLikes(responses []models.CatResponse, userID int) error {
+	ids := make([]int, 0, len(responses))
+	for _, response := range responses {
+		ids = append(ids, response.ID)
+	}
+
+	likes, err := s.likeRepo.GetCatLikes(ids, userID)
+	if err != nil {
+		return err
+	}
+
+	for i := range responses {
+		summary := likes[responses[i].ID]
+		responses[i].LikeCount = summary.LikeCount
+		responses[i].LikedByMe = summary.LikedByMe
+	}
+
+	return nil
+}
+
+// canViewCat проверяет, может ли пользователь видеть кота с учетом его видимости.
+// Приватного или скрытого модератором кота видят только владелец, участники и админ,
+// userID равен 0 для анонимного запроса
+func canViewCat(catRepo repositories.CatRepository, cat *models.Cat, userID int, isAdmin bool) (bool, error) {
+	if (cat.Visibility != models.CatVisibilityPrivate && cat.HiddenAt == nil) || isAdmin {
+		return true, nil
+	}
+	if userID == 0 {
+		return false, nil
+	}
+
+	return hasCatRole(catRepo, cat.ID, userID, isAdmin, models.CatRoleViewer)
+}
+
+// hasCatRole проверяет существование кота и что роль пользователя не ниже требуемой.
+// Админ имеет доступ ко всем котам
+func hasCatRole(catRepo repositories.CatRepository, catID int, userID int, isAdmin bool, required string) (bool, error) {
+	role, err := catRepo.GetUserRole(catID, userID)
+	if err == sql.ErrNoRows {
+		return false, ErrCatNotFound
+	}
+	if err != nil {
+		return false, err
+	}
+
+	if isAdmin {
+		return true, nil
+	}
+
+	return models.CatRoleRanks[role] >= models.CatRoleRanks[required], nil
+}
+
+// requireCatRole возвращает ErrAccessDenied, если роль пользователя ниже требуемой
+func requireCatRole(catRepo repositories.CatRepository, catID int, userID int, isAdmin bool, required string) error {
+	allowed, err := hasCatRole(catRepo, catID, userID, isAdmin, required)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return ErrAccessDenied
+	}
+
+	return nil
+}
+
+// isValidCatBirthDate проверяет формат даты рождения и что возраст кота находится в пределах от 0 до 30 лет
+func isValidCatBirthDate(value string) bool {
+	birthDate, precision, ok := models.ParseBirthDate(value)
+	if !ok {
+		return false
+	}
+
+	now := time.Now()
+
+	// Сравниваем с точностью, с которой известна дата рождения
+	switch precision {
+	case models.BirthDatePrecisionYear:
+		return birthDate.Year() <= now.Year() && birthDate.Year() >= now.Year()-30
+	case models.BirthDatePrecisionMonth:
+		currentMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+		return !birthDate.After(currentMonth) && !birthDate.Before(currentMonth.AddDate(-30, 0, 0))
+	default:
+		return !birthDate.After(now) && !birthDate.Before(now.AddDate(-30, 0, 0))
+	}
+}
+
+// checkMicrochip проверяет формат номера микрочипа и что он не закреплен за другим котом
+func (s *CatService) checkMicrochip(number string, catID int) error {
+	if !IsValidMicrochipNumber(number) {
+		return ErrInvalidMicrochip
+	}
+
+	existing, err := s.repo.GetByMicrochipNumber(number)
+	if err != nil && err != sql.ErrNoRows {
+		return err
+	}
+	if existing != nil && existing.ID != catID {
+		return ErrMicrochipExists
+	}
+
+	return nil
+}
+
+// checkParents проверяет ссылки на родителей кота catID (0 для нового кота).
+// Нулевые ID означают удаление ссылки и не проверяются
+func checkParents(
+	catRepo repositories.CatRepository,
+	pedigreeRepo repositories.PedigreeRepository,
+	catID int,
+	userID int,
+	isAdmin bool,
+	sireID, damID, sireExternalID, damExternalID *int,
+) error {
+	if isSetID(sireID) && isSetID(sireExternalID) || isSetID(damID) && isSetID(damExternalID) {
+		return ErrInvalidParent
+	}
+
+	if isSetID(sireID) && isSetID(damID) && *sireID == *damID {
+		return ErrInvalidParent
+	}
+
+	if isSetID(sireID) {
+		if err := checkCatParent(catRepo, pedigreeRepo, catID, *sireID, models.CatSexMale, userID, isAdmin); err != nil {
+			return err
+		}
+	}
+
+	if isSetID(damID) {
+		if err := checkCatParent(catRepo, pedigreeRepo, catID, *damID, models.CatSexFemale, userID, isAdmin); err != nil {
+			return err
+		}
+	}
+
+	if isSetID(sireExternalID) {
+		if err := checkExternalParent(pedigreeRepo, *sireExternalID, models.CatSexMale); err != nil {
+			return err
+		}
+	}
+
+	if isSetID(damExternalID) {
+		if err := checkExternalParent(pedigreeRepo, *damExternalID, models.CatSexFemale); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkCatParent проверяет, что зарегистрированный кот может быть родителем кота catID.
+// Родителем можно указать только кота, которого пользователь видит, иначе родословная раскроет скрытого кота
+func checkCatParent(
+	catRepo repositories.CatRepository,
+	pedigreeRepo repositories.PedigreeRepository,
+	catID int,
+	parentID int,
+	sex string,
+	userID int,
+	isAdmin bool,
+) error {
+	if parentID == catID {
+		return ErrPedigreeCycle
+	}
+
+	parent, err := catRepo.GetByID(parentID)
+	if err != nil {
+		return ErrParentNotFound
+	}
+
+	canView, err := canViewCat(catRepo, parent, userID, isAdmin)
+	if err != nil {
+		return err
+	}
+	if !canView {
+		return ErrParentNotFound
+	}
+
+	if parent.Sex != nil && *parent.Sex != sex {
+		return ErrInvalidParentSex
+	}
+
+	// Родителем не может стать потомок кота, иначе родословная замкнется
+	if catID != 0 {
+		isDescendant, err := pedigreeRepo.IsCatAncestor(catID, parentID)
+		if err != nil {
+			return err
+		}
+		if isDescendant {
+			return ErrPedigreeCycle
+		}
+	}
+
+	return nil
+}
+
+// checkExternalParent проверяет, что внешний предок может быть родителем указанного пола.
+// Внешние предки ссылаются только на внешних предков, поэтому цикл через них невозможен
+func checkExternalParent(pedigreeRepo repositories.PedigreeRepository, ancestorID int, sex string) error {
+	ancestor, err := pedigreeRepo.GetExternalAncestorByID(ancestorID)
+	if err != nil {
+		return ErrParentNotFound
+	}
+
+	if ancestor.Sex != nil && *ancestor.Sex != sex {
+		return ErrInvalidParentSex
+	}
+
+	return nil
+}
+
+// isSetID проверяет, что ссылка задана и не является удалением
+func isSetID(id *int) bool {
+	return id != nil && *id != 0
+}
+
+// validateCatProfile проверяет поля расширенного профиля кота
+func validateCatProfile(sex, color, coatPattern, eyeColor *string, weightKg *float64) error {
+	if sex != nil && !isValidCatSex(*sex) {
+		return ErrInvalidCatSex
+	}
+
+	if coatPattern != nil && !isValidCoatPattern(*coatPattern) {
+		return ErrInvalidCoatPattern
+	}
+
+	if (color != nil && strings.TrimSpace(*color) == "") || (eyeColor != nil && strings.TrimSpace(*eyeColor) == "") {
+		return ErrInvalidCatData
+	}
+
+	if weightKg != nil && (*weightKg <= 0 || *weightKg > 30) {
+		return ErrInvalidCatWeight
+	}
+
+	return nil
+}
+
+// isValidCatVisibility проверяет значение видимости кота
+func isValidCatVisibility(visibility string) bool {
+	return visibility == models.CatVisibilityPublic ||
+		visibility == models.CatVisibilityUnlisted ||
+		visibility == models.CatVisibilityPrivate
+}
+
+// isValidCatSex проверяет допустимость пола кота
+func isValidCatSex(sex string) bool {
+	return sex == models.CatSexMale || sex == models.CatSexFemale
+}
+
+// isValidCoatPattern проверяет, что тип окраса входит в список допустимых
+func isValidCoatPattern(pattern string) bool {
+	for _, p := range models.CatCoatPatterns {
+		if p == pattern {
+			return true
+		}
+	}
+	return false
+}
+
+// IsValidMicrochipNumber проверяет номер микрочипа по ISO 11784: 15 цифр,
+// первые три из которых - код страны (001-899) или производителя (900-998)
+func IsValidMicrochipNumber(number string) bool {
+	if len(number) != 15 {
+		return false
+	}
+
+	for _, r := range number {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	prefix := number[:3]
+	return prefix != "000" && prefix != "999"
+}
+
+// isValidNeuteredDate проверяет дату стерилизации в формате YYYY-MM-DD
+func isValidNeuteredDate(value string) bool {
+	date, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return false
+	}
+	return !date.After(time.Now())
+}
@@ -0,0 +1,155 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"meawle/internal/models"
+	"meawle/internal/notifier"
+	"meawle/internal/repositories"
+)
+
+var (
+	ErrChipNotRegistered  = errors.New("microchip is not registered")
+	ErrInvalidContactData = errors.New("invalid contact request data")
+)
+
+// ChipService представляет сервис поиска котов по микрочипу
+type ChipService struct {
+	catRepo     repositories.CatRepository
+	contactRepo repositories.ChipContactRepository
+	userRepo    repositories.UserRepository
+	notifier    notifier.Notifier
+}
+
+// NewChipService создает новый экземпляр сервиса микрочипов
+func NewChipService(
+	catRepo repositories.CatRepository,
+	contactRepo repositories.ChipContactRepository,
+	userRepo repositories.UserRepository,
+	notifier notifier.Notifier,
+) *ChipService {
+	return &ChipService{
+		catRepo:     catRepo,
+		contactRepo: contactRepo,
+		userRepo:    userRepo,
+		notifier:    notifier,
+	}
+}
+
+// Lookup проверяет, зарегистрирован ли микрочип, не раскрывая данных владельца.
+// Имя кота сообщается, только если кот виден в каталоге
+func (s *ChipService) Lookup(number string) (*models.ChipLookupResponse, error) {
+	if !IsValidMicrochipNumber(number) {
+		return nil, ErrInvalidMicrochip
+	}
+
+	response := &models.ChipLookupResponse{MicrochipNumber: number}
+
+	cat, err := s.catRepo.GetByMicrochipNumber(number)
+	if err == sql.ErrNoRows {
+		return response, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	response.Registered = true
+
+	canView, err := canViewCat(s.catRepo, cat, 0, false)
+	if err != nil {
+		return nil, err
+	}
+	if canView {
+		response.CatName = &cat.Name
+	}
+
+	return response, nil
+}
+
+// SendContactRequest сохраняет запрос нашедшего кота на связь с владельцем и сразу уведомляет владельца
+func (s *ChipService) SendContactRequest(number string, req *models.ChipContactCreateRequest) (*models.ChipContactRequestResponse, error) {
+	if !IsValidMicrochipNumber(number) {
+		return nil, ErrInvalidMicrochip
+	}
+
+	// Проверяем контактные данные нашедшего
+	if strings.TrimSpace(req.FinderName) == "" || strings.TrimSpace(req.FinderContact) == "" {
+		return nil, ErrInvalidContactData
+	}
+	if req.Message != nil && len(*req.Message) > 1000 {
+		return nil, ErrInvalidContactData
+	}
+
+	cat, err := s.catRepo.GetByMicrochipNumber(number)
+	if err == sql.ErrNoRows {
+		return nil, ErrChipNotRegistered
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	request := &models.ChipContactRequest{
+		CatID:         cat.ID,
+		FinderName:    req.FinderName,
+		FinderContact: req.FinderContact,
+		Message:       req.Message,
+		CreatedAt:     time.Now(),
+	}
+
+	if err := s.contactRepo.Create(request); err != nil {
+		return nil, err
+	}
+
+	s.notifyOwner(cat, request)
+
+	response := request.ToResponse()
+	return &response, nil
+}
+
+// GetOwnerContactRequests возвращает запросы на связь по котам пользователя
+func (s *ChipService) GetOwnerContactRequests(userID int) ([]models.ChipContactRequestResponse, error) {
+	requests, err := s.contactRepo.GetByOwnerID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var responses []models.ChipContactRequestResponse
+	for _, request := range requests {
+		responses = append(responses, request.ToResponse())
+	}
+
+	return responses, nil
+}
+
+// notifyOwner сообщает владельцу кота о запросе на связь. Ошибка доставки не отменяет запрос,
+// владелец увидит его и в списке запросов
+func (s *ChipService) notifyOwner(cat *models.Cat, request *models.ChipContactRequest) {
+	owner, err := s.userRepo.GetByID(cat.UserID)
+	if err != nil {
+		return
+	}
+
+	body := fmt.Sprintf("%s found %s and left a contact: %s", request.FinderName, cat.Name, request.FinderContact)
+	if request.Message != nil {
+		body += "\n\n" + *request.Message
+	}
+
+	notification := notifier.Notification{
+		UserID:  owner.ID,
+		Email:   owner.Email,
+		Subject: fmt.Sprintf("Someone found %s", cat.Name),
+		Body:    body,
+		Data: map[string]string{
+			"contact_request_id": strconv.Itoa(request.ID),
+			"cat_id":             strconv.Itoa(cat.ID),
+		},
+	}
+
+	_ = s.notifier.Notify(context.Background(), notification)
+}
@@ -0,0 +1,383 @@
+package services
+
+import (
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"meawle/internal/models"
+	"meawle/internal/repositories"
+)
+
+var (
+	ErrCommentNotFound       = errors.New("comment not found")
+	ErrInvalidCommentData    = errors.New("comment must be between 1 and 2000 characters")
+	ErrInvalidCommentTarget  = errors.New("comment target must be cat or breed")
+	ErrCommentThreadLocked   = errors.New("comment thread is locked")
+	ErrCommentEditExpired    = errors.New("comment can only be edited within 15 minutes")
+	ErrCommentDeleted        = errors.New("comment has been deleted")
+	ErrCommentRateLimited    = errors.New("too many comments, try again later")
+	ErrInvalidCommentPaging  = errors.New("limit must be between 1 and 100 and offset not negative")
+	ErrInvalidCommentReplyTo = errors.New("reply must belong to the same thread")
+)
+
+const (
+	// commentEditWindow - время после публикации, в течение которого автор может изменить комментарий
+	commentEditWindow = 15 * time.Minute
+	// commentRateWindow - окно, в котором считается число комментариев пользователя
+	commentRateWindow = time.Minute
+	// maxCommentLength - максимальная длина комментария в символах
+	maxCommentLength = 2000
+	// defaultCommentsLimit и maxCommentsLimit - размер страницы комментариев верхнего уровня
+	defaultCommentsLimit = 20
+	maxCommentsLimit     = 100
+)
+
+// CommentService представляет сервис комментариев на страницах котов и пород.
+// Модерируют комментарии владельцы кота, авторы и сотрудники организации породы и админ
+type CommentService struct {
+	repo      repositories.CommentRepository
+	catRepo   repositories.CatRepository
+	breedRepo repositories.CatBreedRepository
+	orgRepo   repositories.OrganizationRepository
+	rateLimit int
+}
+
+// NewCommentService создает новый экземпляр сервиса комментариев.
+// rateLimit - максимум комментариев от одного пользователя в минуту
+func NewCommentService(
+	repo repositories.CommentRepository,
+	catRepo repositories.CatRepository,
+	breedRepo repositories.CatBreedRepository,
+	orgRepo repositories.OrganizationRepository,
+	rateLimit int,
+) *CommentService {
+	return &CommentService{
+		repo:      repo,
+		catRepo:   catRepo,
+		breedRepo: breedRepo,
+		orgRepo:   orgRepo,
+		rateLimit: rateLimit,
+	}
+}
+
+// GetComments возвращает страницу комментариев верхнего уровня с ветками ответов.
+// userID равен 0 для анонимного запроса
+func (s *CommentService) GetComments(targetType string, targetID int, limit int, offset int, userID int, isAdmin bool) (*models.CommentThreadResponse, error) {
+	if limit == 0 {
+		limit = defaultCommentsLimit
+	}
+	if limit < 1 || limit > maxCommentsLimit || offset < 0 {
+		return nil, ErrInvalidCommentPaging
+	}
+
+	canModerate, err := s.checkTarget(targetType, targetID, userID, isAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	total, err := s.repo.CountRoots(targetType, targetID)
+	if err != nil {
+		return nil, err
+	}
+
+	isLocked, err := s.repo.IsLocked(targetType, targetID)
+	if err != nil {
+		return nil, err
+	}
+
+	roots, err := s.repo.GetRoots(targetType, targetID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	rootIDs := make([]int, 0, len(roots))
+	for _, root := range roots {
+		rootIDs = append(rootIDs, root.ID)
+	}
+
+	// Ответы всех веток страницы загружаем одним запросом и собираем в дерево
+	replies, err := s.repo.GetReplies(rootIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	children := make(map[int][]models.Comment)
+	for _, reply := range replies {
+		children[*reply.ParentID] = append(children[*reply.ParentID], reply)
+	}
+
+	comments := make([]models.CommentResponse, 0, len(roots))
+	for _, root := range roots {
+		comments = append(comments, buildCommentTree(&root, children, userID, canModerate))
+	}
+
+	return &models.CommentThreadResponse{
+		Comments:    comments,
+		Total:       total,
+		Limit:       limit,
+		Offset:      offset,
+		IsLocked:    isLocked,
+		CanModerate: canModerate,
+	}, nil
+}
+
+// Create добавляет комментарий или ответ. В закрытой ветке писать могут только модераторы
+func (s *CommentService) Create(targetType string, targetID int, req *models.CommentCreateRequest, userID int, isAdmin bool) (*models.CommentResponse, error) {
+	body, err := normalizeCommentBody(req.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	canModerate, err := s.checkTarget(targetType, targetID, userID, isAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkNotLocked(targetType, targetID, canModerate); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC().Truncate(time.Second)
+	if err := s.checkRateLimit(userID, isAdmin, now); err != nil {
+		return nil, err
+	}
+
+	comment := &models.Comment{
+		TargetType: targetType,
+		TargetID:   targetID,
+		UserID:     userID,
+		Body:       body,
+		CreatedAt:  now,
+	}
+
+	// Ответ попадает в ветку родителя, отвечать на удаленные комментарии нельзя
+	if req.ParentID != nil {
+		parent, err := s.repo.GetByID(*req.ParentID)
+		if err != nil {
+			return nil, ErrCommentNotFound
+		}
+		if parent.TargetType != targetType || parent.TargetID != targetID {
+			return nil, ErrInvalidCommentReplyTo
+		}
+		if parent.DeletedAt != nil {
+			return nil, ErrCommentDeleted
+		}
+
+		rootID := parent.ID
+		if parent.RootID != nil {
+			rootID = *parent.RootID
+		}
+		comment.ParentID = &parent.ID
+		comment.RootID = &rootID
+	}
+
+	if err := s.repo.Create(comment); err != nil {
+		return nil, err
+	}
+
+	response := comment.ToResponse(true)
+	return &response, nil
+}
+
+// Update изменяет текст комментария. Изменить комментарий может только автор в течение 15 минут после публикации
+func (s *CommentService) Update(id int, req *models.CommentUpdateRequest, userID int, isAdmin bool) (*models.CommentResponse, error) {
+	body, err := normalizeCommentBody(req.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	comment, err := s.repo.GetByID(id)
+	if err != nil {
+		return nil, ErrCommentNotFound
+	}
+
+	if comment.UserID != userID {
+		return nil, ErrAccessDenied
+	}
+	if comment.DeletedAt != nil {
+		return nil, ErrCommentDeleted
+	}
+
+	now := time.Now().UTC().Truncate(time.Second)
+	if now.Sub(comment.CreatedAt) > commentEditWindow {
+		return nil, ErrCommentEditExpired
+	}
+
+	canModerate, err := s.checkTarget(comment.TargetType, comment.TargetID, userID, isAdmin)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkNotLocked(comment.TargetType, comment.TargetID, canModerate); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.UpdateBody(id, body, now); err != nil {
+		return nil, err
+	}
+
+	comment.Body = body
+	comment.EditedAt = &now
+	response := comment.ToResponse(true)
+	return &response, nil
+}
+
+// Delete удаляет комментарий, оставляя ответы на него. Удалить комментарий могут автор и модераторы
+func (s *CommentService) Delete(id int, userID int, isAdmin bool) error {
+	comment, err := s.repo.GetByID(id)
+	if err != nil {
+		return ErrCommentNotFound
+	}
+	if comment.DeletedAt != nil {
+		return ErrCommentNotFound
+	}
+
+	if comment.UserID != userID {
+		if err := s.requireModerator(comment.TargetType, comment.TargetID, userID, isAdmin); err != nil {
+			return err
+		}
+	}
+
+	return s.repo.SoftDelete(id, time.Now().UTC().Truncate(time.Second))
+}
+
+// SetHidden скрывает комментарий или возвращает его в ветку. Доступно только модераторам
+func (s *CommentService) SetHidden(id int, hidden bool, userID int, isAdmin bool) error {
+	comment, err := s.repo.GetByID(id)
+	if err != nil {
+		return ErrCommentNotFound
+	}
+
+	if err := s.requireModerator(comment.TargetType, comment.TargetID, userID, isAdmin); err != nil {
+		return err
+	}
+
+	return s.repo.SetHidden(id, hidden, userID)
+}
+
+// SetLocked закрывает ветку для новых комментариев или открывает ее. Доступно только модераторам
+func (s *CommentService) SetLocked(targetType string, targetID int, locked bool, userID int, isAdmin bool) error {
+	if err := s.requireModerator(targetType, targetID, userID, isAdmin); err != nil {
+		return err
+	}
+
+	if locked {
+		return s.repo.Lock(targetType, targetID, userID)
+	}
+	return s.repo.Unlock(targetType, targetID)
+}
+
+// checkTarget проверяет, что кот или порода существует и виден пользователю, и сообщает,
+// может ли пользователь модерировать комментарии к нему
+func (s *CommentService) checkTarget(targetType string, targetID int, userID int, isAdmin bool) (bool, error) {
+	switch targetType {
+	case models.CommentTargetCat:
+		cat, err := s.catRepo.GetByID(targetID)
+		if err != nil {
+			return false, ErrCatNotFound
+		}
+
+		canView, err := canViewCat(s.catRepo, cat, userID, isAdmin)
+		if err != nil {
+			return false, err
+		}
+		if !canView {
+			return false, ErrCatNotFound
+		}
+		if userID == 0 {
+			return false, nil
+		}
+
+		return hasCatRole(s.catRepo, targetID, userID, isAdmin, models.CatRoleOwner)
+	case models.CommentTargetBreed:
+		breed, err := s.breedRepo.GetByID(targetID)
+		if err != nil {
+			return false, ErrCatBreedNotFound
+		}
+
+		canManage := false
+		if userID != 0 {
+			canManage, err = canManageBreed(s.orgRepo, breed, userID, isAdmin)
+			if err != nil {
+				return false, err
+			}
+		}
+
+		// Обсуждение скрытой модератором породы видят только те, кто ею управляет
+		if breed.HiddenAt != nil && !canManage {
+			return false, ErrCatBreedNotFound
+		}
+
+		return canManage, nil
+	default:
+		return false, ErrInvalidCommentTarget
+	}
+}
+
+// requireModerator возвращает ErrAccessDenied, если пользователь не может модерировать комментарии к объекту
+func (s *CommentService) requireModerator(targetType string, targetID int, userID int, isAdmin bool) error {
+	canModerate, err := s.checkTarget(targetType, targetID, userID, isAdmin)
+	if err != nil {
+		return err
+	}
+	if !canModerate {
+		return ErrAccessDenied
+	}
+
+	return nil
+}
+
+// checkNotLocked возвращает ErrCommentThreadLocked для закрытой ветки, если пользователь не модератор
+func (s *CommentService) checkNotLocked(targetType string, targetID int, canModerate bool) error {
+	if canModerate {
+		return nil
+	}
+
+	isLocked, err := s.repo.IsLocked(targetType, targetID)
+	if err != nil {
+		return err
+	}
+	if isLocked {
+		return ErrCommentThreadLocked
+	}
+
+	return nil
+}
+
+// checkRateLimit ограничивает число комментариев пользователя в минуту. На админа ограничение не действует
+func (s *CommentService) checkRateLimit(userID int, isAdmin bool, now time.Time) error {
+	if isAdmin || s.rateLimit <= 0 {
+		return nil
+	}
+
+	count, err := s.repo.CountByUserSince(userID, now.Add(-commentRateWindow))
+	if err != nil {
+		return err
+	}
+	if count >= s.rateLimit {
+		return ErrCommentRateLimited
+	}
+
+	return nil
+}
+
+// buildCommentTree собирает ответ с вложенными ответами. Текст скрытого комментария видят автор и модераторы
+func buildCommentTree(comment *models.Comment, children map[int][]models.Comment, userID int, canModerate bool) models.CommentResponse {
+	response := comment.ToResponse(canModerate || (userID != 0 && comment.UserID == userID))
+	for _, child := range children[comment.ID] {
+		response.Replies = append(response.Replies, buildCommentTree(&child, children, userID, canModerate))
+	}
+
+	return response
+}
+
+// normalizeCommentBody обрезает пробелы и проверяет длину комментария
+func normalizeCommentBody(body string) (string, error) {
+	body = strings.TrimSpace(body)
+	if body == "" || utf8.RuneCountInString(body) > maxCommentLength {
+		return "", ErrInvalidCommentData
+	}
+
+	return body, nil
+}
@@ -0,0 +1,185 @@
+package services
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"meawle/internal/models"
+	"meawle/internal/repositories"
+)
+
+var (
+	ErrCannotFollowSelf  = errors.New("users cannot follow themselves")
+	ErrInvalidFeedCursor = errors.New("invalid feed cursor")
+	ErrInvalidFeedLimit  = errors.New("feed limit must be between 1 and 50")
+)
+
+const (
+	// defaultFeedLimit и maxFeedLimit - размер страницы ленты
+	defaultFeedLimit = 20
+	maxFeedLimit     = 50
+)
+
+// FeedService представляет сервис подписок на пользователей и ленты их активности
+type FeedService struct {
+	followRepo    repositories.FollowRepository
+	activityRepo  repositories.ActivityRepository
+	userRepo      repositories.UserRepository
+	catRepo       repositories.CatRepository
+	breedRepo     repositories.CatBreedRepository
+	lostFoundRepo repositories.LostFoundRepository
+}
+
+// NewFeedService создает новый экземпляр сервиса ленты
+func NewFeedService(
+	followRepo repositories.FollowRepository,
+	activityRepo repositories.ActivityRepository,
+	userRepo repositories.UserRepository,
+	catRepo repositories.CatRepository,
+	breedRepo repositories.CatBreedRepository,
+	lostFoundRepo repositories.LostFoundRepository,
+) *FeedService {
+	return &FeedService{
+		followRepo:    followRepo,
+		activityRepo:  activityRepo,
+		userRepo:      userRepo,
+		catRepo:       catRepo,
+		breedRepo:     breedRepo,
+		lostFoundRepo: lostFoundRepo,
+	}
+}
+
+// Follow подписывает текущего пользователя на автора
+func (s *FeedService) Follow(followeeID int, userID int) error {
+	if followeeID == userID {
+		return ErrCannotFollowSelf
+	}
+
+	if _, err := s.userRepo.GetByID(followeeID); err != nil {
+		return ErrUserNotFound
+	}
+
+	return s.followRepo.Follow(userID, followeeID)
+}
+
+// Unfollow отменяет подписку текущего пользователя на автора
+func (s *FeedService) Unfollow(followeeID int, userID int) error {
+	if _, err := s.userRepo.GetByID(followeeID); err != nil {
+		return ErrUserNotFound
+	}
+
+	return s.followRepo.Unfollow(userID, followeeID)
+}
+
+// GetFollowers возвращает подписчиков пользователя
+func (s *FeedService) GetFollowers(userID int) ([]models.Follow, error) {
+	if _, err := s.userRepo.GetByID(userID); err != nil {
+		return nil, ErrUserNotFound
+	}
+
+	return s.followRepo.GetFollowers(userID)
+}
+
+// GetFollowing возвращает пользователей, на которых подписан пользователь
+func (s *FeedService) GetFollowing(userID int) ([]models.Follow, error) {
+	if _, err := s.userRepo.GetByID(userID); err != nil {
+		return nil, ErrUserNotFound
+	}
+
+	return s.followRepo.GetFollowing(userID)
+}
+
+// GetFeed возвращает страницу ленты событий авторов, на которых подписан пользователь.
+// cursor берется из next_cursor предыдущей страницы, пустой cursor открывает ленту с самых новых событий
+func (s *FeedService) GetFeed(cursor string, limit int, userID int) (*models.FeedResponse, error) {
+	if limit == 0 {
+		limit = defaultFeedLimit
+	}
+	if limit < 1 || limit > maxFeedLimit {
+		return nil, ErrInvalidFeedLimit
+	}
+
+	filter := &models.FeedFilter{ViewerID: userID, Limit: limit + 1}
+	if cursor != "" {
+		beforeID, err := strconv.Atoi(cursor)
+		if err != nil || beforeID <= 0 {
+			return nil, ErrInvalidFeedCursor
+		}
+		filter.BeforeID = beforeID
+	}
+
+	// Запрашиваем на одно событие больше, чтобы узнать, есть ли следующая страница
+	events, err := s.activityRepo.GetFeed(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &models.FeedResponse{Items: []models.FeedItem{}}
+	if len(events) > limit {
+		events = events[:limit]
+		nextCursor := strconv.Itoa(events[len(events)-1].ID)
+		response.NextCursor = &nextCursor
+	}
+
+	for _, event := range events {
+		item, ok := s.loadFeedItem(&event)
+		if !ok {
+			continue
+		}
+		response.Items = append(response.Items, *item)
+	}
+
+	return response, nil
+}
+
+// loadFeedItem дополняет событие объектом, к которому оно относится.
+// Возвращает false, если объект был удален между выборкой событий и загрузкой
+func (s *FeedService) loadFeedItem(event *models.ActivityEvent) (*models.FeedItem, bool) {
+	item := &models.FeedItem{
+		ID:        event.ID,
+		UserID:    event.UserID,
+		EventType: event.EventType,
+		CreatedAt: event.CreatedAt,
+	}
+
+	switch event.TargetType {
+	case models.ActivityTargetCat:
+		cat, err := s.catRepo.GetByID(event.TargetID)
+		if err != nil {
+			return nil, false
+		}
+		response := cat.ToResponse()
+		item.Cat = &response
+	case models.ActivityTargetBreed:
+		breed, err := s.breedRepo.GetByID(event.TargetID)
+		if err != nil {
+			return nil, false
+		}
+		response := breed.ToResponse()
+		item.Breed = &response
+	case models.ActivityTargetLostFound:
+		report, err := s.lostFoundRepo.GetByID(event.TargetID)
+		if err != nil {
+			return nil, false
+		}
+		response := report.ToResponse()
+		item.LostReport = &response
+	default:
+		return nil, false
+	}
+
+	return item, true
+}
+
+// recordActivity сохраняет событие ленты активности. Ошибка записи не должна прерывать
+// основное действие пользователя, поэтому событие записывается по возможности
+func recordActivity(repo repositories.ActivityRepository, userID int, eventType string, targetType string, targetID int) {
+	_ = repo.Record(&models.ActivityEvent{
+		UserID:     userID,
+		EventType:  eventType,
+		TargetType: targetType,
+		TargetID:   targetID,
+		CreatedAt:  time.Now().UTC().Truncate(time.Second),
+	})
+}
@@ -0,0 +1,243 @@
+package services
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"meawle/internal/models"
+	"meawle/internal/repositories"
+)
+
+var (
+	ErrHealthRecordNotFound    = errors.New("health record not found")
+	ErrInvalidHealthRecordData = errors.New("invalid health record data")
+	ErrInvalidHealthRecordType = errors.New("health record type must be vaccination, vet_visit, medication or allergy")
+	ErrInvalidHealthRecordDate = errors.New("health record dates must be YYYY-MM-DD and due date cannot be before record date")
+)
+
+// HealthRecordService представляет сервис для работы с медицинской историей котов
+type HealthRecordService struct {
+	repo    repositories.HealthRecordRepository
+	catRepo repositories.CatRepository
+}
+
+// NewHealthRecordService создает новый экземпляр сервиса медицинских записей
+func NewHealthRecordService(repo repositories.HealthRecordRepository, catRepo repositories.CatRepository) *HealthRecordService {
+	return &HealthRecordService{
+		repo:    repo,
+		catRepo: catRepo,
+	}
+}
+
+// Create создает медицинскую запись для кота
+func (s *HealthRecordService) Create(catID int, req *models.HealthRecordCreateRequest, userID int, isAdmin bool) (*models.HealthRecordResponse, error) {
+	// Добавлять записи могут участники с ролью не ниже опекуна и админ
+	canManage, err := s.canManageCat(catID, userID, isAdmin, models.CatRoleCaretaker)
+	if err != nil {
+		return nil, err
+	}
+	if !canManage {
+		return nil, ErrAccessDenied
+	}
+
+	// Проверяем тип и заголовок записи
+	if !isValidHealthRecordType(req.RecordType) {
+		return nil, ErrInvalidHealthRecordType
+	}
+	if strings.TrimSpace(req.Title) == "" {
+		return nil, ErrInvalidHealthRecordData
+	}
+
+	// Проверяем даты записи
+	if !isValidHealthRecordDates(req.RecordDate, req.DueDate) {
+		return nil, ErrInvalidHealthRecordDate
+	}
+
+	record := &models.HealthRecord{
+		CatID:      catID,
+		RecordType: req.RecordType,
+		Title:      req.Title,
+		Notes:      req.Notes,
+		RecordDate: req.RecordDate,
+		DueDate:    req.DueDate,
+		VetName:    req.VetName,
+		Dosage:     req.Dosage,
+		IsPublic:   req.IsPublic,
+		CreatedAt:  time.Now(),
+	}
+
+	if err := s.repo.Create(record); err != nil {
+		return nil, err
+	}
+
+	response := record.ToResponse()
+	return &response, nil
+}
+
+// GetCatRecords возвращает медицинские записи кота.
+// Владелец, участники кота и админ видят все записи, остальные пользователи - только публичные записи видимых им котов
+func (s *HealthRecordService) GetCatRecords(catID int, recordType *string, userID int, isAdmin bool) ([]models.HealthRecordResponse, error) {
+	if err := s.checkCatVisible(catID, userID, isAdmin); err != nil {
+		return nil, err
+	}
+
+	canManage, err := s.canManageCat(catID, userID, isAdmin, models.CatRoleViewer)
+	if err != nil {
+		return nil, err
+	}
+
+	if recordType != nil && !isValidHealthRecordType(*recordType) {
+		return nil, ErrInvalidHealthRecordType
+	}
+
+	records, err := s.repo.GetByCatID(catID, recordType, !canManage)
+	if err != nil {
+		return nil, err
+	}
+
+	var responses []models.HealthRecordResponse
+	for _, record := range records {
+		responses = append(responses, record.ToResponse())
+	}
+
+	return responses, nil
+}
+
+// GetRecord возвращает медицинскую запись кота по ID
+func (s *HealthRecordService) GetRecord(catID int, recordID int, userID int, isAdmin bool) (*models.HealthRecordResponse, error) {
+	if err := s.checkCatVisible(catID, userID, isAdmin); err != nil {
+		return nil, err
+	}
+
+	canManage, err := s.canManageCat(catID, userID, isAdmin, models.CatRoleViewer)
+	if err != nil {
+		return nil, err
+	}
+
+	record, err := s.getCatRecord(catID, recordID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Скрытые записи не раскрываем посторонним, как будто их нет
+	if !record.IsPublic && !canManage {
+		return nil, ErrHealthRecordNotFound
+	}
+
+	response := record.ToResponse()
+	return &response, nil
+}
+
+// UpdateRecord обновляет медицинскую запись кота
+func (s *HealthRecordService) UpdateRecord(catID int, recordID int, req *models.HealthRecordUpdateRequest, userID int, isAdmin bool) error {
+	canManage, err := s.canManageCat(catID, userID, isAdmin, models.CatRoleCaretaker)
+	if err != nil {
+		return err
+	}
+	if !canManage {
+		return ErrAccessDenied
+	}
+
+	record, err := s.getCatRecord(catID, recordID)
+	if err != nil {
+		return err
+	}
+
+	if req.Title != nil && strings.TrimSpace(*req.Title) == "" {
+		return ErrInvalidHealthRecordData
+	}
+
+	// Проверяем даты с учетом уже сохраненных значений
+	recordDate := record.RecordDate
+	if req.RecordDate != nil {
+		recordDate = *req.RecordDate
+	}
+	dueDate := record.DueDate
+	if req.DueDate != nil {
+		dueDate = req.DueDate
+	}
+	if !isValidHealthRecordDates(recordDate, dueDate) {
+		return ErrInvalidHealthRecordDate
+	}
+
+	return s.repo.Update(recordID, req)
+}
+
+// DeleteRecord удаляет медицинскую запись кота
+func (s *HealthRecordService) DeleteRecord(catID int, recordID int, userID int, isAdmin bool) error {
+	canManage, err := s.canManageCat(catID, userID, isAdmin, models.CatRoleCaretaker)
+	if err != nil {
+		return err
+	}
+	if !canManage {
+		return ErrAccessDenied
+	}
+
+	if _, err := s.getCatRecord(catID, recordID); err != nil {
+		return err
+	}
+
+	return s.repo.Delete(recordID)
+}
+
+// canManageCat проверяет существование кота и что роль пользователя позволяет работать с медицинской историей
+func (s *HealthRecordService) canManageCat(catID int, userID int, isAdmin bool, role string) (bool, error) {
+	return hasCatRole(s.catRepo, catID, userID, isAdmin, role)
+}
+
+// checkCatVisible проверяет, что пользователь может видеть кота.
+// Публичные записи приватного или скрытого кота посторонним не показываются, как и сам кот
+func (s *HealthRecordService) checkCatVisible(catID int, userID int, isAdmin bool) error {
+	cat, err := s.catRepo.GetByID(catID)
+	if err != nil {
+		return ErrCatNotFound
+	}
+
+	canView, err := canViewCat(s.catRepo, cat, userID, isAdmin)
+	if err != nil {
+		return err
+	}
+	if !canView {
+		return ErrCatNotFound
+	}
+
+	return nil
+}
+
+// getCatRecord возвращает запись, убедившись, что она принадлежит указанному коту
+func (s *HealthRecordService) getCatRecord(catID int, recordID int) (*models.HealthRecord, error) {
+	record, err := s.repo.GetByID(recordID)
+	if err != nil || record.CatID != catID {
+		return nil, ErrHealthRecordNotFound
+	}
+
+	return record, nil
+}
+
+// isValidHealthRecordType проверяет допустимость типа медицинской записи
+func isValidHealthRecordType(recordType string) bool {
+	switch recordType {
+	case models.HealthRecordVaccination, models.HealthRecordVetVisit, models.HealthRecordMedication, models.HealthRecordAllergy:
+		return true
+	default:
+		return false
+	}
+}
+
+// isValidHealthRecordDates проверяет формат дат и что срок не раньше даты записи
+func isValidHealthRecordDates(recordDate string, dueDate *string) bool {
+	date, err := time.Parse("2006-01-02", recordDate)
+	if err != nil || date.After(time.Now()) {
+		return false
+	}
+
+	if dueDate != nil {
+		due, err := time.Parse("2006-01-02", *dueDate)
+		if err != nil || due.Before(date) {
+			return false
+		}
+	}
+
+	return true
+}
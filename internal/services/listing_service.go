@@ -0,0 +1,216 @@
+package services
+
+import (
+	"database/sql"
+	"errors"
+	"strings"
+
+	"meawle/internal/models"
+	"meawle/internal/repositories"
+)
+
+var (
+	ErrListingNotFound      = errors.New("listing not found")
+	ErrInvalidListingData   = errors.New("invalid listing data")
+	ErrInvalidListingStatus = errors.New("invalid listing status")
+	ErrListingExists        = errors.New("cat already has an active listing")
+	ErrListingClosed        = errors.New("listing is closed")
+	ErrListingCatPrivate    = errors.New("private cat cannot be listed for adoption")
+)
+
+// ListingService представляет сервис объявлений о пристройстве котов
+type ListingService struct {
+	repo    repositories.ListingRepository
+	catRepo repositories.CatRepository
+}
+
+// NewListingService создает новый экземпляр сервиса объявлений
+func NewListingService(repo repositories.ListingRepository, catRepo repositories.CatRepository) *ListingService {
+	return &ListingService{
+		repo:    repo,
+		catRepo: catRepo,
+	}
+}
+
+// Create создает объявление о пристройстве кота
+func (s *ListingService) Create(req *models.ListingCreateRequest, userID int, isAdmin bool) (*models.ListingResponse, error) {
+	// Пристраивать кота могут основной владелец, совладельцы и админ
+	if err := requireCatRole(s.catRepo, req.CatID, userID, isAdmin, models.CatRoleOwner); err != nil {
+		return nil, err
+	}
+
+	cat, err := s.catRepo.GetByID(req.CatID)
+	if err != nil {
+		return nil, ErrCatNotFound
+	}
+	if cat.Visibility == models.CatVisibilityPrivate {
+		return nil, ErrListingCatPrivate
+	}
+
+	if strings.TrimSpace(req.Title) == "" || strings.TrimSpace(req.Location) == "" {
+		return nil, ErrInvalidListingData
+	}
+	if req.Fee != nil && *req.Fee < 0 {
+		return nil, ErrInvalidListingData
+	}
+
+	// Новое объявление создается черновиком или сразу публикуется
+	status := models.ListingStatusDraft
+	if req.Status != nil {
+		if *req.Status != models.ListingStatusDraft && *req.Status != models.ListingStatusOpen {
+			return nil, ErrInvalidListingStatus
+		}
+		status = *req.Status
+	}
+
+	if _, err := s.repo.GetActiveByCatID(req.CatID); err == nil {
+		return nil, ErrListingExists
+	} else if err != sql.ErrNoRows {
+		return nil, err
+	}
+
+	listing := &models.Listing{
+		CatID:        req.CatID,
+		UserID:       userID,
+		Title:        req.Title,
+		Description:  req.Description,
+		Status:       status,
+		Location:     req.Location,
+		Fee:          req.Fee,
+		Requirements: req.Requirements,
+	}
+
+	if err := s.repo.Create(listing); err != nil {
+		return nil, err
+	}
+
+	created, err := s.repo.GetByID(listing.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := created.ToResponse()
+	return &response, nil
+}
+
+// GetListing возвращает объявление по ID. Черновик видят только автор и админ
+func (s *ListingService) GetListing(id int, userID int, isAdmin bool) (*models.ListingResponse, error) {
+	listing, err := s.repo.GetByID(id)
+	if err != nil {
+		return nil, ErrListingNotFound
+	}
+
+	if listing.Status == models.ListingStatusDraft && !isAdmin && listing.UserID != userID {
+		return nil, ErrListingNotFound
+	}
+
+	response := listing.ToResponse()
+	return &response, nil
+}
+
+// Search возвращает опубликованные объявления, по умолчанию только открытые
+func (s *ListingService) Search(filter *models.ListingFilter, userID int, isAdmin bool) ([]models.ListingResponse, error) {
+	if filter == nil {
+		filter = &models.ListingFilter{}
+	}
+
+	if filter.Status == nil {
+		status := models.ListingStatusOpen
+		filter.Status = &status
+	} else if *filter.Status == models.ListingStatusDraft || !isValidListingStatus(*filter.Status) {
+		return nil, ErrInvalidListingStatus
+	}
+
+	if filter.CatSex != nil && !isValidCatSex(*filter.CatSex) {
+		return nil, ErrInvalidCatSex
+	}
+	if filter.MaxFee != nil && *filter.MaxFee < 0 {
+		return nil, ErrInvalidListingData
+	}
+
+	filter.ViewerID = userID
+	filter.IncludeHidden = isAdmin
+
+	listings, err := s.repo.Search(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return listingResponses(listings), nil
+}
+
+// GetUserListings возвращает все объявления пользователя, включая черновики
+func (s *ListingService) GetUserListings(userID int) ([]models.ListingResponse, error) {
+	listings, err := s.repo.GetByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return listingResponses(listings), nil
+}
+
+// UpdateListing обновляет объявление. Закрытое объявление изменить нельзя
+func (s *ListingService) UpdateListing(id int, req *models.ListingUpdateRequest, userID int, isAdmin bool) error {
+	listing, err := s.repo.GetByID(id)
+	if err != nil {
+		return ErrListingNotFound
+	}
+
+	// Проверяем права доступа: автор может обновлять только свои объявления, админ - любые
+	if !isAdmin && listing.UserID != userID {
+		return ErrAccessDenied
+	}
+
+	if listing.Status == models.ListingStatusAdopted {
+		return ErrListingClosed
+	}
+
+	if req.Status != nil && !isValidListingStatus(*req.Status) {
+		return ErrInvalidListingStatus
+	}
+	if req.Title != nil && strings.TrimSpace(*req.Title) == "" {
+		return ErrInvalidListingData
+	}
+	if req.Location != nil && strings.TrimSpace(*req.Location) == "" {
+		return ErrInvalidListingData
+	}
+	if req.Fee != nil && *req.Fee < 0 {
+		return ErrInvalidListingData
+	}
+
+	return s.repo.Update(id, req)
+}
+
+// DeleteListing удаляет объявление
+func (s *ListingService) DeleteListing(id int, userID int, isAdmin bool) error {
+	listing, err := s.repo.GetByID(id)
+	if err != nil {
+		return ErrListingNotFound
+	}
+
+	// Проверяем права доступа: автор может удалять только свои объявления, админ - любые
+	if !isAdmin && listing.UserID != userID {
+		return ErrAccessDenied
+	}
+
+	return s.repo.Delete(id)
+}
+
+// isValidListingStatus проверяет статус объявления
+func isValidListingStatus(status string) bool {
+	for _, s := range models.ListingStatuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
+
+// listingResponses преобразует список объявлений в ответы
+func listingResponses(listings []models.Listing) []models.ListingResponse {
+	var responses []models.ListingResponse
+	for _, listing := range listings {
+		responses = append(responses, listing.ToResponse())
+	}
+	return responses
+}
@@ -0,0 +1,280 @@
+package services
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"meawle/internal/models"
+	"meawle/internal/repositories"
+)
+
+var (
+	ErrLitterNotFound         = errors.New("litter not found")
+	ErrInvalidLitterData      = errors.New("invalid litter data")
+	ErrInvalidLitterBirthDate = errors.New("litter birth date must be YYYY-MM-DD not in the future")
+	ErrInvalidAvailability    = errors.New("invalid kitten availability")
+	ErrKittenNotInLitter      = errors.New("kitten does not belong to litter")
+)
+
+// maxLitterKittens ограничивает число котят, регистрируемых одним запросом
+const maxLitterKittens = 20
+
+// LitterService представляет сервис для работы с пометами
+type LitterService struct {
+	repo         repositories.LitterRepository
+	catRepo      repositories.CatRepository
+	pedigreeRepo repositories.PedigreeRepository
+	activityRepo repositories.ActivityRepository
+	textFilter   *TextFilterService
+}
+
+// NewLitterService создает новый экземпляр сервиса пометов
+func NewLitterService(
+	repo repositories.LitterRepository,
+	catRepo repositories.CatRepository,
+	pedigreeRepo repositories.PedigreeRepository,
+	activityRepo repositories.ActivityRepository,
+	textFilter *TextFilterService,
+) *LitterService {
+	return &LitterService{
+		repo:         repo,
+		catRepo:      catRepo,
+		pedigreeRepo: pedigreeRepo,
+		activityRepo: activityRepo,
+		textFilter:   textFilter,
+	}
+}
+
+// Create регистрирует помет и создает всех котят одной транзакцией
+func (s *LitterService) Create(req *models.LitterCreateRequest, userID int, isAdmin bool) (*models.LitterResponse, error) {
+	if req.Name != nil && strings.TrimSpace(*req.Name) == "" {
+		return nil, ErrInvalidLitterData
+	}
+
+	if !isValidLitterBirthDate(req.BirthDate) {
+		return nil, ErrInvalidLitterBirthDate
+	}
+
+	if len(req.Kittens) == 0 || len(req.Kittens) > maxLitterKittens {
+		return nil, ErrInvalidLitterData
+	}
+
+	// Помет регистрирует владелец матери, админ - для любой кошки
+	dam, err := s.catRepo.GetByID(req.DamID)
+	if err != nil {
+		return nil, ErrParentNotFound
+	}
+	if !isAdmin && dam.UserID != userID {
+		return nil, ErrAccessDenied
+	}
+
+	// Проверяем родителей так же, как при указании родословной кота
+	if err := checkParents(s.catRepo, s.pedigreeRepo, 0, userID, isAdmin, req.SireID, &req.DamID, req.SireExternalID, nil); err != nil {
+		return nil, err
+	}
+
+	kittens := make([]*models.Cat, 0, len(req.Kittens))
+	// Правила фильтра текста, нарушенные каждым котенком, в порядке kittens
+	kittenFlags := make([][]string, 0, len(req.Kittens))
+	for _, k := range req.Kittens {
+		if strings.TrimSpace(k.Name) == "" || (k.Description != nil && strings.TrimSpace(*k.Description) == "") {
+			return nil, ErrInvalidLitterData
+		}
+
+		// Проверяем имя и описание котенка фильтром текста, как при создании кота
+		flags, err := s.textFilter.Apply(&k.Name, k.Description)
+		if err != nil {
+			return nil, err
+		}
+		kittenFlags = append(kittenFlags, flags)
+
+		if err := validateCatProfile(k.Sex, k.Color, k.CoatPattern, k.EyeColor, nil); err != nil {
+			return nil, err
+		}
+
+		availability := models.KittenAvailable
+		if k.Availability != nil {
+			if !isValidAvailability(*k.Availability) {
+				return nil, ErrInvalidAvailability
+			}
+			availability = *k.Availability
+		}
+
+		kittens = append(kittens, &models.Cat{
+			Name:           k.Name,
+			BirthDate:      &req.BirthDate,
+			Description:    k.Description,
+			Sex:            k.Sex,
+			Color:          k.Color,
+			CoatPattern:    k.CoatPattern,
+			EyeColor:       k.EyeColor,
+			SireID:         req.SireID,
+			DamID:          &req.DamID,
+			SireExternalID: req.SireExternalID,
+			Availability:   &availability,
+			Visibility:     models.CatVisibilityPublic,
+			UserID:         dam.UserID,
+		})
+	}
+
+	litter := &models.Litter{
+		Name:           req.Name,
+		DamID:          req.DamID,
+		SireID:         req.SireID,
+		SireExternalID: req.SireExternalID,
+		BirthDate:      req.BirthDate,
+		Notes:          req.Notes,
+		UserID:         dam.UserID,
+	}
+
+	if err := s.repo.CreateWithKittens(litter, kittens); err != nil {
+		return nil, err
+	}
+
+	for i, kitten := range kittens {
+		s.textFilter.Flag(models.ReportTargetCat, kitten.ID, kittenFlags[i])
+		recordActivity(s.activityRepo, dam.UserID, models.ActivityCatCreated, models.ActivityTargetCat, kitten.ID)
+	}
+
+	// Создатель помета - владелец матери, поэтому видит всех котят
+	return s.GetLitter(litter.ID, dam.UserID, isAdmin)
+}
+
+// GetLitter возвращает помет со списком котят
+func (s *LitterService) GetLitter(id int, userID int, isAdmin bool) (*models.LitterResponse, error) {
+	litter, err := s.repo.GetByID(id)
+	if err != nil {
+		return nil, ErrLitterNotFound
+	}
+
+	kittens, err := s.visibleKittens(litter.ID, userID, isAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	response := litter.ToResponse(kittens)
+	return &response, nil
+}
+
+// GetLitters возвращает пометы, удовлетворяющие фильтру
+func (s *LitterService) GetLitters(filter *models.LitterFilter, userID int, isAdmin bool) ([]models.LitterResponse, error) {
+	litters, err := s.repo.GetAll(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var responses []models.LitterResponse
+	for _, litter := range litters {
+		kittens, err := s.visibleKittens(litter.ID, userID, isAdmin)
+		if err != nil {
+			return nil, err
+		}
+		responses = append(responses, litter.ToResponse(kittens))
+	}
+
+	return responses, nil
+}
+
+// UpdateLitter обновляет данные помета
+func (s *LitterService) UpdateLitter(id int, req *models.LitterUpdateRequest, userID int, isAdmin bool) error {
+	litter, err := s.repo.GetByID(id)
+	if err != nil {
+		return ErrLitterNotFound
+	}
+
+	// Проверяем права доступа: заводчик может обновлять только свои пометы, админ - любые
+	if !isAdmin && litter.UserID != userID {
+		return ErrAccessDenied
+	}
+
+	if req.Name == nil && req.Notes == nil {
+		return ErrInvalidLitterData
+	}
+	if (req.Name != nil && strings.TrimSpace(*req.Name) == "") || (req.Notes != nil && strings.TrimSpace(*req.Notes) == "") {
+		return ErrInvalidLitterData
+	}
+
+	return s.repo.Update(id, req)
+}
+
+// DeleteLitter удаляет помет, котята при этом сохраняются
+func (s *LitterService) DeleteLitter(id int, userID int, isAdmin bool) error {
+	litter, err := s.repo.GetByID(id)
+	if err != nil {
+		return ErrLitterNotFound
+	}
+
+	// Проверяем права доступа: заводчик может удалять только свои пометы, админ - любые
+	if !isAdmin && litter.UserID != userID {
+		return ErrAccessDenied
+	}
+
+	return s.repo.Delete(id)
+}
+
+// UpdateKittenAvailability изменяет статус доступности котенка из помета
+func (s *LitterService) UpdateKittenAvailability(litterID, catID int, req *models.KittenAvailabilityRequest, userID int, isAdmin bool) error {
+	litter, err := s.repo.GetByID(litterID)
+	if err != nil {
+		return ErrLitterNotFound
+	}
+
+	// Статусы котят ведет заводчик помета
+	if !isAdmin && litter.UserID != userID {
+		return ErrAccessDenied
+	}
+
+	kitten, err := s.catRepo.GetByID(catID)
+	if err != nil {
+		return ErrCatNotFound
+	}
+	if kitten.LitterID == nil || *kitten.LitterID != litterID {
+		return ErrKittenNotInLitter
+	}
+
+	if !isValidAvailability(req.Availability) {
+		return ErrInvalidAvailability
+	}
+
+	return s.catRepo.UpdateAvailability(catID, req.Availability)
+}
+
+// visibleKittens возвращает котят помета без скрытых котят, которых пользователь не может видеть
+func (s *LitterService) visibleKittens(litterID int, userID int, isAdmin bool) ([]models.Cat, error) {
+	kittens, err := s.catRepo.GetByLitterID(litterID)
+	if err != nil {
+		return nil, err
+	}
+
+	var visible []models.Cat
+	for i := range kittens {
+		canView, err := canViewCat(s.catRepo, &kittens[i], userID, isAdmin)
+		if err != nil {
+			return nil, err
+		}
+		if canView {
+			visible = append(visible, kittens[i])
+		}
+	}
+
+	return visible, nil
+}
+
+// isValidLitterBirthDate проверяет точную дату рождения помета
+func isValidLitterBirthDate(value string) bool {
+	if _, err := time.Parse("2006-01-02", value); err != nil {
+		return false
+	}
+	return isValidCatBirthDate(value)
+}
+
+// isValidAvailability проверяет, что статус котенка входит в список допустимых
+func isValidAvailability(availability string) bool {
+	for _, a := range models.KittenAvailabilities {
+		if a == availability {
+			return true
+		}
+	}
+	return false
+}
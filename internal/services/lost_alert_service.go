@@ -0,0 +1,207 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"meawle/internal/models"
+	"meawle/internal/notifier"
+	"meawle/internal/repositories"
+)
+
+var (
+	ErrLostAlertNotFound      = errors.New("lost cat alert subscription not found")
+	ErrInvalidLostAlertRadius = errors.New("alert radius must be greater than 0 and not more than 50 km")
+)
+
+const (
+	// maxLostAlertRadiusKm - максимальный радиус подписки на оповещения
+	maxLostAlertRadiusKm = 50.0
+	// pendingLostAlertsBatchSize ограничивает число объявлений, обрабатываемых за один запуск планировщика
+	pendingLostAlertsBatchSize = 50
+	// maxLostAlertAttempts - число неудачных попыток рассылки, после которого объявление больше не рассылается
+	maxLostAlertAttempts = 5
+	// lostAlertRetryDelay - задержка перед первой повторной попыткой, каждая следующая вдвое дольше
+	lostAlertRetryDelay = time.Minute
+)
+
+// LostAlertService представляет сервис оповещений о котах, потерявшихся рядом с пользователем
+type LostAlertService struct {
+	repo     repositories.LostAlertRepository
+	notifier notifier.Notifier
+}
+
+// NewLostAlertService создает новый экземпляр сервиса оповещений о потерявшихся котах
+func NewLostAlertService(repo repositories.LostAlertRepository, notifier notifier.Notifier) *LostAlertService {
+	return &LostAlertService{
+		repo:     repo,
+		notifier: notifier,
+	}
+}
+
+// GetSubscription возвращает подписку пользователя
+func (s *LostAlertService) GetSubscription(userID int) (*models.LostAlertSubscriptionResponse, error) {
+	subscription, err := s.repo.GetByUserID(userID)
+	if err != nil {
+		return nil, ErrLostAlertNotFound
+	}
+
+	response := subscription.ToResponse()
+	return &response, nil
+}
+
+// Subscribe создает или заменяет подписку пользователя на оповещения о потерявшихся рядом котах
+func (s *LostAlertService) Subscribe(req *models.LostAlertSubscriptionRequest, userID int) (*models.LostAlertSubscriptionResponse, error) {
+	if req.Latitude == nil || req.Longitude == nil {
+		return nil, ErrInvalidCoordinates
+	}
+	if !isValidCoordinates(*req.Latitude, *req.Longitude) {
+		return nil, ErrInvalidCoordinates
+	}
+
+	if req.RadiusKm == nil || *req.RadiusKm <= 0 || *req.RadiusKm > maxLostAlertRadiusKm {
+		return nil, ErrInvalidLostAlertRadius
+	}
+
+	subscription := &models.LostAlertSubscription{
+		UserID:    userID,
+		Latitude:  *req.Latitude,
+		Longitude: *req.Longitude,
+		RadiusKm:  *req.RadiusKm,
+		IsActive:  true,
+	}
+	if req.IsActive != nil {
+		subscription.IsActive = *req.IsActive
+	}
+
+	if err := s.repo.Upsert(subscription); err != nil {
+		return nil, err
+	}
+
+	return s.GetSubscription(userID)
+}
+
+// Unsubscribe удаляет подписку пользователя
+func (s *LostAlertService) Unsubscribe(userID int) error {
+	if _, err := s.repo.GetByUserID(userID); err != nil {
+		return ErrLostAlertNotFound
+	}
+
+	return s.repo.Delete(userID)
+}
+
+// DispatchPending рассылает оповещения по новым объявлениям о пропаже подписчикам, в радиус которых они попадают.
+// Вызывается планировщиком; объявление считается обработанным, только когда оповещены все подписчики,
+// а уже оповещенные пользователи при повторном запуске пропускаются
+func (s *LostAlertService) DispatchPending(ctx context.Context, now time.Time) error {
+	// Время в базе хранится в UTC с точностью до секунды
+	now = now.UTC().Truncate(time.Second)
+
+	reports, err := s.repo.GetPendingReports(now, pendingLostAlertsBatchSize)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, report := range reports {
+		if ctx.Err() != nil {
+			break
+		}
+
+		if err := s.dispatch(ctx, &report, now); err != nil {
+			errs = append(errs, fmt.Errorf("lost report %d: %w", report.ID, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// dispatch оповещает подписчиков об одном объявлении. Если оповестить удалось не всех,
+// следующая попытка откладывается с растущей задержкой, а после maxLostAlertAttempts попыток рассылка прекращается
+func (s *LostAlertService) dispatch(ctx context.Context, report *models.LostFoundReport, now time.Time) error {
+	failed, err := s.notifyRecipients(ctx, report, now)
+	if !failed {
+		if markErr := s.repo.MarkDispatched(report.ID, now); markErr != nil {
+			return markErr
+		}
+		return err
+	}
+
+	attempts, attemptErr := s.repo.RecordFailedAttempt(report.ID)
+	if attemptErr != nil {
+		return errors.Join(err, attemptErr)
+	}
+
+	if attempts >= maxLostAlertAttempts {
+		if markErr := s.repo.MarkDispatched(report.ID, now); markErr != nil {
+			return errors.Join(err, markErr)
+		}
+		return fmt.Errorf("giving up after %d attempts: %w", attempts, err)
+	}
+
+	retryAt := now.Add(lostAlertRetryDelay << (attempts - 1))
+	if retryErr := s.repo.ScheduleRetry(report.ID, retryAt); retryErr != nil {
+		return errors.Join(err, retryErr)
+	}
+
+	return err
+}
+
+// notifyRecipients отправляет оповещения еще не оповещенным подписчикам.
+// failed равен true, если кого-то из подписчиков оповестить не удалось и рассылку нужно повторить.
+// Частично доставленное оповещение считается отправленным, его ошибка возвращается только для записи в лог
+func (s *LostAlertService) notifyRecipients(ctx context.Context, report *models.LostFoundReport, now time.Time) (bool, error) {
+	recipients, err := s.repo.FindRecipients(report, maxLostAlertRadiusKm)
+	if err != nil {
+		return true, err
+	}
+
+	failed := false
+	var errs []error
+	for _, recipient := range recipients {
+		notification := notifier.Notification{
+			UserID:  recipient.UserID,
+			Email:   recipient.Email,
+			Subject: fmt.Sprintf("A cat was reported lost %.1f km from you", *recipient.DistanceKm),
+			Body:    lostAlertBody(report),
+			Data: map[string]string{
+				"report_id":   strconv.Itoa(report.ID),
+				"distance_km": strconv.FormatFloat(*recipient.DistanceKm, 'f', 3, 64),
+			},
+		}
+
+		if err := s.notifier.Notify(ctx, notification); err != nil {
+			errs = append(errs, fmt.Errorf("user %d: %w", recipient.UserID, err))
+			if !notifier.IsDelivered(err) {
+				failed = true
+				continue
+			}
+		}
+
+		if err := s.repo.RecordDelivery(report.ID, recipient.UserID, now); err != nil {
+			errs = append(errs, fmt.Errorf("user %d: %w", recipient.UserID, err))
+			failed = true
+		}
+	}
+
+	return failed, errors.Join(errs...)
+}
+
+// lostAlertBody формирует текст оповещения по приметам кота и месту пропажи
+func lostAlertBody(report *models.LostFoundReport) string {
+	body := "A cat went missing near you"
+	if report.Color != nil {
+		body = fmt.Sprintf("A %s cat went missing near you", *report.Color)
+	}
+	if report.Address != nil {
+		body += " at " + *report.Address
+	}
+	body += fmt.Sprintf(", last seen %s", report.LastSeenAt.Format("2006-01-02 15:04 MST"))
+	if report.Description != nil {
+		body += ". " + *report.Description
+	}
+	return body
+}
@@ -0,0 +1,350 @@
+package services
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+	"time"
+
+	"meawle/internal/models"
+	"meawle/internal/repositories"
+)
+
+var (
+	ErrLostFoundNotFound    = errors.New("lost or found report not found")
+	ErrInvalidLostFoundData = errors.New("invalid lost or found report data")
+	ErrInvalidLostFoundType = errors.New("report type must be lost or found")
+	ErrInvalidCoordinates   = errors.New("latitude must be between -90 and 90 and longitude between -180 and 180")
+	ErrInvalidSearchRadius  = errors.New("search radius must be greater than 0 and not more than 100 km")
+	ErrInvalidLastSeenAt    = errors.New("last seen time cannot be in the future")
+	ErrInvalidPhotoURLs     = errors.New("up to 10 photo URLs with http or https scheme are allowed")
+)
+
+const (
+	// defaultLostFoundRadiusKm - радиус поиска объявлений по умолчанию
+	defaultLostFoundRadiusKm = 5.0
+	// defaultMatchRadiusKm - радиус поиска совпадений для объявления по умолчанию
+	defaultMatchRadiusKm = 10.0
+	// maxLostFoundRadiusKm - максимальный радиус поиска
+	maxLostFoundRadiusKm = 100.0
+	// maxPhotoURLs - максимальное количество фотографий в объявлении
+	maxPhotoURLs = 10
+)
+
+// LostFoundService представляет сервис объявлений о потерянных и найденных котах
+type LostFoundService struct {
+	repo         repositories.LostFoundRepository
+	catRepo      repositories.CatRepository
+	breedRepo    repositories.CatBreedRepository
+	activityRepo repositories.ActivityRepository
+}
+
+// NewLostFoundService создает новый экземпляр сервиса объявлений о потерянных и найденных котах
+func NewLostFoundService(
+	repo repositories.LostFoundRepository,
+	catRepo repositories.CatRepository,
+	breedRepo repositories.CatBreedRepository,
+	activityRepo repositories.ActivityRepository,
+) *LostFoundService {
+	return &LostFoundService{
+		repo:         repo,
+		catRepo:      catRepo,
+		breedRepo:    breedRepo,
+		activityRepo: activityRepo,
+	}
+}
+
+// Create создает объявление о потерянном или найденном коте.
+// Потерявшимся можно отметить только кота, за которым пользователь ухаживает
+func (s *LostFoundService) Create(req *models.LostFoundCreateRequest, userID int, isAdmin bool) (*models.LostFoundResponse, error) {
+	if req.ReportType != models.LostFoundTypeLost && req.ReportType != models.LostFoundTypeFound {
+		return nil, ErrInvalidLostFoundType
+	}
+
+	if req.Latitude == nil || req.Longitude == nil {
+		return nil, ErrInvalidCoordinates
+	}
+	if !isValidCoordinates(*req.Latitude, *req.Longitude) {
+		return nil, ErrInvalidCoordinates
+	}
+
+	if err := s.validateDetails(req.BreedID, req.Sex, req.PhotoURLs); err != nil {
+		return nil, err
+	}
+
+	// По умолчанию кота видели только что
+	lastSeenAt := time.Now().UTC().Truncate(time.Second)
+	if req.LastSeenAt != nil {
+		if req.LastSeenAt.After(time.Now()) {
+			return nil, ErrInvalidLastSeenAt
+		}
+		lastSeenAt = req.LastSeenAt.UTC().Truncate(time.Second)
+	}
+
+	report := &models.LostFoundReport{
+		ReportType:  req.ReportType,
+		Status:      models.LostFoundStatusActive,
+		UserID:      userID,
+		CatID:       req.CatID,
+		BreedID:     req.BreedID,
+		Color:       req.Color,
+		Sex:         req.Sex,
+		Description: req.Description,
+		Latitude:    *req.Latitude,
+		Longitude:   *req.Longitude,
+		Address:     req.Address,
+		LastSeenAt:  lastSeenAt,
+		PhotoURLs:   req.PhotoURLs,
+	}
+
+	if req.CatID != nil {
+		if err := s.linkCat(report, userID, isAdmin); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.repo.Create(report); err != nil {
+		return nil, err
+	}
+
+	recordActivity(s.activityRepo, userID, models.ActivityLostFoundCreated, models.ActivityTargetLostFound, report.ID)
+
+	return s.GetReport(report.ID)
+}
+
+// GetReport возвращает объявление по ID
+func (s *LostFoundService) GetReport(id int) (*models.LostFoundResponse, error) {
+	report, err := s.repo.GetByID(id)
+	if err != nil {
+		return nil, ErrLostFoundNotFound
+	}
+
+	response := report.ToResponse()
+	return &response, nil
+}
+
+// Search возвращает активные объявления в радиусе от точки, ближайшие первыми
+func (s *LostFoundService) Search(filter *models.LostFoundFilter) ([]models.LostFoundResponse, error) {
+	if !isValidCoordinates(filter.Latitude, filter.Longitude) {
+		return nil, ErrInvalidCoordinates
+	}
+
+	if filter.RadiusKm == 0 {
+		filter.RadiusKm = defaultLostFoundRadiusKm
+	}
+	if !isValidSearchRadius(filter.RadiusKm) {
+		return nil, ErrInvalidSearchRadius
+	}
+
+	if filter.ReportType != nil && *filter.ReportType != models.LostFoundTypeLost && *filter.ReportType != models.LostFoundTypeFound {
+		return nil, ErrInvalidLostFoundType
+	}
+
+	reports, err := s.repo.Search(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return lostFoundResponses(reports), nil
+}
+
+// GetMatches возвращает активные объявления противоположного типа, похожие на указанное:
+// для найденного кота - потерявшихся раньше, для потерявшегося - найденных позже.
+// Совпадение учитывает породу, цвет, пол и расстояние, неизвестные признаки не исключают объявление
+func (s *LostFoundService) GetMatches(id int, radiusKm float64) ([]models.LostFoundResponse, error) {
+	report, err := s.repo.GetByID(id)
+	if err != nil {
+		return nil, ErrLostFoundNotFound
+	}
+
+	if radiusKm == 0 {
+		radiusKm = defaultMatchRadiusKm
+	}
+	if !isValidSearchRadius(radiusKm) {
+		return nil, ErrInvalidSearchRadius
+	}
+
+	filter := &models.LostFoundFilter{
+		Latitude:    report.Latitude,
+		Longitude:   report.Longitude,
+		RadiusKm:    radiusKm,
+		BreedID:     report.BreedID,
+		Color:       report.Color,
+		Sex:         report.Sex,
+		ExcludeID:   report.ID,
+		ExcludeUser: report.UserID,
+	}
+
+	oppositeType := models.LostFoundTypeLost
+	if report.ReportType == models.LostFoundTypeFound {
+		filter.SeenBefore = &report.LastSeenAt
+	} else {
+		oppositeType = models.LostFoundTypeFound
+		filter.SeenAfter = &report.LastSeenAt
+	}
+	filter.ReportType = &oppositeType
+
+	reports, err := s.repo.Search(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return lostFoundResponses(reports), nil
+}
+
+// GetUserReports возвращает объявления пользователя, включая закрытые
+func (s *LostFoundService) GetUserReports(userID int) ([]models.LostFoundResponse, error) {
+	reports, err := s.repo.GetByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return lostFoundResponses(reports), nil
+}
+
+// UpdateReport обновляет объявление. Изменять его могут только автор и админ
+func (s *LostFoundService) UpdateReport(id int, req *models.LostFoundUpdateRequest, userID int, isAdmin bool) error {
+	report, err := s.repo.GetByID(id)
+	if err != nil {
+		return ErrLostFoundNotFound
+	}
+
+	if !isAdmin && report.UserID != userID {
+		return ErrAccessDenied
+	}
+
+	if req.Status != nil && *req.Status != models.LostFoundStatusActive && *req.Status != models.LostFoundStatusResolved {
+		return ErrInvalidLostFoundData
+	}
+
+	// Координаты проверяем вместе с неизменяемой частью точки
+	latitude, longitude := report.Latitude, report.Longitude
+	if req.Latitude != nil {
+		latitude = *req.Latitude
+	}
+	if req.Longitude != nil {
+		longitude = *req.Longitude
+	}
+	if !isValidCoordinates(latitude, longitude) {
+		return ErrInvalidCoordinates
+	}
+
+	breedID := req.BreedID
+	if breedID != nil && *breedID == 0 {
+		breedID = nil
+	}
+	if err := s.validateDetails(breedID, req.Sex, req.PhotoURLs); err != nil {
+		return err
+	}
+
+	if req.LastSeenAt != nil {
+		if req.LastSeenAt.After(time.Now()) {
+			return ErrInvalidLastSeenAt
+		}
+		lastSeenAt := req.LastSeenAt.UTC().Truncate(time.Second)
+		req.LastSeenAt = &lastSeenAt
+	}
+
+	return s.repo.Update(id, req)
+}
+
+// DeleteReport удаляет объявление. Удалить его могут только автор и админ
+func (s *LostFoundService) DeleteReport(id int, userID int, isAdmin bool) error {
+	report, err := s.repo.GetByID(id)
+	if err != nil {
+		return ErrLostFoundNotFound
+	}
+
+	if !isAdmin && report.UserID != userID {
+		return ErrAccessDenied
+	}
+
+	return s.repo.Delete(id)
+}
+
+// linkCat проверяет доступ к связанному коту и дополняет объявление его приметами
+func (s *LostFoundService) linkCat(report *models.LostFoundReport, userID int, isAdmin bool) error {
+	cat, err := s.catRepo.GetByID(*report.CatID)
+	if err != nil {
+		return ErrCatNotFound
+	}
+
+	if report.ReportType == models.LostFoundTypeLost {
+		// О пропаже кота сообщают те, кто за ним ухаживает
+		if err := requireCatRole(s.catRepo, cat.ID, userID, isAdmin, models.CatRoleCaretaker); err != nil {
+			return err
+		}
+	} else {
+		// Найденного кота можно связать только с видимым пользователю профилем, например по микрочипу
+		canView, err := canViewCat(s.catRepo, cat, userID, isAdmin)
+		if err != nil {
+			return err
+		}
+		if !canView {
+			return ErrCatNotFound
+		}
+	}
+
+	if report.Color == nil {
+		report.Color = cat.Color
+	}
+	if report.Sex == nil {
+		report.Sex = cat.Sex
+	}
+
+	return nil
+}
+
+// validateDetails проверяет породу, пол и ссылки на фотографии объявления
+func (s *LostFoundService) validateDetails(breedID *int, sex *string, photoURLs []string) error {
+	if breedID != nil {
+		if _, err := s.breedRepo.GetByID(*breedID); err != nil {
+			return ErrCatBreedNotFound
+		}
+	}
+
+	if sex != nil && !isValidCatSex(*sex) {
+		return ErrInvalidCatSex
+	}
+
+	if len(photoURLs) > maxPhotoURLs {
+		return ErrInvalidPhotoURLs
+	}
+	for _, photoURL := range photoURLs {
+		if !isValidPhotoURL(photoURL) {
+			return ErrInvalidPhotoURLs
+		}
+	}
+
+	return nil
+}
+
+// isValidCoordinates проверяет диапазоны широты и долготы
+func isValidCoordinates(latitude float64, longitude float64) bool {
+	return latitude >= -90 && latitude <= 90 && longitude >= -180 && longitude <= 180
+}
+
+// isValidSearchRadius проверяет радиус поиска
+func isValidSearchRadius(radiusKm float64) bool {
+	return radiusKm > 0 && radiusKm <= maxLostFoundRadiusKm
+}
+
+// isValidPhotoURL проверяет, что ссылка на фотографию - абсолютный http или https адрес
+func isValidPhotoURL(value string) bool {
+	parsed, err := url.Parse(strings.TrimSpace(value))
+	if err != nil {
+		return false
+	}
+
+	return (parsed.Scheme == "http" || parsed.Scheme == "https") && parsed.Host != ""
+}
+
+// lostFoundResponses преобразует список объявлений в ответы
+func lostFoundResponses(reports []models.LostFoundReport) []models.LostFoundResponse {
+	var responses []models.LostFoundResponse
+	for _, report := range reports {
+		responses = append(responses, report.ToResponse())
+	}
+
+	return responses
+}
@@ -0,0 +1,196 @@
+package services
+
+import (
+	"database/sql"
+	"errors"
+	"math"
+	"time"
+
+	"meawle/internal/models"
+	"meawle/internal/repositories"
+)
+
+var (
+	ErrMeasurementNotFound    = errors.New("measurement not found")
+	ErrInvalidMeasurementData = errors.New("measurement date must be YYYY-MM-DD not in the future, weight between 0 and 30 kg")
+	ErrInvalidMeasurementDate = errors.New("period dates must be YYYY-MM-DD")
+)
+
+// MeasurementService представляет сервис для отслеживания веса и роста котов
+type MeasurementService struct {
+	repo         repositories.MeasurementRepository
+	catRepo      repositories.CatRepository
+	alertPercent float64
+}
+
+// NewMeasurementService создает новый экземпляр сервиса измерений.
+// alertPercent задает изменение веса в процентах, при превышении которого измерение помечается тревожным
+func NewMeasurementService(repo repositories.MeasurementRepository, catRepo repositories.CatRepository, alertPercent float64) *MeasurementService {
+	return &MeasurementService{
+		repo:         repo,
+		catRepo:      catRepo,
+		alertPercent: alertPercent,
+	}
+}
+
+// Create добавляет измерение кота
+func (s *MeasurementService) Create(catID int, req *models.MeasurementCreateRequest, userID int, isAdmin bool) (*models.MeasurementResponse, error) {
+	if err := requireCatRole(s.catRepo, catID, userID, isAdmin, models.CatRoleCaretaker); err != nil {
+		return nil, err
+	}
+
+	// Проверяем дату и значения измерения
+	date, err := time.Parse("2006-01-02", req.MeasuredAt)
+	if err != nil || date.After(time.Now()) {
+		return nil, ErrInvalidMeasurementData
+	}
+	if req.WeightKg <= 0 || req.WeightKg > 30 || (req.LengthCm != nil && *req.LengthCm <= 0) {
+		return nil, ErrInvalidMeasurementData
+	}
+
+	measurement := &models.Measurement{
+		CatID:      catID,
+		MeasuredAt: req.MeasuredAt,
+		WeightKg:   req.WeightKg,
+		LengthCm:   req.LengthCm,
+		Notes:      req.Notes,
+		CreatedAt:  time.Now(),
+	}
+
+	if err := s.repo.Create(measurement); err != nil {
+		return nil, err
+	}
+
+	// Текущий вес в профиле кота соответствует последнему измерению
+	if err := s.syncCatWeight(catID); err != nil {
+		return nil, err
+	}
+
+	response := measurement.ToResponse()
+
+	// Сравниваем с предыдущим по дате измерением, чтобы сразу предупредить о резком изменении веса
+	previous, err := s.repo.GetByCatID(catID, nil, &measurement.MeasuredAt)
+	if err != nil {
+		return nil, err
+	}
+	if len(previous) > 1 {
+		response.ChangePercent, response.Alert = s.weightChange(previous[len(previous)-2].WeightKg, measurement.WeightKg)
+	}
+
+	return &response, nil
+}
+
+// GetCatMeasurements возвращает измерения кота с изменением веса относительно предыдущего измерения
+func (s *MeasurementService) GetCatMeasurements(catID int, from, to *string, userID int, isAdmin bool) ([]models.MeasurementResponse, error) {
+	if err := requireCatRole(s.catRepo, catID, userID, isAdmin, models.CatRoleViewer); err != nil {
+		return nil, err
+	}
+
+	if !isValidPeriodDate(from) || !isValidPeriodDate(to) {
+		return nil, ErrInvalidMeasurementDate
+	}
+
+	measurements, err := s.repo.GetByCatID(catID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	var responses []models.MeasurementResponse
+	for i, measurement := range measurements {
+		response := measurement.ToResponse()
+		if i > 0 {
+			response.ChangePercent, response.Alert = s.weightChange(measurements[i-1].WeightKg, measurement.WeightKg)
+		}
+		responses = append(responses, response)
+	}
+
+	return responses, nil
+}
+
+// GetWeeklySeries возвращает средний вес кота по неделям с изменением относительно предыдущей недели
+func (s *MeasurementService) GetWeeklySeries(catID int, from, to *string, userID int, isAdmin bool) ([]models.WeeklyWeightResponse, error) {
+	if err := requireCatRole(s.catRepo, catID, userID, isAdmin, models.CatRoleViewer); err != nil {
+		return nil, err
+	}
+
+	if !isValidPeriodDate(from) || !isValidPeriodDate(to) {
+		return nil, ErrInvalidMeasurementDate
+	}
+
+	weeks, err := s.repo.GetWeeklyAverages(catID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	var responses []models.WeeklyWeightResponse
+	for i, week := range weeks {
+		response := models.WeeklyWeightResponse{
+			WeekStart:       week.WeekStart,
+			AverageWeightKg: roundTo(week.AverageWeightKg, 3),
+			Count:           week.Count,
+		}
+		if i > 0 {
+			response.ChangePercent, response.Alert = s.weightChange(weeks[i-1].AverageWeightKg, week.AverageWeightKg)
+		}
+		responses = append(responses, response)
+	}
+
+	return responses, nil
+}
+
+// DeleteMeasurement удаляет измерение кота
+func (s *MeasurementService) DeleteMeasurement(catID int, measurementID int, userID int, isAdmin bool) error {
+	if err := requireCatRole(s.catRepo, catID, userID, isAdmin, models.CatRoleCaretaker); err != nil {
+		return err
+	}
+
+	measurement, err := s.repo.GetByID(measurementID)
+	if err != nil || measurement.CatID != catID {
+		return ErrMeasurementNotFound
+	}
+
+	if err := s.repo.Delete(measurementID); err != nil {
+		return err
+	}
+
+	return s.syncCatWeight(catID)
+}
+
+// syncCatWeight записывает в профиль кота вес из последнего измерения
+func (s *MeasurementService) syncCatWeight(catID int) error {
+	latest, err := s.repo.GetLatest(catID)
+	if errors.Is(err, sql.ErrNoRows) {
+		// Измерений не осталось - вес в профиле оставляем без изменений
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	return s.catRepo.Update(catID, &models.CatUpdateRequest{WeightKg: &latest.WeightKg})
+}
+
+// weightChange вычисляет изменение веса в процентах и превышение порога тревоги
+func (s *MeasurementService) weightChange(previous, current float64) (*float64, bool) {
+	if previous <= 0 {
+		return nil, false
+	}
+
+	change := roundTo((current-previous)/previous*100, 1)
+	return &change, math.Abs(change) > s.alertPercent
+}
+
+// isValidPeriodDate проверяет необязательную границу периода в формате YYYY-MM-DD
+func isValidPeriodDate(value *string) bool {
+	if value == nil {
+		return true
+	}
+	_, err := time.Parse("2006-01-02", *value)
+	return err == nil
+}
+
+// roundTo округляет число до указанного количества знаков после запятой
+func roundTo(value float64, places int) float64 {
+	factor := math.Pow(10, float64(places))
+	return math.Round(value*factor) / factor
+}
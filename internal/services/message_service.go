@@ -0,0 +1,325 @@
+package services
+
+import (
+	"database/sql"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"meawle/internal/models"
+	"meawle/internal/realtime"
+	"meawle/internal/repositories"
+)
+
+var (
+	ErrConversationNotFound    = errors.New("conversation not found")
+	ErrInvalidConversationData = errors.New("conversation must be about exactly one cat or listing")
+	ErrInvalidMessageData      = errors.New("message must be between 1 and 2000 characters")
+	ErrCannotMessageSelf       = errors.New("users cannot message themselves")
+	ErrCannotBlockSelf         = errors.New("users cannot block themselves")
+	ErrUserBlocked             = errors.New("messaging between these users is blocked")
+	ErrInvalidMessageCursor    = errors.New("invalid message cursor")
+	ErrInvalidMessageLimit     = errors.New("message limit must be between 1 and 100")
+)
+
+const (
+	// maxMessageLength - максимальная длина сообщения в символах
+	maxMessageLength = 2000
+	// defaultMessagesLimit и maxMessagesLimit - размер страницы сообщений
+	defaultMessagesLimit = 50
+	maxMessagesLimit     = 100
+)
+
+// MessageService представляет сервис личных сообщений между пользователями
+type MessageService struct {
+	repo        repositories.MessageRepository
+	blockRepo   repositories.BlockRepository
+	userRepo    repositories.UserRepository
+	catRepo     repositories.CatRepository
+	listingRepo repositories.ListingRepository
+	hub         *realtime.Hub
+}
+
+// NewMessageService создает новый экземпляр сервиса сообщений
+func NewMessageService(
+	repo repositories.MessageRepository,
+	blockRepo repositories.BlockRepository,
+	userRepo repositories.UserRepository,
+	catRepo repositories.CatRepository,
+	listingRepo repositories.ListingRepository,
+	hub *realtime.Hub,
+) *MessageService {
+	return &MessageService{
+		repo:        repo,
+		blockRepo:   blockRepo,
+		userRepo:    userRepo,
+		catRepo:     catRepo,
+		listingRepo: listingRepo,
+		hub:         hub,
+	}
+}
+
+// StartConversation отправляет первое сообщение владельцу кота или автору объявления.
+// Если переписка этих пользователей о том же коте или объявлении уже есть, сообщение добавляется в нее
+func (s *MessageService) StartConversation(req *models.ConversationCreateRequest, userID int, isAdmin bool) (*models.ConversationResponse, error) {
+	if (req.CatID == nil) == (req.ListingID == nil) {
+		return nil, ErrInvalidConversationData
+	}
+
+	body, err := normalizeMessageBody(req.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	conversation := &models.Conversation{ListingID: req.ListingID, CreatedBy: userID}
+	var recipientID int
+
+	if req.ListingID != nil {
+		listing, err := s.listingRepo.GetByID(*req.ListingID)
+		if err != nil {
+			return nil, ErrListingNotFound
+		}
+		if listing.Status == models.ListingStatusDraft && !isAdmin && listing.UserID != userID {
+			return nil, ErrListingNotFound
+		}
+		conversation.CatID = listing.CatID
+		recipientID = listing.UserID
+	} else {
+		cat, err := s.catRepo.GetByID(*req.CatID)
+		if err != nil {
+			return nil, ErrCatNotFound
+		}
+		canView, err := canViewCat(s.catRepo, cat, userID, isAdmin)
+		if err != nil {
+			return nil, err
+		}
+		if !canView {
+			return nil, ErrCatNotFound
+		}
+		conversation.CatID = cat.ID
+		recipientID = cat.UserID
+	}
+
+	if recipientID == userID {
+		return nil, ErrCannotMessageSelf
+	}
+
+	if err := s.checkNotBlocked(userID, recipientID); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.repo.FindConversation(userID, recipientID, conversation.CatID, conversation.ListingID)
+	switch {
+	case err == nil:
+		conversation = existing
+	case errors.Is(err, sql.ErrNoRows):
+		if err := s.repo.CreateConversation(conversation, []int{userID, recipientID}); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, err
+	}
+
+	if _, err := s.send(conversation.ID, body, userID, []int{userID, recipientID}); err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetUserConversation(conversation.ID, userID)
+}
+
+// GetConversations возвращает переписки пользователя с числом непрочитанных сообщений
+func (s *MessageService) GetConversations(userID int) ([]models.ConversationResponse, error) {
+	return s.repo.GetUserConversations(userID)
+}
+
+// SendMessage отправляет сообщение в переписку. Писать могут только ее участники,
+// если никто из них не заблокировал другого
+func (s *MessageService) SendMessage(conversationID int, req *models.MessageCreateRequest, userID int) (*models.Message, error) {
+	body, err := normalizeMessageBody(req.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	participantIDs, err := s.getParticipantIDs(conversationID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, participantID := range participantIDs {
+		if participantID == userID {
+			continue
+		}
+		if err := s.checkNotBlocked(userID, participantID); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.send(conversationID, body, userID, participantIDs)
+}
+
+// GetMessages возвращает страницу сообщений переписки, новые первыми.
+// cursor берется из next_cursor предыдущей страницы, пустой cursor открывает переписку с самых новых сообщений
+func (s *MessageService) GetMessages(conversationID int, cursor string, limit int, userID int) (*models.MessagePage, error) {
+	if limit == 0 {
+		limit = defaultMessagesLimit
+	}
+	if limit < 1 || limit > maxMessagesLimit {
+		return nil, ErrInvalidMessageLimit
+	}
+
+	filter := &models.MessageFilter{ConversationID: conversationID, Limit: limit + 1}
+	if cursor != "" {
+		beforeID, err := strconv.Atoi(cursor)
+		if err != nil || beforeID <= 0 {
+			return nil, ErrInvalidMessageCursor
+		}
+		filter.BeforeID = beforeID
+	}
+
+	if _, err := s.getParticipantIDs(conversationID, userID); err != nil {
+		return nil, err
+	}
+
+	// Запрашиваем на одно сообщение больше, чтобы узнать, есть ли следующая страница
+	messages, err := s.repo.GetMessages(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &models.MessagePage{Messages: []models.Message{}}
+	if len(messages) > limit {
+		messages = messages[:limit]
+		nextCursor := strconv.Itoa(messages[len(messages)-1].ID)
+		page.NextCursor = &nextCursor
+	}
+	page.Messages = append(page.Messages, messages...)
+
+	return page, nil
+}
+
+// MarkRead отмечает переписку прочитанной и сообщает об этом собеседнику
+func (s *MessageService) MarkRead(conversationID int, userID int) error {
+	participantIDs, err := s.getParticipantIDs(conversationID, userID)
+	if err != nil {
+		return err
+	}
+
+	updated, err := s.repo.MarkRead(conversationID, userID)
+	if err != nil {
+		return err
+	}
+
+	if updated {
+		event := models.MessageEvent{Type: models.MessageEventRead, ConversationID: conversationID, ReaderID: userID}
+		for _, participantID := range participantIDs {
+			s.hub.Publish(participantID, event)
+		}
+	}
+
+	return nil
+}
+
+// GetUnreadCount возвращает число непрочитанных сообщений во всех переписках пользователя
+func (s *MessageService) GetUnreadCount(userID int) (*models.UnreadCountResponse, error) {
+	count, err := s.repo.CountUnread(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.UnreadCountResponse{UnreadCount: count}, nil
+}
+
+// Subscribe подписывает подключение пользователя на новые сообщения и отметки о прочтении
+func (s *MessageService) Subscribe(userID int) (<-chan models.MessageEvent, func()) {
+	return s.hub.Subscribe(userID)
+}
+
+// BlockUser блокирует пользователя: заблокированный не сможет начать переписку и писать в существующие
+func (s *MessageService) BlockUser(blockedID int, userID int) error {
+	if blockedID == userID {
+		return ErrCannotBlockSelf
+	}
+
+	if _, err := s.userRepo.GetByID(blockedID); err != nil {
+		return ErrUserNotFound
+	}
+
+	return s.blockRepo.Block(userID, blockedID)
+}
+
+// UnblockUser снимает блокировку пользователя
+func (s *MessageService) UnblockUser(blockedID int, userID int) error {
+	if _, err := s.userRepo.GetByID(blockedID); err != nil {
+		return ErrUserNotFound
+	}
+
+	return s.blockRepo.Unblock(userID, blockedID)
+}
+
+// GetBlockedUsers возвращает пользователей, заблокированных текущим пользователем
+func (s *MessageService) GetBlockedUsers(userID int) ([]models.UserBlock, error) {
+	return s.blockRepo.GetBlocked(userID)
+}
+
+// send сохраняет сообщение и доставляет его подключенным участникам переписки,
+// включая другие подключения отправителя
+func (s *MessageService) send(conversationID int, body string, senderID int, participantIDs []int) (*models.Message, error) {
+	message := &models.Message{
+		ConversationID: conversationID,
+		SenderID:       senderID,
+		Body:           body,
+		CreatedAt:      time.Now().UTC().Truncate(time.Second),
+	}
+
+	if err := s.repo.CreateMessage(message); err != nil {
+		return nil, err
+	}
+
+	event := models.MessageEvent{Type: models.MessageEventNew, ConversationID: conversationID, Message: message}
+	for _, participantID := range participantIDs {
+		s.hub.Publish(participantID, event)
+	}
+
+	return message, nil
+}
+
+// getParticipantIDs возвращает участников переписки. Для посторонних переписка не существует
+func (s *MessageService) getParticipantIDs(conversationID int, userID int) ([]int, error) {
+	participantIDs, err := s.repo.GetParticipantIDs(conversationID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, participantID := range participantIDs {
+		if participantID == userID {
+			return participantIDs, nil
+		}
+	}
+
+	return nil, ErrConversationNotFound
+}
+
+// checkNotBlocked проверяет, что никто из пользователей не заблокировал другого
+func (s *MessageService) checkNotBlocked(userID int, otherID int) error {
+	blocked, err := s.blockRepo.IsBlockedBetween(userID, otherID)
+	if err != nil {
+		return err
+	}
+	if blocked {
+		return ErrUserBlocked
+	}
+
+	return nil
+}
+
+// normalizeMessageBody обрезает пробелы и проверяет длину сообщения
+func normalizeMessageBody(body string) (string, error) {
+	body = strings.TrimSpace(body)
+	if body == "" || utf8.RuneCountInString(body) > maxMessageLength {
+		return "", ErrInvalidMessageData
+	}
+
+	return body, nil
+}
@@ -0,0 +1,418 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"meawle/internal/models"
+	"meawle/internal/notifier"
+	"meawle/internal/repositories"
+)
+
+var (
+	ErrReportNotFound             = errors.New("report not found")
+	ErrInvalidReportTarget        = errors.New("report target must be cat, breed, comment or user")
+	ErrInvalidReportReason        = errors.New("report reason must be spam, abuse, inappropriate or other")
+	ErrInvalidReportDetails       = errors.New("report details and notes must not exceed 1000 characters")
+	ErrCannotReportSelf           = errors.New("users cannot report themselves")
+	ErrReportExists               = errors.New("report for this content is already open")
+	ErrReportResolved             = errors.New("report is already resolved")
+	ErrInvalidReportStatus        = errors.New("report status must be open or resolved")
+	ErrInvalidModerationAction    = errors.New("moderation action must be dismiss, hide, warn or ban")
+	ErrModerationActionNotAllowed = errors.New("users can only be warned or banned")
+	ErrInvalidModerationPaging    = errors.New("limit must be between 1 and 100 and offset not negative")
+)
+
+const (
+	// maxReportTextLength - максимальная длина пояснения к жалобе и решения модератора в символах
+	maxReportTextLength = 1000
+	// defaultModerationQueueLimit - размер страницы очереди модерации по умолчанию
+	defaultModerationQueueLimit = 20
+	// maxModerationQueueLimit - максимальный размер страницы очереди модерации
+	maxModerationQueueLimit = 100
+)
+
+// ModerationService представляет сервис жалоб и модерации контента
+type ModerationService struct {
+	repo        repositories.ModerationRepository
+	userRepo    repositories.UserRepository
+	catRepo     repositories.CatRepository
+	breedRepo   repositories.CatBreedRepository
+	commentRepo repositories.CommentRepository
+	notifier    notifier.Notifier
+}
+
+// NewModerationService создает новый экземпляр сервиса модерации
+func NewModerationService(
+	repo repositories.ModerationRepository,
+	userRepo repositories.UserRepository,
+	catRepo repositories.CatRepository,
+	breedRepo repositories.CatBreedRepository,
+	commentRepo repositories.CommentRepository,
+	notifier notifier.Notifier,
+) *ModerationService {
+	return &ModerationService{
+		repo:        repo,
+		userRepo:    userRepo,
+		catRepo:     catRepo,
+		breedRepo:   breedRepo,
+		commentRepo: commentRepo,
+		notifier:    notifier,
+	}
+}
+
+// CreateReport создает жалобу на кота, породу, комментарий или пользователя.
+// Пожаловаться можно только на видимый пользователю объект, одна открытая жалоба на объект от пользователя
+func (s *ModerationService) CreateReport(req *models.ContentReportCreateRequest, userID int, isAdmin bool) (*models.ContentReport, error) {
+	if !isValidReportReason(req.Reason) {
+		return nil, ErrInvalidReportReason
+	}
+
+	details, err := normalizeReportText(req.Details)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkReportTarget(req.TargetType, req.TargetID, userID, isAdmin); err != nil {
+		return nil, err
+	}
+
+	exists, err := s.repo.HasOpenReport(req.TargetType, req.TargetID, &userID)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, ErrReportExists
+	}
+
+	report := &models.ContentReport{
+		TargetType: req.TargetType,
+		TargetID:   req.TargetID,
+		ReporterID: &userID,
+		Reason:     req.Reason,
+		Details:    details,
+		Status:     models.ReportStatusOpen,
+	}
+
+	if err := s.repo.CreateReport(report); err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetReportByID(report.ID)
+}
+
+// GetQueue возвращает очередь жалоб, старые первыми. Доступно только модераторам и админу
+func (s *ModerationService) GetQueue(filter *models.ContentReportFilter, userID int, isAdmin bool) ([]models.ContentReport, error) {
+	if err := s.requireModerator(userID, isAdmin); err != nil {
+		return nil, err
+	}
+
+	if filter.Status == "" {
+		filter.Status = models.ReportStatusOpen
+	}
+	if filter.Status != models.ReportStatusOpen && filter.Status != models.ReportStatusResolved {
+		return nil, ErrInvalidReportStatus
+	}
+
+	if filter.TargetType != nil && !isValidReportTarget(*filter.TargetType) {
+		return nil, ErrInvalidReportTarget
+	}
+
+	if filter.Limit == 0 {
+		filter.Limit = defaultModerationQueueLimit
+	}
+	if filter.Limit < 1 || filter.Limit > maxModerationQueueLimit || filter.Offset < 0 {
+		return nil, ErrInvalidModerationPaging
+	}
+
+	return s.repo.GetReports(filter)
+}
+
+// GetReport возвращает жалобу по ID. Доступно только модераторам и админу
+func (s *ModerationService) GetReport(id int, userID int, isAdmin bool) (*models.ContentReport, error) {
+	if err := s.requireModerator(userID, isAdmin); err != nil {
+		return nil, err
+	}
+
+	report, err := s.repo.GetReportByID(id)
+	if err != nil {
+		return nil, ErrReportNotFound
+	}
+
+	return report, nil
+}
+
+// ResolveReport применяет решение модератора к объекту жалобы и закрывает все открытые жалобы на него
+func (s *ModerationService) ResolveReport(id int, req *models.ContentReportResolveRequest, userID int, isAdmin bool) (*models.ContentReport, error) {
+	if err := s.requireModerator(userID, isAdmin); err != nil {
+		return nil, err
+	}
+
+	report, err := s.repo.GetReportByID(id)
+	if err != nil {
+		return nil, ErrReportNotFound
+	}
+
+	if report.Status != models.ReportStatusOpen {
+		return nil, ErrReportResolved
+	}
+
+	note, err := normalizeReportText(req.Note)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC().Truncate(time.Second)
+
+	switch req.Action {
+	case models.ModerationActionDismiss:
+	case models.ModerationActionHide:
+		err = s.hideTarget(report, userID, now)
+	case models.ModerationActionWarn:
+		err = s.warnAuthor(report, note, userID)
+	case models.ModerationActionBan:
+		err = s.banAuthor(report, userID, isAdmin, now)
+	default:
+		err = ErrInvalidModerationAction
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.ResolveTarget(report.TargetType, report.TargetID, req.Action, note, userID, now); err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetReportByID(id)
+}
+
+// UnbanUser снимает блокировку с пользователя. Доступно только модераторам и админу
+func (s *ModerationService) UnbanUser(targetUserID int, userID int, isAdmin bool) error {
+	if err := s.requireModerator(userID, isAdmin); err != nil {
+		return err
+	}
+
+	if _, err := s.userRepo.GetByID(targetUserID); err != nil {
+		return ErrUserNotFound
+	}
+
+	return s.userRepo.SetBanned(targetUserID, nil)
+}
+
+// GetUserWarnings возвращает предупреждения, вынесенные пользователю
+func (s *ModerationService) GetUserWarnings(userID int) ([]models.UserWarning, error) {
+	return s.repo.GetWarningsByUserID(userID)
+}
+
+// requireModerator возвращает ErrAccessDenied, если пользователь не модератор и не админ.
+// Роль модератора читается из базы, чтобы ее снятие действовало сразу
+func (s *ModerationService) requireModerator(userID int, isAdmin bool) error {
+	if isAdmin {
+		return nil
+	}
+
+	user, err := s.userRepo.GetByID(userID)
+	if err != nil || !user.IsModerator {
+		return ErrAccessDenied
+	}
+
+	return nil
+}
+
+// checkReportTarget проверяет, что объект жалобы существует и виден пользователю
+func (s *ModerationService) checkReportTarget(targetType string, targetID int, userID int, isAdmin bool) error {
+	switch targetType {
+	case models.ReportTargetCat:
+		cat, err := s.catRepo.GetByID(targetID)
+		if err != nil {
+			return ErrCatNotFound
+		}
+
+		canView, err := canViewCat(s.catRepo, cat, userID, isAdmin)
+		if err != nil {
+			return err
+		}
+		if !canView {
+			return ErrCatNotFound
+		}
+	case models.ReportTargetBreed:
+		if _, err := s.breedRepo.GetByID(targetID); err != nil {
+			return ErrCatBreedNotFound
+		}
+	case models.ReportTargetComment:
+		comment, err := s.commentRepo.GetByID(targetID)
+		if err != nil || comment.DeletedAt != nil {
+			return ErrCommentNotFound
+		}
+	case models.ReportTargetUser:
+		if targetID == userID {
+			return ErrCannotReportSelf
+		}
+		if _, err := s.userRepo.GetByID(targetID); err != nil {
+			return ErrUserNotFound
+		}
+	default:
+		return ErrInvalidReportTarget
+	}
+
+	return nil
+}
+
+// hideTarget скрывает кота, породу или комментарий из публичных списков
+func (s *ModerationService) hideTarget(report *models.ContentReport, moderatorID int, now time.Time) error {
+	switch report.TargetType {
+	case models.ReportTargetCat:
+		if _, err := s.catRepo.GetByID(report.TargetID); err != nil {
+			return ErrCatNotFound
+		}
+		return s.catRepo.SetHidden(report.TargetID, &now)
+	case models.ReportTargetBreed:
+		if _, err := s.breedRepo.GetByID(report.TargetID); err != nil {
+			return ErrCatBreedNotFound
+		}
+		return s.breedRepo.SetHidden(report.TargetID, &now)
+	case models.ReportTargetComment:
+		if _, err := s.commentRepo.GetByID(report.TargetID); err != nil {
+			return ErrCommentNotFound
+		}
+		return s.commentRepo.SetHidden(report.TargetID, true, moderatorID)
+	default:
+		return ErrModerationActionNotAllowed
+	}
+}
+
+// warnAuthor выносит предупреждение автору объекта жалобы и уведомляет его
+func (s *ModerationService) warnAuthor(report *models.ContentReport, note *string, moderatorID int) error {
+	author, err := s.targetAuthor(report)
+	if err != nil {
+		return err
+	}
+
+	reason := fmt.Sprintf("Your %s was reported as %s", report.TargetType, report.Reason)
+	if note != nil {
+		reason = *note
+	}
+
+	warning := &models.UserWarning{
+		UserID:   author.ID,
+		ReportID: &report.ID,
+		Reason:   reason,
+		IssuedBy: moderatorID,
+	}
+	if err := s.repo.CreateWarning(warning); err != nil {
+		return err
+	}
+
+	s.notifyAuthor(author, report, "You have received a warning from moderators", reason)
+	return nil
+}
+
+// banAuthor блокирует автора объекта жалобы. Админа заблокировать нельзя,
+// модератора может заблокировать только админ
+func (s *ModerationService) banAuthor(report *models.ContentReport, moderatorID int, isAdmin bool, now time.Time) error {
+	author, err := s.targetAuthor(report)
+	if err != nil {
+		return err
+	}
+
+	if author.ID == moderatorID || author.IsAdmin || (author.IsModerator && !isAdmin) {
+		return ErrAccessDenied
+	}
+
+	if err := s.userRepo.SetBanned(author.ID, &now); err != nil {
+		return err
+	}
+
+	s.notifyAuthor(author, report, "Your account has been banned", "Your account was banned after a content report review")
+	return nil
+}
+
+// targetAuthor возвращает автора объекта жалобы: владельца кота, автора породы или комментария, самого пользователя
+func (s *ModerationService) targetAuthor(report *models.ContentReport) (*models.User, error) {
+	authorID := report.TargetID
+
+	switch report.TargetType {
+	case models.ReportTargetCat:
+		cat, err := s.catRepo.GetByID(report.TargetID)
+		if err != nil {
+			return nil, ErrCatNotFound
+		}
+		authorID = cat.UserID
+	case models.ReportTargetBreed:
+		breed, err := s.breedRepo.GetByID(report.TargetID)
+		if err != nil {
+			return nil, ErrCatBreedNotFound
+		}
+		authorID = breed.UserID
+	case models.ReportTargetComment:
+		comment, err := s.commentRepo.GetByID(report.TargetID)
+		if err != nil {
+			return nil, ErrCommentNotFound
+		}
+		authorID = comment.UserID
+	}
+
+	author, err := s.userRepo.GetByID(authorID)
+	if err != nil {
+		return nil, ErrUserNotFound
+	}
+
+	return author, nil
+}
+
+// notifyAuthor уведомляет автора о решении модератора. Ошибка доставки не отменяет решение
+func (s *ModerationService) notifyAuthor(author *models.User, report *models.ContentReport, subject string, body string) {
+	notification := notifier.Notification{
+		UserID:  author.ID,
+		Email:   author.Email,
+		Subject: subject,
+		Body:    body,
+		Data: map[string]string{
+			"report_id":   strconv.Itoa(report.ID),
+			"target_type": report.TargetType,
+			"target_id":   strconv.Itoa(report.TargetID),
+		},
+	}
+
+	_ = s.notifier.Notify(context.Background(), notification)
+}
+
+// normalizeReportText обрезает пробелы в пояснении и проверяет его длину, пустое пояснение равно nil
+func normalizeReportText(text *string) (*string, error) {
+	if text == nil {
+		return nil, nil
+	}
+
+	trimmed := strings.TrimSpace(*text)
+	if trimmed == "" {
+		return nil, nil
+	}
+	if utf8.RuneCountInString(trimmed) > maxReportTextLength {
+		return nil, ErrInvalidReportDetails
+	}
+
+	return &trimmed, nil
+}
+
+// isValidReportTarget проверяет тип объекта жалобы
+func isValidReportTarget(targetType string) bool {
+	switch targetType {
+	case models.ReportTargetCat, models.ReportTargetBreed, models.ReportTargetComment, models.ReportTargetUser:
+		return true
+	}
+	return false
+}
+
+// isValidReportReason проверяет причину жалобы
+func isValidReportReason(reason string) bool {
+	switch reason {
+	case models.ReportReasonSpam, models.ReportReasonAbuse, models.ReportReasonInappropriate, models.ReportReasonOther:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,365 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"meawle/internal/models"
+	"meawle/internal/notifier"
+	"meawle/internal/repositories"
+)
+
+var (
+	ErrOrganizationNotFound       = errors.New("organization not found")
+	ErrOrganizationNameExists     = errors.New("organization name already exists")
+	ErrInvalidOrganizationData    = errors.New("invalid organization data")
+	ErrOrganizationMemberNotFound = errors.New("organization member not found")
+	ErrOrganizationMemberExists   = errors.New("user is already a member of the organization")
+	ErrInvalidOrganizationRole    = errors.New("organization role must be admin or staff")
+	ErrLastOrganizationAdmin      = errors.New("organization must keep at least one admin")
+)
+
+// OrganizationService представляет сервис организаций (приютов и питомников) и их сотрудников
+type OrganizationService struct {
+	repo     repositories.OrganizationRepository
+	userRepo repositories.UserRepository
+	notifier notifier.Notifier
+}
+
+// NewOrganizationService создает новый экземпляр сервиса организаций
+func NewOrganizationService(
+	repo repositories.OrganizationRepository,
+	userRepo repositories.UserRepository,
+	notifier notifier.Notifier,
+) *OrganizationService {
+	return &OrganizationService{
+		repo:     repo,
+		userRepo: userRepo,
+		notifier: notifier,
+	}
+}
+
+// Create создает организацию, создатель становится ее администратором
+func (s *OrganizationService) Create(req *models.OrganizationCreateRequest, userID int) (*models.OrganizationResponse, error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, ErrInvalidOrganizationData
+	}
+
+	exists, err := s.repo.ExistsByName(name)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, ErrOrganizationNameExists
+	}
+
+	org := &models.Organization{
+		Name:         name,
+		Description:  req.Description,
+		ContactEmail: req.ContactEmail,
+		CreatedBy:    userID,
+	}
+
+	if err := s.repo.Create(org); err != nil {
+		return nil, err
+	}
+
+	return s.GetOrganization(org.ID, userID)
+}
+
+// GetOrganization возвращает организацию по ID. userID равен 0 для анонимного запроса
+func (s *OrganizationService) GetOrganization(id int, userID int) (*models.OrganizationResponse, error) {
+	org, err := s.repo.GetByID(id)
+	if err != nil {
+		return nil, ErrOrganizationNotFound
+	}
+
+	response := org.ToResponse()
+
+	// Для сотрудника указываем его роль
+	if userID != 0 {
+		role, err := s.repo.GetMemberRole(id, userID)
+		if err != nil {
+			return nil, err
+		}
+		if role != "" {
+			response.MyRole = &role
+		}
+	}
+
+	return &response, nil
+}
+
+// GetAllOrganizations возвращает все организации. userID равен 0 для анонимного запроса
+func (s *OrganizationService) GetAllOrganizations(userID int) ([]models.OrganizationResponse, error) {
+	orgs, err := s.repo.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.organizationResponses(orgs, userID)
+}
+
+// GetUserOrganizations возвращает организации, в которых пользователь является сотрудником
+func (s *OrganizationService) GetUserOrganizations(userID int) ([]models.OrganizationResponse, error) {
+	orgs, err := s.repo.GetByMemberID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.organizationResponses(orgs, userID)
+}
+
+// UpdateOrganization обновляет данные организации
+func (s *OrganizationService) UpdateOrganization(id int, req *models.OrganizationUpdateRequest, userID int, isAdmin bool) error {
+	if err := requireOrganizationRole(s.repo, id, userID, isAdmin, models.OrganizationRoleAdmin); err != nil {
+		return err
+	}
+
+	if req.Name != nil {
+		name := strings.TrimSpace(*req.Name)
+		if name == "" {
+			return ErrInvalidOrganizationData
+		}
+		req.Name = &name
+
+		org, err := s.repo.GetByID(id)
+		if err != nil {
+			return ErrOrganizationNotFound
+		}
+		if name != org.Name {
+			exists, err := s.repo.ExistsByName(name)
+			if err != nil {
+				return err
+			}
+			if exists {
+				return ErrOrganizationNameExists
+			}
+		}
+	}
+
+	return s.repo.Update(id, req)
+}
+
+// DeleteOrganization удаляет организацию. Ее коты и породы остаются у создавших их сотрудников
+func (s *OrganizationService) DeleteOrganization(id int, userID int, isAdmin bool) error {
+	if err := requireOrganizationRole(s.repo, id, userID, isAdmin, models.OrganizationRoleAdmin); err != nil {
+		return err
+	}
+
+	return s.repo.Delete(id)
+}
+
+// GetMembers возвращает сотрудников организации. Список видят сотрудники и админ
+func (s *OrganizationService) GetMembers(id int, userID int, isAdmin bool) ([]models.OrganizationMemberResponse, error) {
+	if err := requireOrganizationRole(s.repo, id, userID, isAdmin, models.OrganizationRoleStaff); err != nil {
+		return nil, err
+	}
+
+	members, err := s.repo.GetMembers(id)
+	if err != nil {
+		return nil, err
+	}
+
+	var responses []models.OrganizationMemberResponse
+	for _, member := range members {
+		responses = append(responses, member.ToResponse())
+	}
+
+	return responses, nil
+}
+
+// AddMember добавляет зарегистрированного пользователя с указанным email в сотрудники организации
+func (s *OrganizationService) AddMember(id int, req *models.OrganizationMemberAddRequest, userID int, isAdmin bool) (*models.OrganizationMemberResponse, error) {
+	if err := requireOrganizationRole(s.repo, id, userID, isAdmin, models.OrganizationRoleAdmin); err != nil {
+		return nil, err
+	}
+
+	if !isValidOrganizationRole(req.Role) {
+		return nil, ErrInvalidOrganizationRole
+	}
+
+	user, err := s.userRepo.GetByEmail(strings.TrimSpace(req.Email))
+	if err != nil {
+		return nil, ErrInviteeNotFound
+	}
+
+	role, err := s.repo.GetMemberRole(id, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if role != "" {
+		return nil, ErrOrganizationMemberExists
+	}
+
+	member := &models.OrganizationMember{
+		OrganizationID: id,
+		UserID:         user.ID,
+		Role:           req.Role,
+	}
+
+	if err := s.repo.AddMember(member); err != nil {
+		return nil, err
+	}
+
+	created, err := s.repo.GetMemberByID(member.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.notify(created)
+
+	response := created.ToResponse()
+	return &response, nil
+}
+
+// UpdateMemberRole изменяет роль сотрудника. В организации всегда остается хотя бы один администратор
+func (s *OrganizationService) UpdateMemberRole(id int, memberID int, req *models.OrganizationMemberUpdateRequest, userID int, isAdmin bool) error {
+	if err := requireOrganizationRole(s.repo, id, userID, isAdmin, models.OrganizationRoleAdmin); err != nil {
+		return err
+	}
+
+	if !isValidOrganizationRole(req.Role) {
+		return ErrInvalidOrganizationRole
+	}
+
+	member, err := s.getMember(id, memberID)
+	if err != nil {
+		return err
+	}
+
+	if member.Role == models.OrganizationRoleAdmin && req.Role != models.OrganizationRoleAdmin {
+		if err := s.checkNotLastAdmin(id); err != nil {
+			return err
+		}
+	}
+
+	return s.repo.UpdateMemberRole(memberID, req.Role)
+}
+
+// RemoveMember удаляет сотрудника из организации. Сотрудник может уйти сам
+func (s *OrganizationService) RemoveMember(id int, memberID int, userID int, isAdmin bool) error {
+	member, err := s.getMember(id, memberID)
+	if err != nil {
+		return err
+	}
+
+	if member.UserID != userID {
+		if err := requireOrganizationRole(s.repo, id, userID, isAdmin, models.OrganizationRoleAdmin); err != nil {
+			return err
+		}
+	}
+
+	if member.Role == models.OrganizationRoleAdmin {
+		if err := s.checkNotLastAdmin(id); err != nil {
+			return err
+		}
+	}
+
+	return s.repo.RemoveMember(memberID)
+}
+
+// getMember возвращает сотрудника, убедившись, что он относится к указанной организации
+func (s *OrganizationService) getMember(id int, memberID int) (*models.OrganizationMember, error) {
+	if _, err := s.repo.GetByID(id); err != nil {
+		return nil, ErrOrganizationNotFound
+	}
+
+	member, err := s.repo.GetMemberByID(memberID)
+	if err != nil || member.OrganizationID != id {
+		return nil, ErrOrganizationMemberNotFound
+	}
+
+	return member, nil
+}
+
+// checkNotLastAdmin возвращает ErrLastOrganizationAdmin, если в организации единственный администратор
+func (s *OrganizationService) checkNotLastAdmin(id int) error {
+	count, err := s.repo.CountAdmins(id)
+	if err != nil {
+		return err
+	}
+	if count <= 1 {
+		return ErrLastOrganizationAdmin
+	}
+
+	return nil
+}
+
+// notify уведомляет пользователя о добавлении в организацию. Ошибка доставки не отменяет добавление
+func (s *OrganizationService) notify(member *models.OrganizationMember) {
+	org, err := s.repo.GetByID(member.OrganizationID)
+	if err != nil {
+		return
+	}
+
+	notification := notifier.Notification{
+		UserID:  member.UserID,
+		Email:   member.Email,
+		Subject: fmt.Sprintf("You have been added to %s as %s", org.Name, member.Role),
+		Body:    fmt.Sprintf("You now have access to the cats of %s", org.Name),
+		Data: map[string]string{
+			"organization_id": strconv.Itoa(member.OrganizationID),
+			"role":            member.Role,
+		},
+	}
+
+	_ = s.notifier.Notify(context.Background(), notification)
+}
+
+// organizationResponses преобразует список организаций в ответы с ролью пользователя
+func (s *OrganizationService) organizationResponses(orgs []models.Organization, userID int) ([]models.OrganizationResponse, error) {
+	// Роли загружаем одним запросом, а не для каждой организации
+	roles := map[int]string{}
+	if userID != 0 {
+		var err error
+		roles, err = s.repo.GetUserRoles(userID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var responses []models.OrganizationResponse
+	for _, org := range orgs {
+		response := org.ToResponse()
+		if role, ok := roles[org.ID]; ok {
+			response.MyRole = &role
+		}
+		responses = append(responses, response)
+	}
+
+	return responses, nil
+}
+
+// requireOrganizationRole проверяет существование организации и роль пользователя в ней:
+// для staff достаточно быть любым сотрудником, для admin нужна роль администратора.
+// Админ сервиса имеет доступ ко всем организациям
+func requireOrganizationRole(repo repositories.OrganizationRepository, id int, userID int, isAdmin bool, required string) error {
+	if _, err := repo.GetByID(id); err != nil {
+		return ErrOrganizationNotFound
+	}
+
+	if isAdmin {
+		return nil
+	}
+
+	role, err := repo.GetMemberRole(id, userID)
+	if err != nil {
+		return err
+	}
+	if role == "" || (required == models.OrganizationRoleAdmin && role != models.OrganizationRoleAdmin) {
+		return ErrAccessDenied
+	}
+
+	return nil
+}
+
+// isValidOrganizationRole проверяет роль сотрудника организации
+func isValidOrganizationRole(role string) bool {
+	_, ok := models.OrganizationCatRoles[role]
+	return ok
+}
@@ -0,0 +1,379 @@
+package services
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+
+	"meawle/internal/models"
+	"meawle/internal/repositories"
+)
+
+var (
+	ErrExternalAncestorNotFound = errors.New("external ancestor not found")
+	ErrInvalidAncestorData      = errors.New("invalid external ancestor data")
+	ErrInvalidGenerations       = errors.New("invalid number of generations")
+)
+
+// Ограничения глубины родословной
+const (
+	DefaultPedigreeGenerations = 4
+	MaxPedigreeGenerations     = 10
+)
+
+// PedigreeService представляет сервис для работы с родословными котов
+type PedigreeService struct {
+	repo    repositories.PedigreeRepository
+	catRepo repositories.CatRepository
+}
+
+// NewPedigreeService создает новый экземпляр сервиса родословных
+func NewPedigreeService(repo repositories.PedigreeRepository, catRepo repositories.CatRepository) *PedigreeService {
+	return &PedigreeService{
+		repo:    repo,
+		catRepo: catRepo,
+	}
+}
+
+// CreateExternalAncestor создает предка, не зарегистрированного в системе
+func (s *PedigreeService) CreateExternalAncestor(req *models.ExternalAncestorCreateRequest, userID int) (*models.ExternalAncestorResponse, error) {
+	if strings.TrimSpace(req.Name) == "" {
+		return nil, ErrInvalidAncestorData
+	}
+
+	if req.Sex != nil && !isValidCatSex(*req.Sex) {
+		return nil, ErrInvalidCatSex
+	}
+
+	if req.RegistrationNumber != nil && strings.TrimSpace(*req.RegistrationNumber) == "" {
+		return nil, ErrInvalidAncestorData
+	}
+
+	// Родители внешнего предка уже должны существовать, поэтому циклы среди внешних предков невозможны
+	if req.SireID != nil {
+		if err := checkExternalParent(s.repo, *req.SireID, models.CatSexMale); err != nil {
+			return nil, err
+		}
+	}
+
+	if req.DamID != nil {
+		if err := checkExternalParent(s.repo, *req.DamID, models.CatSexFemale); err != nil {
+			return nil, err
+		}
+	}
+
+	ancestor := &models.ExternalAncestor{
+		Name:               strings.TrimSpace(req.Name),
+		Sex:                req.Sex,
+		RegistrationNumber: req.RegistrationNumber,
+		SireID:             req.SireID,
+		DamID:              req.DamID,
+		UserID:             userID,
+	}
+
+	if err := s.repo.CreateExternalAncestor(ancestor); err != nil {
+		return nil, err
+	}
+
+	// Перечитываем запись, чтобы получить дату создания из базы
+	created, err := s.repo.GetExternalAncestorByID(ancestor.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := created.ToResponse()
+	return &response, nil
+}
+
+// GetExternalAncestor возвращает внешнего предка по ID
+func (s *PedigreeService) GetExternalAncestor(id int) (*models.ExternalAncestorResponse, error) {
+	ancestor, err := s.repo.GetExternalAncestorByID(id)
+	if err != nil {
+		return nil, ErrExternalAncestorNotFound
+	}
+
+	response := ancestor.ToResponse()
+	return &response, nil
+}
+
+// GetPedigree возвращает дерево предков кота на указанное число поколений
+// вместе с коэффициентом инбридинга, вычисленным по этому дереву
+func (s *PedigreeService) GetPedigree(catID int, generations int, userID int, isAdmin bool) (*models.PedigreeResponse, error) {
+	if generations < 1 || generations > MaxPedigreeGenerations {
+		return nil, ErrInvalidGenerations
+	}
+
+	cat, err := s.getVisibleCat(catID, userID, isAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	links, err := s.repo.GetAncestorLinks(catID, generations)
+	if err != nil {
+		return nil, err
+	}
+
+	// Скрытые от пользователя предки остаются в дереве, но без ID и имени
+	visible := make(map[int]bool)
+	for i := range links {
+		if links[i].ParentKind != models.PedigreeKindCat {
+			continue
+		}
+		canView, err := s.isCatVisible(links[i].ParentID, userID, isAdmin, visible)
+		if err != nil {
+			return nil, err
+		}
+		links[i].ParentHidden = !canView
+	}
+
+	pedigree := newPedigreeGraph(links, generations)
+	root := pedigreeKey(models.PedigreeKindCat, cat.ID)
+
+	return &models.PedigreeResponse{
+		CatID:                 cat.ID,
+		Generations:           generations,
+		InbreedingCoefficient: roundTo(pedigree.inbreeding(root), 6),
+		Tree:                  pedigree.buildNode(models.PedigreeKindCat, cat.ID, cat.Name, cat.Sex, 0, generations),
+	}, nil
+}
+
+// GetDescendants возвращает зарегистрированных потомков кота на указанное число поколений
+func (s *PedigreeService) GetDescendants(catID int, generations int, userID int, isAdmin bool) ([]models.CatDescendant, error) {
+	if generations < 1 || generations > MaxPedigreeGenerations {
+		return nil, ErrInvalidGenerations
+	}
+
+	if _, err := s.getVisibleCat(catID, userID, isAdmin); err != nil {
+		return nil, err
+	}
+
+	descendants, err := s.repo.GetDescendants(catID, generations)
+	if err != nil {
+		return nil, err
+	}
+
+	// Потомков, которых пользователь не видит, пропускаем
+	visible := make(map[int]bool)
+	var result []models.CatDescendant
+	for _, descendant := range descendants {
+		canView, err := s.isCatVisible(descendant.ID, userID, isAdmin, visible)
+		if err != nil {
+			return nil, err
+		}
+		if canView {
+			result = append(result, descendant)
+		}
+	}
+
+	return result, nil
+}
+
+// isCatVisible проверяет, видит ли пользователь кота, и запоминает результат в cache.
+// Удаленный кот считается невидимым
+func (s *PedigreeService) isCatVisible(catID int, userID int, isAdmin bool, cache map[int]bool) (bool, error) {
+	if canView, ok := cache[catID]; ok {
+		return canView, nil
+	}
+
+	cat, err := s.catRepo.GetByID(catID)
+	if err != nil {
+		cache[catID] = false
+		return false, nil
+	}
+
+	canView, err := canViewCat(s.catRepo, cat, userID, isAdmin)
+	if err != nil {
+		return false, err
+	}
+
+	cache[catID] = canView
+	return canView, nil
+}
+
+// getVisibleCat возвращает кота, если пользователь может его видеть. Скрытый кот для посторонних не существует
+func (s *PedigreeService) getVisibleCat(catID int, userID int, isAdmin bool) (*models.Cat, error) {
+	cat, err := s.catRepo.GetByID(catID)
+	if err != nil {
+		return nil, ErrCatNotFound
+	}
+
+	canView, err := canViewCat(s.catRepo, cat, userID, isAdmin)
+	if err != nil {
+		return nil, err
+	}
+	if !canView {
+		return nil, ErrCatNotFound
+	}
+
+	return cat, nil
+}
+
+// pedigreeGraph хранит родителей узлов родословной, полученных рекурсивным запросом
+type pedigreeGraph struct {
+	parents     map[string]map[string]models.PedigreeLink // ключ узла -> роль -> связь с родителем
+	generations int
+	inbreeds    map[string]float64
+	inProgress  map[string]bool
+}
+
+// newPedigreeGraph строит граф родословной из связей "потомок - родитель"
+func newPedigreeGraph(links []models.PedigreeLink, generations int) *pedigreeGraph {
+	g := &pedigreeGraph{
+		parents:     make(map[string]map[string]models.PedigreeLink),
+		generations: generations,
+		inbreeds:    make(map[string]float64),
+		inProgress:  make(map[string]bool),
+	}
+
+	for _, link := range links {
+		child := pedigreeKey(link.ChildKind, link.ChildID)
+		if g.parents[child] == nil {
+			g.parents[child] = make(map[string]models.PedigreeLink)
+		}
+		g.parents[child][link.Role] = link
+	}
+
+	return g
+}
+
+// pedigreeKey возвращает ключ узла родословной, уникальный среди котов и внешних предков
+func pedigreeKey(kind string, id int) string {
+	return kind + ":" + strconv.Itoa(id)
+}
+
+// buildNode рекурсивно строит узел дерева родословной до указанного поколения
+func (g *pedigreeGraph) buildNode(kind string, id int, name string, sex *string, generation, generations int) *models.PedigreeNode {
+	node := &models.PedigreeNode{
+		ID:         id,
+		Kind:       kind,
+		Name:       name,
+		Sex:        sex,
+		Generation: generation,
+	}
+
+	if generation >= generations {
+		return node
+	}
+
+	parents := g.parents[pedigreeKey(kind, id)]
+	if sire, ok := parents[models.PedigreeRoleSire]; ok {
+		node.Sire = g.buildParentNode(sire, generation+1, generations)
+	}
+	if dam, ok := parents[models.PedigreeRoleDam]; ok {
+		node.Dam = g.buildParentNode(dam, generation+1, generations)
+	}
+
+	return node
+}
+
+// buildParentNode строит узел родителя по связи. У скрытого кота убираются ID и имя,
+// а его открытые предки показываются как обычно
+func (g *pedigreeGraph) buildParentNode(link models.PedigreeLink, generation, generations int) *models.PedigreeNode {
+	node := g.buildNode(link.ParentKind, link.ParentID, link.ParentName, link.ParentSex, generation, generations)
+	if link.ParentHidden {
+		node.ID = 0
+		node.Name = ""
+		node.Hidden = true
+	}
+
+	return node
+}
+
+// inbreeding вычисляет коэффициент инбридинга узла по формуле Райта:
+// F = Σ (1/2)^(n1+n2+1) * (1 + F_A), где суммирование идет по всем общим предкам A отца и матери
+// и по всем парам путей к ним, не имеющим других общих узлов, а n1 и n2 - длины путей от отца и от матери.
+// Учитываются только предки, попавшие в запрошенное число поколений
+func (g *pedigreeGraph) inbreeding(key string) float64 {
+	if value, ok := g.inbreeds[key]; ok {
+		return value
+	}
+
+	// Защита от зацикливания на некорректных данных
+	if g.inProgress[key] {
+		return 0
+	}
+	g.inProgress[key] = true
+	defer delete(g.inProgress, key)
+
+	parents := g.parents[key]
+	sire, hasSire := parents[models.PedigreeRoleSire]
+	dam, hasDam := parents[models.PedigreeRoleDam]
+	if !hasSire || !hasDam {
+		g.inbreeds[key] = 0
+		return 0
+	}
+
+	sirePaths := g.ancestorPaths(pedigreeKey(sire.ParentKind, sire.ParentID), g.generations)
+	damPaths := g.ancestorPaths(pedigreeKey(dam.ParentKind, dam.ParentID), g.generations)
+
+	coefficient := 0.0
+	for _, sirePath := range sirePaths {
+		for _, damPath := range damPaths {
+			ancestor := sirePath[len(sirePath)-1]
+			if damPath[len(damPath)-1] != ancestor || !pathsDisjoint(sirePath, damPath) {
+				continue
+			}
+
+			n1 := len(sirePath) - 1
+			n2 := len(damPath) - 1
+			coefficient += math.Pow(0.5, float64(n1+n2+1)) * (1 + g.inbreeding(ancestor))
+		}
+	}
+
+	g.inbreeds[key] = coefficient
+	return coefficient
+}
+
+// ancestorPaths возвращает все пути от узла вверх к каждому из его предков не глубже depth поколений,
+// включая путь из самого узла. Пути, проходящие через один узел дважды, возможны только на
+// зацикленных данных и пропускаются
+func (g *pedigreeGraph) ancestorPaths(key string, depth int) [][]string {
+	paths := [][]string{{key}}
+	if depth == 0 {
+		return paths
+	}
+
+	for _, role := range []string{models.PedigreeRoleSire, models.PedigreeRoleDam} {
+		link, ok := g.parents[key][role]
+		if !ok {
+			continue
+		}
+
+		for _, parentPath := range g.ancestorPaths(pedigreeKey(link.ParentKind, link.ParentID), depth-1) {
+			if containsKey(parentPath, key) {
+				continue
+			}
+			path := append([]string{key}, parentPath...)
+			paths = append(paths, path)
+		}
+	}
+
+	return paths
+}
+
+// pathsDisjoint проверяет, что пути к общему предку не пересекаются ни в одном узле, кроме него самого
+func pathsDisjoint(a, b []string) bool {
+	seen := make(map[string]bool, len(a))
+	for _, key := range a[:len(a)-1] {
+		seen[key] = true
+	}
+
+	for _, key := range b[:len(b)-1] {
+		if seen[key] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// containsKey проверяет, входит ли узел в путь
+func containsKey(path []string, key string) bool {
+	for _, k := range path {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,112 @@
+package services
+
+import (
+	"math"
+	"testing"
+
+	"meawle/internal/models"
+)
+
+// parentLink описывает связь кота с родителем-котом для тестового графа
+func parentLink(child, parent int, role string) models.PedigreeLink {
+	return models.PedigreeLink{
+		ChildKind:  models.PedigreeKindCat,
+		ChildID:    child,
+		ParentKind: models.PedigreeKindCat,
+		ParentID:   parent,
+		Role:       role,
+	}
+}
+
+func sire(child, parent int) models.PedigreeLink {
+	return parentLink(child, parent, models.PedigreeRoleSire)
+}
+
+func dam(child, parent int) models.PedigreeLink {
+	return parentLink(child, parent, models.PedigreeRoleDam)
+}
+
+func TestPedigreeInbreeding(t *testing.T) {
+	tests := []struct {
+		name  string
+		links []models.PedigreeLink
+		want  float64
+	}{
+		{
+			name: "no parents",
+			want: 0,
+		},
+		{
+			name:  "missing dam",
+			links: []models.PedigreeLink{sire(1, 2), sire(2, 4), dam(2, 5)},
+			want:  0,
+		},
+		{
+			name:  "unrelated parents",
+			links: []models.PedigreeLink{sire(1, 2), dam(1, 3), sire(2, 4), dam(2, 5), sire(3, 6), dam(3, 7)},
+			want:  0,
+		},
+		{
+			name:  "full siblings",
+			links: []models.PedigreeLink{sire(1, 2), dam(1, 3), sire(2, 4), dam(2, 5), sire(3, 4), dam(3, 5)},
+			want:  0.25,
+		},
+		{
+			name:  "half siblings",
+			links: []models.PedigreeLink{sire(1, 2), dam(1, 3), sire(2, 4), dam(2, 5), sire(3, 4), dam(3, 6)},
+			want:  0.125,
+		},
+		{
+			name:  "half siblings with a missing parent on one side",
+			links: []models.PedigreeLink{sire(1, 2), dam(1, 3), sire(2, 4), sire(3, 4), dam(3, 6)},
+			want:  0.125,
+		},
+		{
+			name:  "father and daughter",
+			links: []models.PedigreeLink{sire(1, 2), dam(1, 3), sire(3, 2), dam(3, 4)},
+			want:  0.25,
+		},
+		{
+			name: "half siblings with an inbred common ancestor",
+			links: []models.PedigreeLink{
+				sire(1, 2), dam(1, 3), sire(2, 4), dam(2, 5), sire(3, 4), dam(3, 6),
+				sire(4, 7), dam(4, 8), sire(7, 9), dam(7, 10), sire(8, 9), dam(8, 10),
+			},
+			want: 0.125 * 1.25,
+		},
+		{
+			name:  "cycle through the parents",
+			links: []models.PedigreeLink{sire(1, 2), dam(1, 3), sire(2, 3), dam(3, 2)},
+			want:  0.5,
+		},
+		{
+			name:  "cat is its own parent",
+			links: []models.PedigreeLink{sire(1, 1), dam(1, 2)},
+			want:  0.25,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := newPedigreeGraph(tt.links, MaxPedigreeGenerations)
+			got := g.inbreeding(pedigreeKey(models.PedigreeKindCat, 1))
+			if math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("inbreeding() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPedigreeInbreedingMixesCatsAndExternalAncestors(t *testing.T) {
+	// Общий предок - внешний кот с тем же ID, что и у одного из котов: ключи узлов не должны совпасть
+	links := []models.PedigreeLink{
+		sire(1, 2), dam(1, 3),
+		{ChildKind: models.PedigreeKindCat, ChildID: 2, ParentKind: models.PedigreeKindExternal, ParentID: 2, Role: models.PedigreeRoleSire},
+		{ChildKind: models.PedigreeKindCat, ChildID: 3, ParentKind: models.PedigreeKindExternal, ParentID: 2, Role: models.PedigreeRoleSire},
+	}
+
+	g := newPedigreeGraph(links, MaxPedigreeGenerations)
+	if got := g.inbreeding(pedigreeKey(models.PedigreeKindCat, 1)); math.Abs(got-0.125) > 1e-9 {
+		t.Errorf("inbreeding() = %v, want 0.125", got)
+	}
+}
@@ -0,0 +1,229 @@
+package services
+
+import (
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var ErrInvalidRecurrenceRule = errors.New("invalid recurrence rule")
+
+// Частоты повторения, поддерживаемые подмножеством RRULE
+const (
+	FreqDaily   = "DAILY"
+	FreqWeekly  = "WEEKLY"
+	FreqMonthly = "MONTHLY"
+	FreqYearly  = "YEARLY"
+)
+
+// maxRecurrenceIterations ограничивает перебор повторений, чтобы некорректное правило не зациклило поиск
+const maxRecurrenceIterations = 100000
+
+// weekdayCodes сопоставляет коды дней недели RFC 5545 и time.Weekday
+var weekdayCodes = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+// RecurrenceRule представляет подмножество RRULE (RFC 5545):
+// FREQ=DAILY|WEEKLY|MONTHLY|YEARLY, INTERVAL, COUNT, UNTIL и BYDAY для еженедельных правил
+type RecurrenceRule struct {
+	Freq     string
+	Interval int
+	Count    int
+	Until    *time.Time
+	ByDay    []time.Weekday
+}
+
+// ParseRecurrenceRule разбирает строку RRULE, например "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,TH"
+func ParseRecurrenceRule(value string) (*RecurrenceRule, error) {
+	value = strings.TrimPrefix(strings.TrimSpace(value), "RRULE:")
+	if value == "" {
+		return nil, ErrInvalidRecurrenceRule
+	}
+
+	rule := &RecurrenceRule{Interval: 1}
+
+	for _, part := range strings.Split(value, ";") {
+		key, val, ok := strings.Cut(part, "=")
+		if !ok || val == "" {
+			return nil, ErrInvalidRecurrenceRule
+		}
+
+		switch strings.ToUpper(key) {
+		case "FREQ":
+			freq := strings.ToUpper(val)
+			if freq != FreqDaily && freq != FreqWeekly && freq != FreqMonthly && freq != FreqYearly {
+				return nil, ErrInvalidRecurrenceRule
+			}
+			rule.Freq = freq
+		case "INTERVAL":
+			interval, err := strconv.Atoi(val)
+			if err != nil || interval < 1 {
+				return nil, ErrInvalidRecurrenceRule
+			}
+			rule.Interval = interval
+		case "COUNT":
+			count, err := strconv.Atoi(val)
+			if err != nil || count < 1 {
+				return nil, ErrInvalidRecurrenceRule
+			}
+			rule.Count = count
+		case "UNTIL":
+			until, err := parseRecurrenceUntil(val)
+			if err != nil {
+				return nil, ErrInvalidRecurrenceRule
+			}
+			rule.Until = &until
+		case "BYDAY":
+			for _, code := range strings.Split(strings.ToUpper(val), ",") {
+				day, ok := weekdayCodes[code]
+				if !ok {
+					return nil, ErrInvalidRecurrenceRule
+				}
+				rule.ByDay = append(rule.ByDay, day)
+			}
+		default:
+			return nil, ErrInvalidRecurrenceRule
+		}
+	}
+
+	if rule.Freq == "" {
+		return nil, ErrInvalidRecurrenceRule
+	}
+
+	// COUNT и UNTIL взаимоисключающие по RFC 5545, BYDAY поддерживается только для WEEKLY
+	if rule.Count > 0 && rule.Until != nil {
+		return nil, ErrInvalidRecurrenceRule
+	}
+	if len(rule.ByDay) > 0 && rule.Freq != FreqWeekly {
+		return nil, ErrInvalidRecurrenceRule
+	}
+
+	return rule, nil
+}
+
+// String возвращает правило в нормализованном виде RRULE
+func (r *RecurrenceRule) String() string {
+	parts := []string{"FREQ=" + r.Freq}
+
+	if r.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+
+	if r.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	}
+
+	if r.Until != nil {
+		parts = append(parts, "UNTIL="+r.Until.UTC().Format("20060102T150405Z"))
+	}
+
+	if len(r.ByDay) > 0 {
+		codes := make([]string, 0, len(r.ByDay))
+		for _, day := range r.ByDay {
+			for code, weekday := range weekdayCodes {
+				if weekday == day {
+					codes = append(codes, code)
+				}
+			}
+		}
+		parts = append(parts, "BYDAY="+strings.Join(codes, ","))
+	}
+
+	return strings.Join(parts, ";")
+}
+
+// Next возвращает первое повторение события, начинающегося в start, строго после момента after.
+// Второе значение false означает, что повторений больше нет
+func (r *RecurrenceRule) Next(start, after time.Time) (time.Time, bool) {
+	emitted := 0
+
+	for period := 0; period < maxRecurrenceIterations; period++ {
+		for _, occurrence := range r.periodOccurrences(start, period) {
+			if r.Until != nil && occurrence.After(*r.Until) {
+				return time.Time{}, false
+			}
+
+			emitted++
+			if r.Count > 0 && emitted > r.Count {
+				return time.Time{}, false
+			}
+
+			if occurrence.After(after) {
+				return occurrence, true
+			}
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// periodOccurrences возвращает повторения в пределах одного периода правила в хронологическом порядке
+func (r *RecurrenceRule) periodOccurrences(start time.Time, period int) []time.Time {
+	step := period * r.Interval
+
+	switch r.Freq {
+	case FreqDaily:
+		return []time.Time{start.AddDate(0, 0, step)}
+	case FreqWeekly:
+		if len(r.ByDay) == 0 {
+			return []time.Time{start.AddDate(0, 0, 7*step)}
+		}
+
+		// Недели начинаются с понедельника (WKST=MO)
+		offset := (int(start.Weekday()) + 6) % 7
+		weekStart := start.AddDate(0, 0, 7*step-offset)
+
+		var occurrences []time.Time
+		for _, day := range r.ByDay {
+			occurrence := weekStart.AddDate(0, 0, (int(day)+6)%7)
+			if !occurrence.Before(start) {
+				occurrences = append(occurrences, occurrence)
+			}
+		}
+		sort.Slice(occurrences, func(i, j int) bool { return occurrences[i].Before(occurrences[j]) })
+		return occurrences
+	case FreqMonthly:
+		// Месяцы без нужного числа (например, 31-го) пропускаются, как требует RFC 5545
+		occurrence := start.AddDate(0, step, 0)
+		if occurrence.Day() != start.Day() {
+			return nil
+		}
+		return []time.Time{occurrence}
+	case FreqYearly:
+		occurrence := start.AddDate(step, 0, 0)
+		if occurrence.Day() != start.Day() {
+			return nil
+		}
+		return []time.Time{occurrence}
+	}
+
+	return nil
+}
+
+// parseRecurrenceUntil разбирает значение UNTIL в формате даты или даты-времени RFC 5545
+func parseRecurrenceUntil(value string) (time.Time, error) {
+	layouts := []string{"20060102T150405Z", "20060102T150405", "20060102"}
+
+	var err error
+	for _, layout := range layouts {
+		var t time.Time
+		if t, err = time.Parse(layout, value); err == nil {
+			// Дата без времени включает весь указанный день
+			if layout == "20060102" {
+				t = t.Add(24*time.Hour - time.Second)
+			}
+			return t, nil
+		}
+	}
+
+	return time.Time{}, err
+}
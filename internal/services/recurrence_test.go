@@ -0,0 +1,211 @@
+package services
+
+import (
+	"testing"
+	"time"
+)
+
+func date(year int, month time.Month, day, hour int) time.Time {
+	return time.Date(year, month, day, hour, 0, 0, 0, time.UTC)
+}
+
+func TestRecurrenceRuleNext(t *testing.T) {
+	tests := []struct {
+		name   string
+		rrule  string
+		start  time.Time
+		after  time.Time
+		want   time.Time
+		wantOK bool
+	}{
+		{
+			name:   "daily with interval",
+			rrule:  "FREQ=DAILY;INTERVAL=2",
+			start:  date(2026, time.March, 1, 9),
+			after:  date(2026, time.March, 4, 12),
+			want:   date(2026, time.March, 5, 9),
+			wantOK: true,
+		},
+		{
+			name:   "first occurrence is the start",
+			rrule:  "FREQ=DAILY",
+			start:  date(2026, time.March, 1, 9),
+			after:  date(2026, time.February, 1, 0),
+			want:   date(2026, time.March, 1, 9),
+			wantOK: true,
+		},
+		{
+			name:   "weekly by day skips days before the start",
+			rrule:  "FREQ=WEEKLY;BYDAY=MO,TH",
+			start:  date(2026, time.October, 14, 9), // среда
+			after:  date(2026, time.October, 14, 9),
+			want:   date(2026, time.October, 15, 9),
+			wantOK: true,
+		},
+		{
+			name:   "weekly by day moves to the next week",
+			rrule:  "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,TH",
+			start:  date(2026, time.October, 14, 9),
+			after:  date(2026, time.October, 15, 9),
+			want:   date(2026, time.October, 26, 9),
+			wantOK: true,
+		},
+		{
+			name:   "monthly on the 31st skips february",
+			rrule:  "FREQ=MONTHLY",
+			start:  date(2026, time.January, 31, 9),
+			after:  date(2026, time.January, 31, 9),
+			want:   date(2026, time.March, 31, 9),
+			wantOK: true,
+		},
+		{
+			name:   "monthly on the 31st skips 30-day months",
+			rrule:  "FREQ=MONTHLY",
+			start:  date(2026, time.January, 31, 9),
+			after:  date(2026, time.March, 31, 9),
+			want:   date(2026, time.May, 31, 9),
+			wantOK: true,
+		},
+		{
+			name:   "monthly on the 30th skips february",
+			rrule:  "FREQ=MONTHLY",
+			start:  date(2026, time.January, 30, 9),
+			after:  date(2026, time.February, 1, 0),
+			want:   date(2026, time.March, 30, 9),
+			wantOK: true,
+		},
+		{
+			name:   "monthly on the 29th keeps leap february",
+			rrule:  "FREQ=MONTHLY",
+			start:  date(2028, time.January, 29, 9),
+			after:  date(2028, time.January, 29, 9),
+			want:   date(2028, time.February, 29, 9),
+			wantOK: true,
+		},
+		{
+			name:   "yearly on february 29th waits for a leap year",
+			rrule:  "FREQ=YEARLY",
+			start:  date(2024, time.February, 29, 9),
+			after:  date(2024, time.February, 29, 9),
+			want:   date(2028, time.February, 29, 9),
+			wantOK: true,
+		},
+		{
+			name:   "count does not include skipped months",
+			rrule:  "FREQ=MONTHLY;COUNT=2",
+			start:  date(2026, time.January, 31, 9),
+			after:  date(2026, time.February, 1, 0),
+			want:   date(2026, time.March, 31, 9),
+			wantOK: true,
+		},
+		{
+			name:   "count exhausted",
+			rrule:  "FREQ=MONTHLY;COUNT=2",
+			start:  date(2026, time.January, 31, 9),
+			after:  date(2026, time.March, 31, 9),
+			wantOK: false,
+		},
+		{
+			name:   "until as a date includes the whole day",
+			rrule:  "FREQ=DAILY;UNTIL=20260305",
+			start:  date(2026, time.March, 1, 9),
+			after:  date(2026, time.March, 4, 9),
+			want:   date(2026, time.March, 5, 9),
+			wantOK: true,
+		},
+		{
+			name:   "until passed",
+			rrule:  "FREQ=DAILY;UNTIL=20260305T000000Z",
+			start:  date(2026, time.March, 1, 9),
+			after:  date(2026, time.March, 4, 9),
+			wantOK: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := ParseRecurrenceRule(tt.rrule)
+			if err != nil {
+				t.Fatalf("ParseRecurrenceRule(%q): %v", tt.rrule, err)
+			}
+
+			got, ok := rule.Next(tt.start, tt.after)
+			if ok != tt.wantOK {
+				t.Fatalf("Next() ok = %v, want %v (got %v)", ok, tt.wantOK, got)
+			}
+			if ok && !got.Equal(tt.want) {
+				t.Errorf("Next() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseRecurrenceRule(t *testing.T) {
+	tests := []struct {
+		rrule   string
+		want    string
+		wantErr bool
+	}{
+		{rrule: "RRULE:freq=weekly;interval=1;byday=mo,th", want: "FREQ=WEEKLY;BYDAY=MO,TH"},
+		{rrule: "FREQ=MONTHLY;INTERVAL=3;COUNT=4", want: "FREQ=MONTHLY;INTERVAL=3;COUNT=4"},
+		{rrule: "FREQ=YEARLY;UNTIL=20300101", want: "FREQ=YEARLY;UNTIL=20300101T235959Z"},
+		{rrule: "", wantErr: true},
+		{rrule: "INTERVAL=2", wantErr: true},
+		{rrule: "FREQ=HOURLY", wantErr: true},
+		{rrule: "FREQ=DAILY;INTERVAL=0", wantErr: true},
+		{rrule: "FREQ=DAILY;COUNT=2;UNTIL=20300101", wantErr: true},
+		{rrule: "FREQ=MONTHLY;BYDAY=MO", wantErr: true},
+		{rrule: "FREQ=WEEKLY;BYDAY=XX", wantErr: true},
+		{rrule: "FREQ=DAILY;BYMONTH=1", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.rrule, func(t *testing.T) {
+			rule, err := ParseRecurrenceRule(tt.rrule)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ParseRecurrenceRule(%q) = %v, want error", tt.rrule, rule)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseRecurrenceRule(%q): %v", tt.rrule, err)
+			}
+			if got := rule.String(); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNextReminderRun(t *testing.T) {
+	monthly := "FREQ=MONTHLY"
+	now := date(2026, time.April, 1, 0)
+
+	tests := []struct {
+		name     string
+		startsAt time.Time
+		rrule    *string
+		want     *time.Time
+	}{
+		{name: "one-off in the future", startsAt: date(2026, time.April, 2, 9), want: ptrTime(date(2026, time.April, 2, 9))},
+		{name: "one-off in the past", startsAt: date(2026, time.March, 31, 9), want: nil},
+		{name: "recurring from the end of month", startsAt: date(2026, time.January, 31, 9), rrule: &monthly, want: ptrTime(date(2026, time.May, 31, 9))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := nextReminderRun(tt.startsAt, tt.rrule, now)
+			if err != nil {
+				t.Fatalf("nextReminderRun: %v", err)
+			}
+			if (got == nil) != (tt.want == nil) || (got != nil && !got.Equal(*tt.want)) {
+				t.Errorf("nextReminderRun() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func ptrTime(t time.Time) *time.Time {
+	return &t
+}
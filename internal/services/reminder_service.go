@@ -0,0 +1,324 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"meawle/internal/models"
+	"meawle/internal/notifier"
+	"meawle/internal/repositories"
+)
+
+var (
+	ErrReminderNotFound        = errors.New("reminder not found")
+	ErrInvalidReminderData     = errors.New("invalid reminder data")
+	ErrInvalidReminderType     = errors.New("reminder type must be vaccination, deworming, flea_treatment, feeding or other")
+	ErrReminderHasNoOccurrence = errors.New("reminder has no future occurrences")
+)
+
+// dueRemindersBatchSize ограничивает число напоминаний, обрабатываемых за один запуск планировщика
+const dueRemindersBatchSize = 100
+
+// ReminderService представляет сервис напоминаний по уходу за котами
+type ReminderService struct {
+	repo     repositories.ReminderRepository
+	catRepo  repositories.CatRepository
+	userRepo repositories.UserRepository
+	notifier notifier.Notifier
+}
+
+// NewReminderService создает новый экземпляр сервиса напоминаний
+func NewReminderService(
+	repo repositories.ReminderRepository,
+	catRepo repositories.CatRepository,
+	userRepo repositories.UserRepository,
+	notifier notifier.Notifier,
+) *ReminderService {
+	return &ReminderService{
+		repo:     repo,
+		catRepo:  catRepo,
+		userRepo: userRepo,
+		notifier: notifier,
+	}
+}
+
+// Create создает напоминание для кота
+func (s *ReminderService) Create(catID int, req *models.ReminderCreateRequest, userID int, isAdmin bool) (*models.ReminderResponse, error) {
+	if err := requireCatRole(s.catRepo, catID, userID, isAdmin, models.CatRoleCaretaker); err != nil {
+		return nil, err
+	}
+
+	// Проверяем тип и заголовок напоминания
+	if !isValidReminderType(req.ReminderType) {
+		return nil, ErrInvalidReminderType
+	}
+	if strings.TrimSpace(req.Title) == "" || req.StartsAt.IsZero() {
+		return nil, ErrInvalidReminderData
+	}
+
+	startsAt := req.StartsAt.UTC().Truncate(time.Second)
+	rrule, err := normalizeRRule(req.RRule)
+	if err != nil {
+		return nil, err
+	}
+
+	nextRunAt, err := nextReminderRun(startsAt, rrule, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if nextRunAt == nil {
+		return nil, ErrReminderHasNoOccurrence
+	}
+
+	reminder := &models.Reminder{
+		CatID:        catID,
+		UserID:       userID,
+		ReminderType: req.ReminderType,
+		Title:        req.Title,
+		Notes:        req.Notes,
+		StartsAt:     startsAt,
+		RRule:        rrule,
+		NextRunAt:    nextRunAt,
+		IsActive:     true,
+		CreatedAt:    time.Now(),
+	}
+
+	if err := s.repo.Create(reminder); err != nil {
+		return nil, err
+	}
+
+	response := reminder.ToResponse()
+	return &response, nil
+}
+
+// GetCatReminders возвращает напоминания кота
+func (s *ReminderService) GetCatReminders(catID int, userID int, isAdmin bool) ([]models.ReminderResponse, error) {
+	if err := requireCatRole(s.catRepo, catID, userID, isAdmin, models.CatRoleViewer); err != nil {
+		return nil, err
+	}
+
+	reminders, err := s.repo.GetByCatID(catID)
+	if err != nil {
+		return nil, err
+	}
+
+	var responses []models.ReminderResponse
+	for _, reminder := range reminders {
+		responses = append(responses, reminder.ToResponse())
+	}
+
+	return responses, nil
+}
+
+// UpdateReminder обновляет напоминание и пересчитывает время следующей отправки
+func (s *ReminderService) UpdateReminder(catID int, reminderID int, req *models.ReminderUpdateRequest, userID int, isAdmin bool) error {
+	if err := requireCatRole(s.catRepo, catID, userID, isAdmin, models.CatRoleCaretaker); err != nil {
+		return err
+	}
+
+	reminder, err := s.getCatReminder(catID, reminderID)
+	if err != nil {
+		return err
+	}
+
+	if req.Title != nil && strings.TrimSpace(*req.Title) == "" {
+		return ErrInvalidReminderData
+	}
+
+	// Собираем итоговое расписание с учетом сохраненных значений
+	startsAt := reminder.StartsAt
+	if req.StartsAt != nil {
+		normalized := req.StartsAt.UTC().Truncate(time.Second)
+		req.StartsAt = &normalized
+		startsAt = normalized
+	}
+
+	rrule := reminder.RRule
+	if req.RRule != nil {
+		if *req.RRule == "" {
+			rrule = nil
+		} else {
+			if rrule, err = normalizeRRule(req.RRule); err != nil {
+				return err
+			}
+			req.RRule = rrule
+		}
+	}
+
+	isActive := reminder.IsActive
+	if req.IsActive != nil {
+		isActive = *req.IsActive
+	}
+
+	nextRunAt, err := nextReminderRun(startsAt, rrule, time.Now())
+	if err != nil {
+		return err
+	}
+	if isActive && nextRunAt == nil {
+		return ErrReminderHasNoOccurrence
+	}
+
+	if err := s.repo.Update(reminderID, req); err != nil {
+		return err
+	}
+
+	return s.repo.SetSchedule(reminderID, nextRunAt, isActive)
+}
+
+// DeleteReminder удаляет напоминание
+func (s *ReminderService) DeleteReminder(catID int, reminderID int, userID int, isAdmin bool) error {
+	if err := requireCatRole(s.catRepo, catID, userID, isAdmin, models.CatRoleCaretaker); err != nil {
+		return err
+	}
+
+	if _, err := s.getCatReminder(catID, reminderID); err != nil {
+		return err
+	}
+
+	return s.repo.Delete(reminderID)
+}
+
+// DispatchDue отправляет наступившие напоминания и планирует их следующие повторения.
+// Вызывается планировщиком; неотправленные напоминания будут повторены при следующем запуске
+func (s *ReminderService) DispatchDue(ctx context.Context, now time.Time) error {
+	// Время в базе хранится в UTC с точностью до секунды
+	now = now.UTC().Truncate(time.Second)
+
+	reminders, err := s.repo.GetDue(now, dueRemindersBatchSize)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, reminder := range reminders {
+		if ctx.Err() != nil {
+			break
+		}
+
+		if err := s.dispatch(ctx, &reminder, now); err != nil {
+			errs = append(errs, fmt.Errorf("reminder %d: %w", reminder.ID, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// dispatch отправляет одно напоминание текущему владельцу кота и планирует следующее.
+// Напоминание удаленного кота отключается. Частично доставленное напоминание считается отправленным,
+// ошибка недоставившего канала возвращается для записи в лог
+func (s *ReminderService) dispatch(ctx context.Context, reminder *models.Reminder, now time.Time) error {
+	cat, err := s.catRepo.GetByID(reminder.CatID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return s.repo.SetSchedule(reminder.ID, nil, false)
+	}
+	if err != nil {
+		return err
+	}
+
+	// Кот мог перейти к другому владельцу после создания напоминания
+	user, err := s.userRepo.GetByID(cat.UserID)
+	if err != nil {
+		return err
+	}
+
+	notification := notifier.Notification{
+		UserID:  user.ID,
+		Email:   user.Email,
+		Subject: fmt.Sprintf("Reminder for %s: %s", cat.Name, reminder.Title),
+		Body:    reminderBody(reminder),
+		Data: map[string]string{
+			"reminder_id":   strconv.Itoa(reminder.ID),
+			"cat_id":        strconv.Itoa(reminder.CatID),
+			"reminder_type": reminder.ReminderType,
+		},
+	}
+
+	notifyErr := s.notifier.Notify(ctx, notification)
+	if !notifier.IsDelivered(notifyErr) {
+		return notifyErr
+	}
+
+	// Пропущенные во время простоя повторения не отправляем повторно
+	nextRunAt, err := nextReminderRun(reminder.StartsAt, reminder.RRule, now)
+	if err != nil {
+		return err
+	}
+
+	if err := s.repo.MarkSent(reminder.ID, now, nextRunAt); err != nil {
+		return err
+	}
+
+	return notifyErr
+}
+
+// getCatReminder возвращает напоминание, убедившись, что оно принадлежит указанному коту
+func (s *ReminderService) getCatReminder(catID int, reminderID int) (*models.Reminder, error) {
+	reminder, err := s.repo.GetByID(reminderID)
+	if err != nil || reminder.CatID != catID {
+		return nil, ErrReminderNotFound
+	}
+
+	return reminder, nil
+}
+
+// isValidReminderType проверяет допустимость типа напоминания
+func isValidReminderType(reminderType string) bool {
+	switch reminderType {
+	case models.ReminderVaccination, models.ReminderDeworming, models.ReminderFleaTreatment, models.ReminderFeeding, models.ReminderOther:
+		return true
+	default:
+		return false
+	}
+}
+
+// normalizeRRule проверяет правило повторения и приводит его к нормализованному виду
+func normalizeRRule(rrule *string) (*string, error) {
+	if rrule == nil || strings.TrimSpace(*rrule) == "" {
+		return nil, nil
+	}
+
+	rule, err := ParseRecurrenceRule(*rrule)
+	if err != nil {
+		return nil, err
+	}
+
+	normalized := rule.String()
+	return &normalized, nil
+}
+
+// nextReminderRun вычисляет время следующей отправки напоминания после момента now.
+// Возвращает nil, если повторений больше нет
+func nextReminderRun(startsAt time.Time, rrule *string, now time.Time) (*time.Time, error) {
+	if rrule == nil {
+		if startsAt.After(now) {
+			return &startsAt, nil
+		}
+		return nil, nil
+	}
+
+	rule, err := ParseRecurrenceRule(*rrule)
+	if err != nil {
+		return nil, err
+	}
+
+	next, ok := rule.Next(startsAt, now)
+	if !ok {
+		return nil, nil
+	}
+
+	return &next, nil
+}
+
+// reminderBody формирует текст уведомления о напоминании
+func reminderBody(reminder *models.Reminder) string {
+	body := fmt.Sprintf("It's time for %s: %s", strings.ReplaceAll(reminder.ReminderType, "_", " "), reminder.Title)
+	if reminder.Notes != nil {
+		body += ". " + *reminder.Notes
+	}
+	return body
+}
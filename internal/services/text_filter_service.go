@@ -0,0 +1,118 @@
+package services
+
+import (
+	"errors"
+	"strings"
+
+	"meawle/internal/contentfilter"
+	"meawle/internal/models"
+	"meawle/internal/repositories"
+)
+
+var (
+	ErrContentRejected = errors.New("text contains prohibited words, too many links or repetitions")
+)
+
+// TextFilterService проверяет пользовательский текст фильтром и реагирует на нарушения
+// согласно режиму из конфигурации: отклоняет текст, маскирует его или отправляет объект в очередь модерации
+type TextFilterService struct {
+	filter         contentfilter.Filter
+	mode           string
+	moderationRepo repositories.ModerationRepository
+}
+
+// NewTextFilterService создает новый экземпляр сервиса фильтра текста.
+// Неизвестный режим считается режимом mask
+func NewTextFilterService(filter contentfilter.Filter, mode string, moderationRepo repositories.ModerationRepository) *TextFilterService {
+	switch mode {
+	case contentfilter.ModeOff, contentfilter.ModeReject, contentfilter.ModeFlag, contentfilter.ModeMask:
+	default:
+		mode = contentfilter.ModeMask
+	}
+
+	return &TextFilterService{
+		filter:         filter,
+		mode:           mode,
+		moderationRepo: moderationRepo,
+	}
+}
+
+// Apply проверяет текстовые поля, пустые указатели пропускаются. В режиме mask замаскированный текст
+// записывается обратно в поля. Возвращает правила, по которым объект после сохранения нужно передать в Flag
+func (s *TextFilterService) Apply(fields ...*string) ([]string, error) {
+	if s.mode == contentfilter.ModeOff {
+		return nil, nil
+	}
+
+	var flags []string
+	for _, field := range fields {
+		if field == nil {
+			continue
+		}
+
+		result := s.filter.Check(*field)
+		if result.Clean() {
+			continue
+		}
+
+		switch s.mode {
+		case contentfilter.ModeReject:
+			return nil, ErrContentRejected
+		case contentfilter.ModeFlag:
+			flags = appendUnique(flags, result.Violations...)
+		case contentfilter.ModeMask:
+			*field = result.Text
+			flags = appendUnique(flags, result.Violations...)
+		}
+	}
+
+	return flags, nil
+}
+
+// Flag создает жалобу без автора на объект с нарушениями, если открытой жалобы фильтра на него еще нет.
+// Ошибка не отменяет сохранение объекта
+func (s *TextFilterService) Flag(targetType string, targetID int, rules []string) {
+	if len(rules) == 0 {
+		return
+	}
+
+	exists, err := s.moderationRepo.HasOpenReport(targetType, targetID, nil)
+	if err != nil || exists {
+		return
+	}
+
+	reason := models.ReportReasonSpam
+	for _, rule := range rules {
+		if rule == contentfilter.RuleProfanity {
+			reason = models.ReportReasonInappropriate
+		}
+	}
+
+	details := "Flagged by content filter: " + strings.Join(rules, ", ")
+	report := &models.ContentReport{
+		TargetType: targetType,
+		TargetID:   targetID,
+		Reason:     reason,
+		Details:    &details,
+		Status:     models.ReportStatusOpen,
+	}
+
+	_ = s.moderationRepo.CreateReport(report)
+}
+
+// appendUnique добавляет в список значения, которых в нем еще нет
+func appendUnique(list []string, values ...string) []string {
+	for _, value := range values {
+		found := false
+		for _, existing := range list {
+			if existing == value {
+				found = true
+				break
+			}
+		}
+		if !found {
+			list = append(list, value)
+		}
+	}
+	return list
+}
@@ -0,0 +1,48 @@
+package services
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"meawle/internal/contentfilter"
+)
+
+func TestTextFilterServiceApply(t *testing.T) {
+	filter := contentfilter.MultiFilter{
+		contentfilter.NewWordListFilter([]string{"ru"}, nil, nil),
+		contentfilter.NewLinkFilter(1),
+	}
+	const text = "Кот сука http://a.example http://b.example"
+
+	tests := []struct {
+		mode      string
+		wantText  string
+		wantFlags []string
+		wantErr   error
+	}{
+		{mode: contentfilter.ModeOff, wantText: text},
+		{mode: contentfilter.ModeReject, wantText: text, wantErr: ErrContentRejected},
+		{mode: contentfilter.ModeFlag, wantText: text, wantFlags: []string{contentfilter.RuleLinks}},
+		{mode: contentfilter.ModeMask, wantText: "Кот с*** http://a.example http://b.example", wantFlags: []string{contentfilter.RuleLinks}},
+		{mode: "unknown", wantText: "Кот с*** http://a.example http://b.example", wantFlags: []string{contentfilter.RuleLinks}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.mode, func(t *testing.T) {
+			service := NewTextFilterService(filter, tt.mode, nil)
+			field := text
+
+			flags, err := service.Apply(&field, nil)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Apply() error = %v, want %v", err, tt.wantErr)
+			}
+			if field != tt.wantText {
+				t.Errorf("field = %q, want %q", field, tt.wantText)
+			}
+			if !reflect.DeepEqual(flags, tt.wantFlags) {
+				t.Errorf("Apply() flags = %v, want %v", flags, tt.wantFlags)
+			}
+		})
+	}
+}
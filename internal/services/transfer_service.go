@@ -0,0 +1,295 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"meawle/internal/models"
+	"meawle/internal/notifier"
+	"meawle/internal/repositories"
+)
+
+var (
+	ErrTransferNotFound    = errors.New("transfer not found")
+	ErrTransferNotPending  = errors.New("transfer is already resolved")
+	ErrTransferPending     = errors.New("cat already has a pending transfer")
+	ErrRecipientNotFound   = errors.New("recipient not found")
+	ErrInvalidTransfer     = errors.New("cat cannot be transferred to its current owner")
+	ErrInvalidTransferData = errors.New("invalid transfer data")
+	ErrCatOwnerChanged     = errors.New("cat owner has changed")
+)
+
+// TransferService представляет сервис передачи котов другим владельцам
+type TransferService struct {
+	repo     repositories.TransferRepository
+	catRepo  repositories.CatRepository
+	userRepo repositories.UserRepository
+	notifier notifier.Notifier
+}
+
+// NewTransferService создает новый экземпляр сервиса передачи котов
+func NewTransferService(
+	repo repositories.TransferRepository,
+	catRepo repositories.CatRepository,
+	userRepo repositories.UserRepository,
+	notifier notifier.Notifier,
+) *TransferService {
+	return &TransferService{
+		repo:     repo,
+		catRepo:  catRepo,
+		userRepo: userRepo,
+		notifier: notifier,
+	}
+}
+
+// Initiate начинает передачу кота пользователю с указанным email
+func (s *TransferService) Initiate(catID int, req *models.CatTransferCreateRequest, userID int) (*models.CatTransferResponse, error) {
+	cat, err := s.catRepo.GetByID(catID)
+	if err != nil {
+		return nil, ErrCatNotFound
+	}
+
+	// Начать передачу может только текущий владелец, админ использует принудительную передачу
+	if cat.UserID != userID {
+		return nil, ErrAccessDenied
+	}
+
+	recipient, err := s.userRepo.GetByEmail(strings.TrimSpace(req.Email))
+	if err != nil {
+		return nil, ErrRecipientNotFound
+	}
+	if recipient.ID == cat.UserID {
+		return nil, ErrInvalidTransfer
+	}
+
+	if req.Message != nil && strings.TrimSpace(*req.Message) == "" {
+		return nil, ErrInvalidTransferData
+	}
+
+	if _, err := s.repo.GetPendingByCatID(catID); err == nil {
+		return nil, ErrTransferPending
+	} else if err != sql.ErrNoRows {
+		return nil, err
+	}
+
+	transfer := &models.CatTransfer{
+		CatID:      catID,
+		FromUserID: userID,
+		ToUserID:   recipient.ID,
+		Status:     models.TransferStatusPending,
+		Message:    req.Message,
+	}
+
+	if err := s.repo.Create(transfer); err != nil {
+		return nil, err
+	}
+
+	created, err := s.repo.GetByID(transfer.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.notify(recipient.ID, recipient.Email, fmt.Sprintf("%s wants to transfer %s to you", created.FromEmail, created.CatName), created)
+
+	response := created.ToResponse()
+	return &response, nil
+}
+
+// GetIncoming возвращает передачи, адресованные пользователю
+func (s *TransferService) GetIncoming(userID int) ([]models.CatTransferResponse, error) {
+	transfers, err := s.repo.GetIncoming(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return transferResponses(transfers), nil
+}
+
+// GetOutgoing возвращает передачи, начатые пользователем
+func (s *TransferService) GetOutgoing(userID int) ([]models.CatTransferResponse, error) {
+	transfers, err := s.repo.GetOutgoing(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return transferResponses(transfers), nil
+}
+
+// Accept принимает передачу и делает получателя владельцем кота
+func (s *TransferService) Accept(id int, userID int) (*models.CatTransferResponse, error) {
+	transfer, err := s.getPending(id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Принять передачу может только получатель
+	if transfer.ToUserID != userID {
+		return nil, ErrAccessDenied
+	}
+
+	// Кот мог сменить владельца после начала передачи, тогда передача отменяется
+	if err := s.repo.Accept(transfer); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrTransferNotPending
+		}
+		if errors.Is(err, repositories.ErrCatOwnerChanged) {
+			return nil, ErrCatOwnerChanged
+		}
+		return nil, err
+	}
+
+	return s.resolved(id, transfer.FromUserID, transfer.FromEmail, "%s accepted the transfer of %s")
+}
+
+// Decline отклоняет передачу получателем
+func (s *TransferService) Decline(id int, userID int) (*models.CatTransferResponse, error) {
+	transfer, err := s.getPending(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if transfer.ToUserID != userID {
+		return nil, ErrAccessDenied
+	}
+
+	if err := s.repo.Resolve(id, models.TransferStatusDeclined); err != nil {
+		return nil, err
+	}
+
+	return s.resolved(id, transfer.FromUserID, transfer.FromEmail, "%s declined the transfer of %s")
+}
+
+// Cancel отменяет передачу отправителем или админом
+func (s *TransferService) Cancel(id int, userID int, isAdmin bool) (*models.CatTransferResponse, error) {
+	transfer, err := s.getPending(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isAdmin && transfer.FromUserID != userID {
+		return nil, ErrAccessDenied
+	}
+
+	if err := s.repo.Resolve(id, models.TransferStatusCancelled); err != nil {
+		return nil, err
+	}
+
+	return s.resolved(id, transfer.ToUserID, transfer.ToEmail, "%s cancelled the transfer of %s")
+}
+
+// ForceTransfer передает кота другому пользователю по решению администратора
+func (s *TransferService) ForceTransfer(catID int, req *models.CatForceTransferRequest, userID int, isAdmin bool) (*models.OwnershipRecord, error) {
+	if !isAdmin {
+		return nil, ErrAccessDenied
+	}
+
+	cat, err := s.catRepo.GetByID(catID)
+	if err != nil {
+		return nil, ErrCatNotFound
+	}
+
+	recipient, err := s.userRepo.GetByEmail(strings.TrimSpace(req.Email))
+	if err != nil {
+		return nil, ErrRecipientNotFound
+	}
+	if recipient.ID == cat.UserID {
+		return nil, ErrInvalidTransfer
+	}
+
+	if req.Note != nil && strings.TrimSpace(*req.Note) == "" {
+		return nil, ErrInvalidTransferData
+	}
+
+	record := &models.OwnershipRecord{
+		CatID:      catID,
+		FromUserID: &cat.UserID,
+		ToUserID:   recipient.ID,
+		Reason:     models.OwnershipReasonForced,
+		ChangedBy:  userID,
+		Note:       req.Note,
+		CreatedAt:  time.Now().UTC().Truncate(time.Second),
+	}
+
+	if err := s.repo.ForceTransfer(record); err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+// GetHistory возвращает историю владельцев кота
+func (s *TransferService) GetHistory(catID int, userID int, isAdmin bool) ([]models.OwnershipRecord, error) {
+	cat, err := s.catRepo.GetByID(catID)
+	if err != nil {
+		return nil, ErrCatNotFound
+	}
+
+	// Проверяем права доступа: историю видит текущий владелец или админ
+	if !isAdmin && cat.UserID != userID {
+		return nil, ErrAccessDenied
+	}
+
+	return s.repo.GetHistory(catID)
+}
+
+// getPending возвращает передачу, ожидающую решения
+func (s *TransferService) getPending(id int) (*models.CatTransfer, error) {
+	transfer, err := s.repo.GetByID(id)
+	if err != nil {
+		return nil, ErrTransferNotFound
+	}
+
+	if transfer.Status != models.TransferStatusPending {
+		return nil, ErrTransferNotPending
+	}
+
+	return transfer, nil
+}
+
+// resolved перечитывает завершенную передачу и уведомляет другую сторону
+func (s *TransferService) resolved(id int, notifyUserID int, notifyEmail string, subjectFormat string) (*models.CatTransferResponse, error) {
+	transfer, err := s.repo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	actor := transfer.ToEmail
+	if notifyUserID == transfer.ToUserID {
+		actor = transfer.FromEmail
+	}
+	s.notify(notifyUserID, notifyEmail, fmt.Sprintf(subjectFormat, actor, transfer.CatName), transfer)
+
+	response := transfer.ToResponse()
+	return &response, nil
+}
+
+// notify отправляет уведомление о передаче. Ошибка доставки не отменяет уже выполненную операцию
+func (s *TransferService) notify(userID int, email string, subject string, transfer *models.CatTransfer) {
+	notification := notifier.Notification{
+		UserID:  userID,
+		Email:   email,
+		Subject: subject,
+		Body:    fmt.Sprintf("Transfer of %s is %s", transfer.CatName, transfer.Status),
+		Data: map[string]string{
+			"transfer_id": strconv.Itoa(transfer.ID),
+			"cat_id":      strconv.Itoa(transfer.CatID),
+			"status":      transfer.Status,
+		},
+	}
+
+	_ = s.notifier.Notify(context.Background(), notification)
+}
+
+// transferResponses преобразует список передач в ответы
+func transferResponses(transfers []models.CatTransfer) []models.CatTransferResponse {
+	var responses []models.CatTransferResponse
+	for _, transfer := range transfers {
+		responses = append(responses, transfer.ToResponse())
+	}
+	return responses
+}
@@ -16,6 +16,7 @@ var (
 	ErrEmailExists        = errors.New("email already exists")
 	ErrUnauthorized       = errors.New("unauthorized")
 	ErrAccessDenied       = errors.New("access denied")
+	ErrUserBanned         = errors.New("user is banned")
 )
 
 // JWTClaims представляет claims для JWT токена
@@ -81,6 +82,10 @@ func (s *UserService) Login(req *models.UserLoginRequest) (string, *models.UserR
 		return "", nil, ErrInvalidCredentials
 	}
 
+	if user.BannedAt != nil {
+		return "", nil, ErrUserBanned
+	}
+
 	// Генерируем JWT токен
 	token, err := s.generateJWT(user)
 	if err != nil {
@@ -126,6 +131,11 @@ func (s *UserService) UpdateUser(id int, req *models.UserUpdateRequest, currentU
 		return ErrAccessDenied
 	}
 
+	// Назначать модераторов может только админ
+	if req.IsModerator != nil && !isAdmin {
+		return ErrAccessDenied
+	}
+
 	// Проверяем существование пользователя
 	_, err := s.repo.GetByID(id)
 	if err != nil {
@@ -174,11 +184,21 @@ func (s *UserService) ValidateToken(tokenString string) (*JWTClaims, error) {
 		return nil, ErrUnauthorized
 	}
 
-	if claims, ok := token.Claims.(*JWTClaims); ok && token.Valid {
-		return claims, nil
+	claims, ok := token.Claims.(*JWTClaims)
+	if !ok || !token.Valid {
+		return nil, ErrUnauthorized
+	}
+
+	// Блокировка действует сразу, не дожидаясь истечения выданного токена
+	user, err := s.repo.GetByID(claims.UserID)
+	if err != nil {
+		return nil, ErrUnauthorized
+	}
+	if user.BannedAt != nil {
+		return nil, ErrUserBanned
 	}
 
-	return nil, ErrUnauthorized
+	return claims, nil
 }
 
 // generateJWT генерирует JWT токен для пользователя
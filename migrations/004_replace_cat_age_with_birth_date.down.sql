@@ -0,0 +1,8 @@
+-- Откат миграции: возвращаем столбец возраста, вычисляя его по году рождения
+ALTER TABLE cats ADD COLUMN age INTEGER;
+
+UPDATE cats
+SET age = CAST(strftime('%Y', 'now') AS INTEGER) - CAST(substr(birth_date, 1, 4) AS INTEGER)
+WHERE birth_date IS NOT NULL;
+
+ALTER TABLE cats DROP COLUMN birth_date;
@@ -0,0 +1,10 @@
+-- Замена статичного возраста кота на дату рождения.
+-- Дата хранится в формате YYYY, YYYY-MM или YYYY-MM-DD, точность определяется форматом.
+ALTER TABLE cats ADD COLUMN birth_date TEXT;
+
+-- Переносим существующий возраст в приблизительный год рождения относительно даты создания записи
+UPDATE cats
+SET birth_date = strftime('%Y', created_at, '-' || age || ' years')
+WHERE age IS NOT NULL;
+
+ALTER TABLE cats DROP COLUMN age;
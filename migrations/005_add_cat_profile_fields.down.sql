@@ -0,0 +1,13 @@
+-- Откат миграции: удаление полей расширенного профиля кота
+DROP INDEX IF EXISTS idx_cats_color;
+DROP INDEX IF EXISTS idx_cats_sex;
+DROP INDEX IF EXISTS idx_cats_microchip_number;
+
+ALTER TABLE cats DROP COLUMN neutered_at;
+ALTER TABLE cats DROP COLUMN is_neutered;
+ALTER TABLE cats DROP COLUMN microchip_number;
+ALTER TABLE cats DROP COLUMN weight_kg;
+ALTER TABLE cats DROP COLUMN eye_color;
+ALTER TABLE cats DROP COLUMN coat_pattern;
+ALTER TABLE cats DROP COLUMN color;
+ALTER TABLE cats DROP COLUMN sex;
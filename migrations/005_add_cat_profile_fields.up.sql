@@ -0,0 +1,21 @@
+-- Расширенный профиль кота: пол, окрас, цвет глаз, вес, микрочип и стерилизация
+ALTER TABLE cats ADD COLUMN sex TEXT;
+ALTER TABLE cats ADD COLUMN color TEXT;
+ALTER TABLE cats ADD COLUMN coat_pattern TEXT;
+ALTER TABLE cats ADD COLUMN eye_color TEXT;
+ALTER TABLE cats ADD COLUMN weight_kg REAL;
+ALTER TABLE cats ADD COLUMN microchip_number TEXT;
+ALTER TABLE cats ADD COLUMN is_neutered BIT NOT NULL DEFAULT 0;
+ALTER TABLE cats ADD COLUMN neutered_at TEXT;
+
+-- Номер микрочипа уникален, но может отсутствовать у нескольких котов
+CREATE UNIQUE INDEX IF NOT EXISTS idx_cats_microchip_number ON cats(microchip_number);
+
+-- Индексы для фильтрации
+CREATE INDEX IF NOT EXISTS idx_cats_sex ON cats(sex);
+CREATE INDEX IF NOT EXISTS idx_cats_color ON cats(color);
+
+-- Заполняем тестовые данные
+UPDATE cats SET sex = 'male', color = 'рыжий', coat_pattern = 'tabby', eye_color = 'зеленый', weight_kg = 5.2, is_neutered = 1 WHERE name = 'Мурзик';
+UPDATE cats SET sex = 'male', color = 'серый', coat_pattern = 'solid', eye_color = 'желтый', weight_kg = 6.1, microchip_number = '643094100123456', is_neutered = 1, neutered_at = '2021-06-15' WHERE name = 'Барсик';
+UPDATE cats SET sex = 'female', color = 'белый', coat_pattern = 'solid', eye_color = 'голубой', weight_kg = 2.3 WHERE name = 'Снежок';
@@ -0,0 +1,2 @@
+-- Удаление таблицы запросов на связь по микрочипу
+DROP TABLE IF EXISTS chip_contact_requests;
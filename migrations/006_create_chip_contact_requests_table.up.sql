@@ -0,0 +1,14 @@
+-- Создание таблицы запросов на связь с владельцем найденного кота по микрочипу
+CREATE TABLE IF NOT EXISTS chip_contact_requests (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    cat_id INTEGER NOT NULL,
+    finder_name TEXT NOT NULL,
+    finder_contact TEXT NOT NULL,
+    message TEXT,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (cat_id) REFERENCES cats(id) ON DELETE CASCADE
+);
+
+-- Создание индексов
+CREATE INDEX IF NOT EXISTS idx_chip_contact_requests_cat_id ON chip_contact_requests(cat_id);
+CREATE INDEX IF NOT EXISTS idx_chip_contact_requests_created_at ON chip_contact_requests(created_at);
@@ -0,0 +1,2 @@
+-- Удаление таблицы медицинской истории котов
+DROP TABLE IF EXISTS cat_health_records;
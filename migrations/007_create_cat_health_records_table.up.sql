@@ -0,0 +1,26 @@
+-- Создание таблицы медицинской истории котов: прививки, визиты к ветеринару, лекарства, аллергии
+CREATE TABLE IF NOT EXISTS cat_health_records (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    cat_id INTEGER NOT NULL,
+    record_type TEXT NOT NULL,
+    title TEXT NOT NULL,
+    notes TEXT,
+    record_date TEXT NOT NULL,
+    due_date TEXT,
+    vet_name TEXT,
+    dosage TEXT,
+    is_public BIT NOT NULL DEFAULT 0,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (cat_id) REFERENCES cats(id) ON DELETE CASCADE
+);
+
+-- Создание индексов
+CREATE INDEX IF NOT EXISTS idx_cat_health_records_cat_id ON cat_health_records(cat_id);
+CREATE INDEX IF NOT EXISTS idx_cat_health_records_due_date ON cat_health_records(due_date);
+
+-- Вставка тестовых данных
+INSERT INTO cat_health_records (cat_id, record_type, title, notes, record_date, due_date, vet_name, dosage, is_public) VALUES
+    (1, 'vaccination', 'Комплексная вакцина', 'Перенес хорошо', '2025-03-10', '2026-03-10', 'Клиника Айболит', NULL, 1),
+    (1, 'vet_visit', 'Плановый осмотр', 'Здоров', '2025-03-10', NULL, 'Клиника Айболит', NULL, 0),
+    (2, 'allergy', 'Аллергия на курицу', 'Исключить курицу из рациона', '2024-08-01', NULL, NULL, NULL, 0),
+    (3, 'medication', 'Антигельминтик', NULL, '2025-06-01', '2025-06-03', NULL, '1 таблетка в день', 0);
@@ -0,0 +1,2 @@
+-- Удаление таблицы измерений котов
+DROP TABLE IF EXISTS cat_measurements;
@@ -0,0 +1,21 @@
+-- Создание таблицы измерений веса и роста котов
+CREATE TABLE IF NOT EXISTS cat_measurements (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    cat_id INTEGER NOT NULL,
+    measured_at TEXT NOT NULL,
+    weight_kg REAL NOT NULL,
+    length_cm REAL,
+    notes TEXT,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (cat_id) REFERENCES cats(id) ON DELETE CASCADE
+);
+
+-- Создание индексов
+CREATE INDEX IF NOT EXISTS idx_cat_measurements_cat_id_measured_at ON cat_measurements(cat_id, measured_at);
+
+-- Вставка тестовых данных: рост котенка Снежка
+INSERT INTO cat_measurements (cat_id, measured_at, weight_kg, length_cm) VALUES
+    (5, '2025-09-01', 1.1, 25),
+    (5, '2025-09-08', 1.3, 26),
+    (5, '2025-09-15', 1.4, 27),
+    (5, '2025-09-22', 1.7, 28);
@@ -0,0 +1,2 @@
+-- Удаление таблицы напоминаний по уходу за котами
+DROP TABLE IF EXISTS cat_reminders;
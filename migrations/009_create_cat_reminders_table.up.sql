@@ -0,0 +1,21 @@
+-- Создание таблицы напоминаний по уходу за котами
+CREATE TABLE IF NOT EXISTS cat_reminders (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    cat_id INTEGER NOT NULL,
+    user_id INTEGER NOT NULL,
+    reminder_type TEXT NOT NULL,
+    title TEXT NOT NULL,
+    notes TEXT,
+    starts_at DATETIME NOT NULL,
+    rrule TEXT,
+    next_run_at DATETIME,
+    last_sent_at DATETIME,
+    is_active BIT NOT NULL DEFAULT 1,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (cat_id) REFERENCES cats(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- Создание индексов
+CREATE INDEX IF NOT EXISTS idx_cat_reminders_cat_id ON cat_reminders(cat_id);
+CREATE INDEX IF NOT EXISTS idx_cat_reminders_next_run_at ON cat_reminders(is_active, next_run_at);
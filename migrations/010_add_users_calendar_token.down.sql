@@ -0,0 +1,4 @@
+-- Откат миграции: удаление токена календаря
+DROP INDEX IF EXISTS idx_users_calendar_token;
+
+ALTER TABLE users DROP COLUMN calendar_token;
@@ -0,0 +1,4 @@
+-- Секретный токен пользователя для подписки на календарь ухода за котами
+ALTER TABLE users ADD COLUMN calendar_token TEXT;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_users_calendar_token ON users(calendar_token);
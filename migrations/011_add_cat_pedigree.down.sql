@@ -0,0 +1,12 @@
+-- Откат миграции: удаление родословной
+DROP VIEW IF EXISTS pedigree_links;
+
+DROP INDEX IF EXISTS idx_cats_dam_id;
+DROP INDEX IF EXISTS idx_cats_sire_id;
+
+ALTER TABLE cats DROP COLUMN dam_external_id;
+ALTER TABLE cats DROP COLUMN sire_external_id;
+ALTER TABLE cats DROP COLUMN dam_id;
+ALTER TABLE cats DROP COLUMN sire_id;
+
+DROP TABLE IF EXISTS external_ancestors;
@@ -0,0 +1,44 @@
+-- Родословная: внешние (незарегистрированные) предки и ссылки на родителей котов
+CREATE TABLE IF NOT EXISTS external_ancestors (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name TEXT NOT NULL,
+    sex TEXT,
+    registration_number TEXT,
+    sire_id INTEGER,
+    dam_id INTEGER,
+    user_id INTEGER NOT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (sire_id) REFERENCES external_ancestors(id) ON DELETE SET NULL,
+    FOREIGN KEY (dam_id) REFERENCES external_ancestors(id) ON DELETE SET NULL,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- Родителем кота может быть зарегистрированный кот или внешний предок
+ALTER TABLE cats ADD COLUMN sire_id INTEGER REFERENCES cats(id) ON DELETE SET NULL;
+ALTER TABLE cats ADD COLUMN dam_id INTEGER REFERENCES cats(id) ON DELETE SET NULL;
+ALTER TABLE cats ADD COLUMN sire_external_id INTEGER REFERENCES external_ancestors(id) ON DELETE SET NULL;
+ALTER TABLE cats ADD COLUMN dam_external_id INTEGER REFERENCES external_ancestors(id) ON DELETE SET NULL;
+
+-- Создание индексов для поиска потомков
+CREATE INDEX IF NOT EXISTS idx_cats_sire_id ON cats(sire_id);
+CREATE INDEX IF NOT EXISTS idx_cats_dam_id ON cats(dam_id);
+
+-- Единое представление связей "потомок - родитель" для рекурсивных запросов по родословной
+CREATE VIEW IF NOT EXISTS pedigree_links AS
+    SELECT 'cat' AS child_kind, id AS child_id, 'cat' AS parent_kind, sire_id AS parent_id, 'sire' AS role FROM cats WHERE sire_id IS NOT NULL
+    UNION ALL
+    SELECT 'cat', id, 'cat', dam_id, 'dam' FROM cats WHERE dam_id IS NOT NULL
+    UNION ALL
+    SELECT 'cat', id, 'external', sire_external_id, 'sire' FROM cats WHERE sire_external_id IS NOT NULL
+    UNION ALL
+    SELECT 'cat', id, 'external', dam_external_id, 'dam' FROM cats WHERE dam_external_id IS NOT NULL
+    UNION ALL
+    SELECT 'external', id, 'external', sire_id, 'sire' FROM external_ancestors WHERE sire_id IS NOT NULL
+    UNION ALL
+    SELECT 'external', id, 'external', dam_id, 'dam' FROM external_ancestors WHERE dam_id IS NOT NULL;
+
+-- Вставка тестовых данных: Снежок - дочь Мурзика и внешней кошки
+INSERT INTO external_ancestors (name, sex, registration_number, user_id) VALUES
+    ('Мурка', 'female', 'WCF-RU-0001', 3);
+
+UPDATE cats SET sire_id = 1, dam_external_id = 1 WHERE name = 'Снежок';
@@ -0,0 +1,11 @@
+-- Откат миграции: удаление пометов
+DELETE FROM cats WHERE litter_id IN (SELECT id FROM litters WHERE name = 'Помет A');
+
+DROP INDEX IF EXISTS idx_cats_litter_id;
+DROP INDEX IF EXISTS idx_litters_sire_id;
+DROP INDEX IF EXISTS idx_litters_dam_id;
+
+ALTER TABLE cats DROP COLUMN availability;
+ALTER TABLE cats DROP COLUMN litter_id;
+
+DROP TABLE IF EXISTS litters;
@@ -0,0 +1,33 @@
+-- Создание таблицы пометов
+CREATE TABLE IF NOT EXISTS litters (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name TEXT,
+    dam_id INTEGER NOT NULL,
+    sire_id INTEGER,
+    sire_external_id INTEGER,
+    birth_date TEXT NOT NULL, -- YYYY-MM-DD
+    notes TEXT,
+    user_id INTEGER NOT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (dam_id) REFERENCES cats(id) ON DELETE CASCADE,
+    FOREIGN KEY (sire_id) REFERENCES cats(id) ON DELETE SET NULL,
+    FOREIGN KEY (sire_external_id) REFERENCES external_ancestors(id) ON DELETE SET NULL,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- Котята ссылаются на свой помет и имеют статус доступности
+ALTER TABLE cats ADD COLUMN litter_id INTEGER REFERENCES litters(id) ON DELETE SET NULL;
+ALTER TABLE cats ADD COLUMN availability TEXT; -- available, reserved, placed, kept
+
+-- Создание индексов для улучшения производительности
+CREATE INDEX IF NOT EXISTS idx_litters_dam_id ON litters(dam_id);
+CREATE INDEX IF NOT EXISTS idx_litters_sire_id ON litters(sire_id);
+CREATE INDEX IF NOT EXISTS idx_cats_litter_id ON cats(litter_id);
+
+-- Вставка тестовых данных: помет Снежка от Мурзика
+INSERT INTO litters (name, dam_id, sire_id, birth_date, notes, user_id) VALUES
+    ('Помет A', 5, 1, '2026-08-01', 'Первый помет', 3);
+
+INSERT INTO cats (name, birth_date, sex, color, coat_pattern, sire_id, dam_id, litter_id, availability, user_id) VALUES
+    ('Агата', '2026-08-01', 'female', 'белый', 'solid', 1, 5, (SELECT id FROM litters WHERE name = 'Помет A'), 'available', 3),
+    ('Арчи', '2026-08-01', 'male', 'рыжий', 'tabby', 1, 5, (SELECT id FROM litters WHERE name = 'Помет A'), 'reserved', 3);
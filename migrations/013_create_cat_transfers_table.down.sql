@@ -0,0 +1,9 @@
+-- Откат миграции: удаление передач и истории владельцев котов
+DROP INDEX IF EXISTS idx_cat_transfers_pending;
+DROP INDEX IF EXISTS idx_cat_ownership_history_cat_id;
+DROP INDEX IF EXISTS idx_cat_transfers_from_user_id;
+DROP INDEX IF EXISTS idx_cat_transfers_to_user_id;
+DROP INDEX IF EXISTS idx_cat_transfers_cat_id;
+
+DROP TABLE IF EXISTS cat_ownership_history;
+DROP TABLE IF EXISTS cat_transfers;
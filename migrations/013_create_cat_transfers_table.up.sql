@@ -0,0 +1,38 @@
+-- Создание таблицы передач котов другим владельцам
+CREATE TABLE IF NOT EXISTS cat_transfers (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    cat_id INTEGER NOT NULL,
+    from_user_id INTEGER NOT NULL,
+    to_user_id INTEGER NOT NULL,
+    status TEXT NOT NULL DEFAULT 'pending', -- pending, accepted, declined, cancelled
+    message TEXT,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    resolved_at DATETIME,
+    FOREIGN KEY (cat_id) REFERENCES cats(id) ON DELETE CASCADE,
+    FOREIGN KEY (from_user_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (to_user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- Создание таблицы истории владельцев котов
+CREATE TABLE IF NOT EXISTS cat_ownership_history (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    cat_id INTEGER NOT NULL,
+    from_user_id INTEGER,
+    to_user_id INTEGER NOT NULL,
+    transfer_id INTEGER,
+    reason TEXT NOT NULL, -- transfer или forced
+    changed_by INTEGER NOT NULL,
+    note TEXT,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (cat_id) REFERENCES cats(id) ON DELETE CASCADE,
+    FOREIGN KEY (transfer_id) REFERENCES cat_transfers(id) ON DELETE SET NULL
+);
+
+-- Создание индексов для улучшения производительности
+CREATE INDEX IF NOT EXISTS idx_cat_transfers_cat_id ON cat_transfers(cat_id);
+CREATE INDEX IF NOT EXISTS idx_cat_transfers_to_user_id ON cat_transfers(to_user_id);
+CREATE INDEX IF NOT EXISTS idx_cat_transfers_from_user_id ON cat_transfers(from_user_id);
+CREATE INDEX IF NOT EXISTS idx_cat_ownership_history_cat_id ON cat_ownership_history(cat_id);
+
+-- У кота может быть только одна незавершенная передача
+CREATE UNIQUE INDEX IF NOT EXISTS idx_cat_transfers_pending ON cat_transfers(cat_id) WHERE status = 'pending';
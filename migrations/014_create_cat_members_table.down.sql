@@ -0,0 +1,3 @@
+-- Откат миграции: удаление участников котов
+DROP INDEX IF EXISTS idx_cat_members_user_id;
+DROP TABLE IF EXISTS cat_members;
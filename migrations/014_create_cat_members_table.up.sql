@@ -0,0 +1,22 @@
+-- Создание таблицы участников кота (совладельцы, опекуны, наблюдатели)
+CREATE TABLE IF NOT EXISTS cat_members (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    cat_id INTEGER NOT NULL,
+    user_id INTEGER NOT NULL,
+    role TEXT NOT NULL, -- owner, caretaker, viewer
+    status TEXT NOT NULL DEFAULT 'pending', -- pending, accepted
+    invited_by INTEGER NOT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    accepted_at DATETIME,
+    UNIQUE (cat_id, user_id),
+    FOREIGN KEY (cat_id) REFERENCES cats(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (invited_by) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- Создание индексов для улучшения производительности
+CREATE INDEX IF NOT EXISTS idx_cat_members_user_id ON cat_members(user_id);
+
+-- Вставка тестовых данных: Мария ухаживает за Мурзиком
+INSERT INTO cat_members (cat_id, user_id, role, status, invited_by, accepted_at) VALUES
+    (1, 2, 'caretaker', 'accepted', 1, CURRENT_TIMESTAMP);
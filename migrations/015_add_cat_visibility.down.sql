@@ -0,0 +1,4 @@
+-- Откат миграции: удаление видимости котов
+DROP INDEX IF EXISTS idx_cats_visibility;
+
+ALTER TABLE cats DROP COLUMN visibility;
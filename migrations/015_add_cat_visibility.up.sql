@@ -0,0 +1,10 @@
+-- Видимость кота: public - в каталоге, unlisted - только по прямой ссылке,
+-- private - только владельцу, участникам и админу
+ALTER TABLE cats ADD COLUMN visibility TEXT NOT NULL DEFAULT 'public';
+
+-- Создание индекса для фильтрации каталога по видимости
+CREATE INDEX IF NOT EXISTS idx_cats_visibility ON cats(visibility);
+
+-- Тестовые данные: Барсик доступен по ссылке, Рыжик скрыт
+UPDATE cats SET visibility = 'unlisted' WHERE name = 'Барсик';
+UPDATE cats SET visibility = 'private' WHERE name = 'Рыжик';
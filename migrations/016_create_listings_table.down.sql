@@ -0,0 +1,6 @@
+-- Откат миграции: удаление объявлений о пристройстве
+DROP INDEX IF EXISTS idx_listings_active_cat;
+DROP INDEX IF EXISTS idx_listings_user_id;
+DROP INDEX IF EXISTS idx_listings_status;
+
+DROP TABLE IF EXISTS listings;
@@ -0,0 +1,30 @@
+-- Создание таблицы объявлений о пристройстве котов
+CREATE TABLE IF NOT EXISTS listings (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    cat_id INTEGER NOT NULL,
+    user_id INTEGER NOT NULL, -- Автор объявления
+    title TEXT NOT NULL,
+    description TEXT,
+    status TEXT NOT NULL DEFAULT 'draft', -- draft, open, reserved, adopted
+    location TEXT NOT NULL, -- Город или район
+    fee REAL, -- Взнос за пристройство, NULL - бесплатно
+    requirements TEXT, -- Требования к новым владельцам
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    closed_at DATETIME,
+    FOREIGN KEY (cat_id) REFERENCES cats(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- Создание индексов для улучшения производительности
+CREATE INDEX IF NOT EXISTS idx_listings_status ON listings(status);
+CREATE INDEX IF NOT EXISTS idx_listings_user_id ON listings(user_id);
+
+-- У кота может быть только одно незакрытое объявление
+CREATE UNIQUE INDEX IF NOT EXISTS idx_listings_active_cat ON listings(cat_id) WHERE status != 'adopted';
+
+-- Вставка тестовых данных: объявление о котенке Агате
+INSERT INTO listings (cat_id, user_id, title, description, status, location, fee, requirements)
+SELECT id, user_id, 'Агата ищет дом', 'Ласковая белая кошечка из помета A', 'open', 'Москва', 5000,
+    'Сетки на окнах, стерилизация по возрасту'
+FROM cats WHERE name = 'Агата';
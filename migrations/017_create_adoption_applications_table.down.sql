@@ -0,0 +1,4 @@
+-- Откат миграции: удаление заявок на пристройство
+DROP INDEX IF EXISTS idx_adoption_applications_user_id;
+
+DROP TABLE IF EXISTS adoption_applications;
@@ -0,0 +1,23 @@
+-- Создание таблицы заявок на пристройство
+CREATE TABLE IF NOT EXISTS adoption_applications (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    listing_id INTEGER NOT NULL,
+    user_id INTEGER NOT NULL, -- Соискатель
+    answers TEXT NOT NULL, -- Ответы на анкету в формате JSON
+    message TEXT,
+    status TEXT NOT NULL DEFAULT 'submitted', -- submitted, reviewing, approved, rejected, completed
+    status_note TEXT, -- Комментарий к последнему изменению статуса
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (listing_id) REFERENCES listings(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    UNIQUE (listing_id, user_id)
+);
+
+-- Создание индексов для улучшения производительности
+CREATE INDEX IF NOT EXISTS idx_adoption_applications_user_id ON adoption_applications(user_id);
+
+-- Вставка тестовых данных: заявка Марии на Агату
+INSERT INTO adoption_applications (listing_id, user_id, answers, message)
+SELECT id, 2, '{"housing":"квартира","other_pets":"нет","experience":"был кот 10 лет"}', 'Очень хотим взять Агату'
+FROM listings WHERE title = 'Агата ищет дом';
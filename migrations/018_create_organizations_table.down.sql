@@ -0,0 +1,10 @@
+-- Откат миграции: удаление организаций и их сотрудников
+DROP INDEX IF EXISTS idx_cat_breeds_organization_id;
+DROP INDEX IF EXISTS idx_cats_organization_id;
+DROP INDEX IF EXISTS idx_organization_members_user_id;
+
+ALTER TABLE cat_breeds DROP COLUMN organization_id;
+ALTER TABLE cats DROP COLUMN organization_id;
+
+DROP TABLE IF EXISTS organization_members;
+DROP TABLE IF EXISTS organizations;
@@ -0,0 +1,42 @@
+-- Создание таблицы организаций (приюты, питомники)
+CREATE TABLE IF NOT EXISTS organizations (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name TEXT NOT NULL UNIQUE,
+    description TEXT,
+    contact_email TEXT,
+    created_by INTEGER NOT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (created_by) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- Создание таблицы сотрудников организации
+CREATE TABLE IF NOT EXISTS organization_members (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    organization_id INTEGER NOT NULL,
+    user_id INTEGER NOT NULL,
+    role TEXT NOT NULL, -- admin, staff
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (organization_id, user_id),
+    FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- Коты и породы могут принадлежать организации. Ссылка не объявлена внешним ключом,
+-- так как SQLite не позволяет удалить такую колонку при откате; при удалении организации она очищается
+ALTER TABLE cats ADD COLUMN organization_id INTEGER;
+ALTER TABLE cat_breeds ADD COLUMN organization_id INTEGER;
+
+-- Создание индексов для улучшения производительности
+CREATE INDEX IF NOT EXISTS idx_organization_members_user_id ON organization_members(user_id);
+CREATE INDEX IF NOT EXISTS idx_cats_organization_id ON cats(organization_id);
+CREATE INDEX IF NOT EXISTS idx_cat_breeds_organization_id ON cat_breeds(organization_id);
+
+-- Вставка тестовых данных: приют Алексея, Мария работает в нем сотрудником, Арчи принадлежит приюту
+INSERT INTO organizations (name, description, contact_email, created_by) VALUES
+    ('Приют Добрые лапы', 'Помогаем бездомным кошкам найти дом', 'shelter@example.com', 3);
+
+INSERT INTO organization_members (organization_id, user_id, role) VALUES
+    (1, 3, 'admin'),
+    (1, 2, 'staff');
+
+UPDATE cats SET organization_id = 1 WHERE name = 'Арчи';
@@ -0,0 +1,5 @@
+-- Откат миграции: удаление объявлений о потерянных и найденных котах
+DROP INDEX IF EXISTS idx_lost_found_reports_user_id;
+DROP INDEX IF EXISTS idx_lost_found_reports_location;
+
+DROP TABLE IF EXISTS lost_found_reports;
@@ -0,0 +1,36 @@
+-- Создание таблицы объявлений о потерянных и найденных котах
+CREATE TABLE IF NOT EXISTS lost_found_reports (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    report_type TEXT NOT NULL, -- lost, found
+    status TEXT NOT NULL DEFAULT 'active', -- active, resolved
+    user_id INTEGER NOT NULL, -- Автор объявления
+    cat_id INTEGER, -- Кот, если он известен
+    breed_id INTEGER,
+    color TEXT,
+    sex TEXT,
+    description TEXT,
+    latitude REAL NOT NULL,
+    longitude REAL NOT NULL,
+    address TEXT, -- Ориентир или адрес места
+    last_seen_at DATETIME NOT NULL, -- Когда кота видели в последний раз или нашли
+    photo_urls TEXT NOT NULL DEFAULT '[]', -- Ссылки на фотографии в JSON
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    resolved_at DATETIME,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (cat_id) REFERENCES cats(id) ON DELETE SET NULL,
+    FOREIGN KEY (breed_id) REFERENCES cat_breeds(id) ON DELETE SET NULL
+);
+
+-- Индекс по координатам для предварительного отбора по ограничивающему прямоугольнику
+CREATE INDEX IF NOT EXISTS idx_lost_found_reports_location ON lost_found_reports(status, latitude, longitude);
+CREATE INDEX IF NOT EXISTS idx_lost_found_reports_user_id ON lost_found_reports(user_id);
+
+-- Вставка тестовых данных: Мурзик потерялся у Патриарших прудов, похожего кота нашли на Тверской
+INSERT INTO lost_found_reports (report_type, user_id, cat_id, color, sex, description, latitude, longitude, address, last_seen_at)
+SELECT 'lost', user_id, id, color, sex, 'Убежал с балкона, откликается на имя', 55.7637, 37.5924, 'Патриаршие пруды',
+    datetime('now', '-2 days')
+FROM cats WHERE name = 'Мурзик';
+
+INSERT INTO lost_found_reports (report_type, user_id, color, sex, description, latitude, longitude, address, last_seen_at) VALUES
+    ('found', 3, 'рыжий', 'male', 'Сидит у подъезда, ручной', 55.7652, 37.6050, 'Тверская улица', datetime('now', '-1 day'));
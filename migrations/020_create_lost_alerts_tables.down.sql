@@ -0,0 +1,8 @@
+-- Откат миграции: удаление оповещений о потерявшихся котах
+DROP INDEX IF EXISTS idx_lost_found_reports_alerts;
+DROP INDEX IF EXISTS idx_lost_alert_subscriptions_location;
+
+ALTER TABLE lost_found_reports DROP COLUMN alerts_dispatched_at;
+
+DROP TABLE IF EXISTS lost_alert_deliveries;
+DROP TABLE IF EXISTS lost_alert_subscriptions;
@@ -0,0 +1,37 @@
+-- Создание таблицы подписок на оповещения о потерявшихся рядом котах (одна подписка на пользователя)
+CREATE TABLE IF NOT EXISTS lost_alert_subscriptions (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id INTEGER NOT NULL UNIQUE,
+    latitude REAL NOT NULL,
+    longitude REAL NOT NULL,
+    radius_km REAL NOT NULL,
+    is_active BOOLEAN NOT NULL DEFAULT 1,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- Создание таблицы отправленных оповещений, чтобы не оповещать пользователя об объявлении повторно
+CREATE TABLE IF NOT EXISTS lost_alert_deliveries (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    report_id INTEGER NOT NULL,
+    user_id INTEGER NOT NULL,
+    sent_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (report_id, user_id),
+    FOREIGN KEY (report_id) REFERENCES lost_found_reports(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- Время завершения рассылки оповещений по объявлению, NULL - объявление ожидает рассылки
+ALTER TABLE lost_found_reports ADD COLUMN alerts_dispatched_at DATETIME;
+
+-- Создание индексов для улучшения производительности
+CREATE INDEX IF NOT EXISTS idx_lost_alert_subscriptions_location ON lost_alert_subscriptions(is_active, latitude, longitude);
+CREATE INDEX IF NOT EXISTS idx_lost_found_reports_alerts ON lost_found_reports(alerts_dispatched_at);
+
+-- По уже существующим объявлениям оповещения не рассылаем
+UPDATE lost_found_reports SET alerts_dispatched_at = CURRENT_TIMESTAMP;
+
+-- Вставка тестовых данных: Мария хочет знать о потерявшихся котах в 3 км от дома
+INSERT INTO lost_alert_subscriptions (user_id, latitude, longitude, radius_km) VALUES
+    (2, 55.7600, 37.6000, 3);
@@ -0,0 +1,6 @@
+-- Откат миграции: удаление отметок "нравится"
+DROP INDEX IF EXISTS idx_cat_breed_likes_user_id;
+DROP INDEX IF EXISTS idx_cat_likes_user_id;
+
+DROP TABLE IF EXISTS cat_breed_likes;
+DROP TABLE IF EXISTS cat_likes;
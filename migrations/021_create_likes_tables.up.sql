@@ -0,0 +1,35 @@
+-- Создание таблицы отметок "нравится" для котов
+CREATE TABLE IF NOT EXISTS cat_likes (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    cat_id INTEGER NOT NULL,
+    user_id INTEGER NOT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (cat_id, user_id),
+    FOREIGN KEY (cat_id) REFERENCES cats(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- Создание таблицы отметок "нравится" для пород
+CREATE TABLE IF NOT EXISTS cat_breed_likes (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    breed_id INTEGER NOT NULL,
+    user_id INTEGER NOT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (breed_id, user_id),
+    FOREIGN KEY (breed_id) REFERENCES cat_breeds(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- Создание индексов для улучшения производительности
+CREATE INDEX IF NOT EXISTS idx_cat_likes_user_id ON cat_likes(user_id);
+CREATE INDEX IF NOT EXISTS idx_cat_breed_likes_user_id ON cat_breed_likes(user_id);
+
+-- Вставка тестовых данных: избранное Марии и Алексея
+INSERT INTO cat_likes (cat_id, user_id) VALUES
+    (1, 2),
+    (1, 3),
+    (2, 3);
+
+INSERT INTO cat_breed_likes (breed_id, user_id) VALUES
+    (1, 2),
+    (2, 3);
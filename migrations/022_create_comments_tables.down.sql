@@ -0,0 +1,7 @@
+-- Откат миграции: удаление комментариев
+DROP INDEX IF EXISTS idx_comments_user_created;
+DROP INDEX IF EXISTS idx_comments_root_id;
+DROP INDEX IF EXISTS idx_comments_target;
+
+DROP TABLE IF EXISTS comment_thread_locks;
+DROP TABLE IF EXISTS comments;
@@ -0,0 +1,40 @@
+-- Создание таблицы комментариев на страницах котов и пород
+CREATE TABLE IF NOT EXISTS comments (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    target_type TEXT NOT NULL, -- cat, breed
+    target_id INTEGER NOT NULL,
+    user_id INTEGER NOT NULL,
+    parent_id INTEGER, -- Комментарий, на который дан ответ
+    root_id INTEGER, -- Комментарий верхнего уровня ветки, NULL для самого комментария верхнего уровня
+    body TEXT NOT NULL,
+    is_hidden BOOLEAN NOT NULL DEFAULT 0, -- Скрыт модератором
+    hidden_by INTEGER,
+    edited_at DATETIME,
+    deleted_at DATETIME, -- Удаленный комментарий остается в ветке, чтобы сохранить ответы
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (parent_id) REFERENCES comments(id) ON DELETE CASCADE,
+    FOREIGN KEY (root_id) REFERENCES comments(id) ON DELETE CASCADE,
+    FOREIGN KEY (hidden_by) REFERENCES users(id) ON DELETE SET NULL
+);
+
+-- Создание таблицы закрытых для новых комментариев веток
+CREATE TABLE IF NOT EXISTS comment_thread_locks (
+    target_type TEXT NOT NULL,
+    target_id INTEGER NOT NULL,
+    locked_by INTEGER NOT NULL,
+    locked_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (target_type, target_id),
+    FOREIGN KEY (locked_by) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- Создание индексов для улучшения производительности
+CREATE INDEX IF NOT EXISTS idx_comments_target ON comments(target_type, target_id, root_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_comments_root_id ON comments(root_id);
+CREATE INDEX IF NOT EXISTS idx_comments_user_created ON comments(user_id, created_at);
+
+-- Вставка тестовых данных: обсуждение Мурзика
+INSERT INTO comments (target_type, target_id, user_id, parent_id, root_id, body) VALUES
+    ('cat', 1, 2, NULL, NULL, 'Какой красавец! Сколько он весит?'),
+    ('cat', 1, 1, 1, 1, 'Спасибо! Почти пять килограммов'),
+    ('breed', 1, 3, NULL, NULL, 'Сиамские очень разговорчивые');
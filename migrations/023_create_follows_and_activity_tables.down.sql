@@ -0,0 +1,6 @@
+-- Откат миграции: удаление подписок и ленты активности
+DROP INDEX IF EXISTS idx_activity_events_user_id;
+DROP INDEX IF EXISTS idx_follows_followee_id;
+
+DROP TABLE IF EXISTS activity_events;
+DROP TABLE IF EXISTS follows;
@@ -0,0 +1,40 @@
+-- Создание таблицы подписок пользователей друг на друга
+CREATE TABLE IF NOT EXISTS follows (
+    follower_id INTEGER NOT NULL,
+    followee_id INTEGER NOT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (follower_id, followee_id),
+    FOREIGN KEY (follower_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (followee_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- Создание таблицы событий для ленты активности: новые коты, породы и объявления с фотографиями
+CREATE TABLE IF NOT EXISTS activity_events (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id INTEGER NOT NULL, -- Автор события
+    event_type TEXT NOT NULL, -- cat_created, breed_created, lost_found_created
+    target_type TEXT NOT NULL, -- cat, breed, lost_found
+    target_id INTEGER NOT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- Создание индексов для улучшения производительности
+CREATE INDEX IF NOT EXISTS idx_follows_followee_id ON follows(followee_id);
+CREATE INDEX IF NOT EXISTS idx_activity_events_user_id ON activity_events(user_id, id);
+
+-- Заполнение ленты событиями по уже существующим котам, породам и объявлениям
+INSERT INTO activity_events (user_id, event_type, target_type, target_id, created_at)
+SELECT user_id, event_type, target_type, target_id, created_at FROM (
+    SELECT user_id, 'cat_created' AS event_type, 'cat' AS target_type, id AS target_id, created_at FROM cats
+    UNION ALL
+    SELECT user_id, 'breed_created', 'breed', id, created_at FROM cat_breeds
+    UNION ALL
+    SELECT user_id, 'lost_found_created', 'lost_found', id, created_at FROM lost_found_reports
+) ORDER BY created_at, target_type, target_id;
+
+-- Вставка тестовых данных: Мария подписана на Ивана и Алексея, Алексей - на Марию
+INSERT INTO follows (follower_id, followee_id) VALUES
+    (2, 1),
+    (2, 3),
+    (3, 2);
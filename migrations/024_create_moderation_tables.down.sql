@@ -0,0 +1,13 @@
+-- Откат миграции: удаление жалоб, предупреждений и полей модерации
+DROP INDEX IF EXISTS idx_user_warnings_user_id;
+DROP INDEX IF EXISTS idx_content_reports_target;
+DROP INDEX IF EXISTS idx_content_reports_status;
+DROP INDEX IF EXISTS idx_content_reports_open;
+
+DROP TABLE IF EXISTS user_warnings;
+DROP TABLE IF EXISTS content_reports;
+
+ALTER TABLE cat_breeds DROP COLUMN hidden_at;
+ALTER TABLE cats DROP COLUMN hidden_at;
+ALTER TABLE users DROP COLUMN banned_at;
+ALTER TABLE users DROP COLUMN is_moderator;
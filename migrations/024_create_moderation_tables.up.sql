@@ -0,0 +1,53 @@
+-- Модераторы и блокировка пользователей
+ALTER TABLE users ADD COLUMN is_moderator BOOLEAN NOT NULL DEFAULT 0;
+ALTER TABLE users ADD COLUMN banned_at DATETIME;
+
+-- Скрытые модератором коты и породы не показываются в каталоге
+ALTER TABLE cats ADD COLUMN hidden_at DATETIME;
+ALTER TABLE cat_breeds ADD COLUMN hidden_at DATETIME;
+
+-- Создание таблицы жалоб на котов, породы, комментарии и пользователей
+CREATE TABLE IF NOT EXISTS content_reports (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    target_type TEXT NOT NULL, -- cat, breed, comment, user
+    target_id INTEGER NOT NULL,
+    reporter_id INTEGER NOT NULL,
+    reason TEXT NOT NULL, -- spam, abuse, inappropriate, other
+    details TEXT,
+    status TEXT NOT NULL DEFAULT 'open', -- open, resolved
+    action TEXT, -- dismiss, hide, warn, ban
+    resolution_note TEXT,
+    resolved_by INTEGER,
+    resolved_at DATETIME,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (reporter_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (resolved_by) REFERENCES users(id) ON DELETE SET NULL
+);
+
+-- Создание таблицы предупреждений пользователям
+CREATE TABLE IF NOT EXISTS user_warnings (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id INTEGER NOT NULL,
+    report_id INTEGER,
+    reason TEXT NOT NULL,
+    issued_by INTEGER NOT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (report_id) REFERENCES content_reports(id) ON DELETE SET NULL,
+    FOREIGN KEY (issued_by) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- Пользователь может держать только одну открытую жалобу на объект
+CREATE UNIQUE INDEX IF NOT EXISTS idx_content_reports_open ON content_reports(target_type, target_id, reporter_id)
+    WHERE status = 'open';
+
+-- Создание индексов для улучшения производительности
+CREATE INDEX IF NOT EXISTS idx_content_reports_status ON content_reports(status, created_at);
+CREATE INDEX IF NOT EXISTS idx_content_reports_target ON content_reports(target_type, target_id);
+CREATE INDEX IF NOT EXISTS idx_user_warnings_user_id ON user_warnings(user_id);
+
+-- Вставка тестовых данных: alex - модератор, мария пожаловалась на комментарий
+UPDATE users SET is_moderator = 1 WHERE email = 'alex@example.com';
+
+INSERT INTO content_reports (target_type, target_id, reporter_id, reason, details) VALUES
+    ('comment', 3, 2, 'spam', 'Похоже на рекламу');
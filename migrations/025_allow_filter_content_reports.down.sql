@@ -0,0 +1,39 @@
+-- Откат миграции: жалобы фильтра текста удаляются, reporter_id снова обязателен.
+-- Как и при применении, таблица пересоздается на подключении без проверки внешних ключей,
+-- поэтому ссылки предупреждений на удаляемые жалобы фильтра обнуляются явно
+UPDATE user_warnings SET report_id = NULL
+WHERE report_id IN (SELECT id FROM content_reports WHERE reporter_id IS NULL);
+
+CREATE TABLE content_reports_old (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    target_type TEXT NOT NULL,
+    target_id INTEGER NOT NULL,
+    reporter_id INTEGER NOT NULL,
+    reason TEXT NOT NULL,
+    details TEXT,
+    status TEXT NOT NULL DEFAULT 'open',
+    action TEXT,
+    resolution_note TEXT,
+    resolved_by INTEGER,
+    resolved_at DATETIME,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (reporter_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (resolved_by) REFERENCES users(id) ON DELETE SET NULL
+);
+
+INSERT INTO content_reports_old (id, target_type, target_id, reporter_id, reason, details, status, action,
+    resolution_note, resolved_by, resolved_at, created_at)
+SELECT id, target_type, target_id, reporter_id, reason, details, status, action,
+    resolution_note, resolved_by, resolved_at, created_at
+FROM content_reports WHERE reporter_id IS NOT NULL;
+
+DROP INDEX IF EXISTS idx_content_reports_target;
+DROP INDEX IF EXISTS idx_content_reports_status;
+DROP INDEX IF EXISTS idx_content_reports_open;
+DROP TABLE content_reports;
+ALTER TABLE content_reports_old RENAME TO content_reports;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_content_reports_open ON content_reports(target_type, target_id, reporter_id)
+    WHERE status = 'open';
+CREATE INDEX IF NOT EXISTS idx_content_reports_status ON content_reports(status, created_at);
+CREATE INDEX IF NOT EXISTS idx_content_reports_target ON content_reports(target_type, target_id);
@@ -0,0 +1,37 @@
+-- Жалобы от автоматической проверки текста не имеют автора: reporter_id становится необязательным.
+-- SQLite не изменяет ограничения колонок, поэтому таблица пересоздается.
+-- Пересоздание рассчитано на подключение без проверки внешних ключей (Database.RunMigrations): иначе
+-- DROP TABLE обнулит ссылки предупреждений user_warnings.report_id на жалобы
+CREATE TABLE content_reports_new (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    target_type TEXT NOT NULL, -- cat, breed, comment, user
+    target_id INTEGER NOT NULL,
+    reporter_id INTEGER, -- NULL для жалоб фильтра текста
+    reason TEXT NOT NULL, -- spam, abuse, inappropriate, other
+    details TEXT,
+    status TEXT NOT NULL DEFAULT 'open', -- open, resolved
+    action TEXT, -- dismiss, hide, warn, ban
+    resolution_note TEXT,
+    resolved_by INTEGER,
+    resolved_at DATETIME,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (reporter_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (resolved_by) REFERENCES users(id) ON DELETE SET NULL
+);
+
+INSERT INTO content_reports_new (id, target_type, target_id, reporter_id, reason, details, status, action,
+    resolution_note, resolved_by, resolved_at, created_at)
+SELECT id, target_type, target_id, reporter_id, reason, details, status, action,
+    resolution_note, resolved_by, resolved_at, created_at
+FROM content_reports;
+
+DROP INDEX IF EXISTS idx_content_reports_target;
+DROP INDEX IF EXISTS idx_content_reports_status;
+DROP INDEX IF EXISTS idx_content_reports_open;
+DROP TABLE content_reports;
+ALTER TABLE content_reports_new RENAME TO content_reports;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_content_reports_open ON content_reports(target_type, target_id, reporter_id)
+    WHERE status = 'open';
+CREATE INDEX IF NOT EXISTS idx_content_reports_status ON content_reports(status, created_at);
+CREATE INDEX IF NOT EXISTS idx_content_reports_target ON content_reports(target_type, target_id);
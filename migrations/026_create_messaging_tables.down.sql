@@ -0,0 +1,9 @@
+-- Откат миграции: удаление переписок, сообщений и блокировок пользователей
+DROP INDEX IF EXISTS idx_user_blocks_blocked_id;
+DROP INDEX IF EXISTS idx_messages_conversation_id;
+DROP INDEX IF EXISTS idx_conversation_participants_user_id;
+
+DROP TABLE IF EXISTS user_blocks;
+DROP TABLE IF EXISTS messages;
+DROP TABLE IF EXISTS conversation_participants;
+DROP TABLE IF EXISTS conversations;
@@ -0,0 +1,3 @@
+-- Откат миграции: удаление счетчика попыток рассылки оповещений
+ALTER TABLE lost_found_reports DROP COLUMN alert_retry_at;
+ALTER TABLE lost_found_reports DROP COLUMN alert_attempts;
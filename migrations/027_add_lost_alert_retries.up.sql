@@ -0,0 +1,4 @@
+-- Повторные попытки рассылки оповещений: число неудачных попыток и время следующей.
+-- Объявление, которое не удается разослать, не задерживает рассылку по более новым
+ALTER TABLE lost_found_reports ADD COLUMN alert_attempts INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE lost_found_reports ADD COLUMN alert_retry_at DATETIME;
@@ -0,0 +1,26 @@
+-- Откат миграции: непринятые приглашения по email удаляются, user_id снова обязателен
+CREATE TABLE cat_members_old (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    cat_id INTEGER NOT NULL,
+    user_id INTEGER NOT NULL,
+    role TEXT NOT NULL,
+    status TEXT NOT NULL DEFAULT 'pending',
+    invited_by INTEGER NOT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    accepted_at DATETIME,
+    UNIQUE (cat_id, user_id),
+    FOREIGN KEY (cat_id) REFERENCES cats(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (invited_by) REFERENCES users(id) ON DELETE CASCADE
+);
+
+INSERT INTO cat_members_old (id, cat_id, user_id, role, status, invited_by, created_at, accepted_at)
+SELECT id, cat_id, user_id, role, status, invited_by, created_at, accepted_at
+FROM cat_members WHERE user_id IS NOT NULL;
+
+DROP INDEX IF EXISTS idx_cat_members_invite_email;
+DROP INDEX IF EXISTS idx_cat_members_user_id;
+DROP TABLE cat_members;
+ALTER TABLE cat_members_old RENAME TO cat_members;
+
+CREATE INDEX IF NOT EXISTS idx_cat_members_user_id ON cat_members(user_id);
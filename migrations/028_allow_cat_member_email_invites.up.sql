@@ -0,0 +1,30 @@
+-- Приглашение участника кота по email, еще не зарегистрированному в сервисе: user_id заполняется,
+-- когда приглашенный примет приглашение. Так ответ на приглашение не раскрывает, есть ли аккаунт с этим email.
+-- SQLite не изменяет ограничения колонок, поэтому таблица пересоздается
+CREATE TABLE cat_members_new (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    cat_id INTEGER NOT NULL,
+    user_id INTEGER, -- NULL, пока приглашение по email не принято
+    invite_email TEXT, -- Email приглашенного без аккаунта
+    role TEXT NOT NULL, -- owner, caretaker, viewer
+    status TEXT NOT NULL DEFAULT 'pending', -- pending, accepted
+    invited_by INTEGER NOT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    accepted_at DATETIME,
+    UNIQUE (cat_id, user_id),
+    UNIQUE (cat_id, invite_email),
+    FOREIGN KEY (cat_id) REFERENCES cats(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (invited_by) REFERENCES users(id) ON DELETE CASCADE
+);
+
+INSERT INTO cat_members_new (id, cat_id, user_id, role, status, invited_by, created_at, accepted_at)
+SELECT id, cat_id, user_id, role, status, invited_by, created_at, accepted_at
+FROM cat_members;
+
+DROP INDEX IF EXISTS idx_cat_members_user_id;
+DROP TABLE cat_members;
+ALTER TABLE cat_members_new RENAME TO cat_members;
+
+CREATE INDEX IF NOT EXISTS idx_cat_members_user_id ON cat_members(user_id);
+CREATE INDEX IF NOT EXISTS idx_cat_members_invite_email ON cat_members(invite_email);